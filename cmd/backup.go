@@ -0,0 +1,310 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/backup"
+	"github.com/kyokomi/gotodoist/internal/cli"
+	"github.com/kyokomi/gotodoist/internal/config"
+	"github.com/kyokomi/gotodoist/internal/factory"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	// サブコマンドを追加
+	backupCmd.AddCommand(backupCreateCmd)
+	backupCmd.AddCommand(backupRestoreCmd)
+
+	// backupコマンドをルートコマンドに追加
+	rootCmd.AddCommand(backupCmd)
+
+	// backup create用のフラグ
+	backupCreateCmd.Flags().StringP("output", "o", "", "output file (default: gotodoist-backup-YYYYMMDD-HHMMSS.json)")
+
+	// backup restore用のフラグ
+	backupRestoreCmd.Flags().StringP("project", "p", "", "restore only this project (name or ID in the backup) and its sub-projects")
+	backupRestoreCmd.Flags().BoolP("yes", "y", false, "skip confirmation prompt")
+}
+
+// backupCmd はバックアップ関連のコマンド
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up and restore Todoist data",
+	Long: `Back up the locally synced Todoist data to a JSON file and restore it.
+
+A backup contains projects, sections, tasks, labels, comments and filters.
+This command requires local storage to be enabled.`,
+}
+
+// backupCreateCmd はバックアップの作成コマンド
+var backupCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Write a backup of the local data to a JSON file",
+	Long: `Write a backup of the locally synced data to a versioned JSON file.
+
+Run 'gotodoist sync' first to back up the latest state.`,
+	Example: `  gotodoist backup create
+  gotodoist backup create -o todoist.json`,
+	Args: cobra.NoArgs,
+	RunE: runBackupCreate,
+}
+
+// backupRestoreCmd はバックアップからの復元コマンド
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore <file>",
+	Short: "Re-create objects from a backup that no longer exist",
+	Long: `Re-create the projects, sections, open tasks, labels, comments and filters of a
+backup that no longer exist, in a single batched request. Objects that still exist
+are left untouched; labels and filters are matched by name.
+
+The objects to create are listed before anything is changed. Restored objects get
+new IDs, which are recorded in <file>.idmap.json so that running the restore again
+does not create them twice.`,
+	Example: `  gotodoist backup restore gotodoist-backup-20261018-090000.json
+  gotodoist backup restore todoist.json --project Work
+  gotodoist backup restore todoist.json --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBackupRestore,
+}
+
+// backupCreateParams はバックアップ作成のパラメータ
+type backupCreateParams struct {
+	outputPath string
+}
+
+// backupRestoreParams はバックアップからの復元のパラメータ
+type backupRestoreParams struct {
+	path    string
+	project string
+	yes     bool
+}
+
+// runBackupCreate はバックアップ作成の実際の処理
+func runBackupCreate(cmd *cobra.Command, _ []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupBackupExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	outputPath, _ := cmd.Flags().GetString("output")
+	return executor.executeBackupCreateWithOutput(ctx, &backupCreateParams{outputPath: outputPath})
+}
+
+// runBackupRestore はバックアップからの復元の実際の処理
+func runBackupRestore(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupBackupExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	project, _ := cmd.Flags().GetString("project")
+	yes, _ := cmd.Flags().GetBool("yes")
+	return executor.executeBackupRestoreWithOutput(ctx, &backupRestoreParams{path: args[0], project: project, yes: yes})
+}
+
+// executeBackupCreateWithOutput はバックアップの作成と結果表示を実行する（テスト可能）
+func (e *backupExecutor) executeBackupCreateWithOutput(ctx context.Context, params *backupCreateParams) error {
+	// 1. ローカルのデータからバックアップを作成
+	archive, err := e.repository.CreateBackup(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create backup: %w", err)
+	}
+
+	// 2. ファイルに書き出す
+	path := params.outputPath
+	if path == "" {
+		path = fmt.Sprintf("gotodoist-backup-%s.json", archive.CreatedAt.Format("20060102-150405"))
+	}
+	var buf bytes.Buffer
+	if err := backup.Write(&buf, archive); err != nil {
+		return fmt.Errorf("failed to encode backup: %w", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	// 3. 結果表示
+	e.output.Successf("💾 Backed up to %s", path)
+	e.output.Plainf("   Projects: %d", len(archive.Projects))
+	e.output.Plainf("   Sections: %d", len(archive.Sections))
+	e.output.Plainf("   Tasks: %d", len(archive.Tasks))
+	e.output.Plainf("   Labels: %d", len(archive.Labels))
+	e.output.Plainf("   Comments: %d", len(archive.Notes))
+	e.output.Plainf("   Filters: %d", len(archive.Filters))
+
+	return nil
+}
+
+// executeBackupRestoreWithOutput はバックアップからの復元と結果表示を実行する（テスト可能）
+func (e *backupExecutor) executeBackupRestoreWithOutput(ctx context.Context, params *backupRestoreParams) error {
+	// 1. バックアップと前回の復元のIDの対応を読み込む
+	file, err := os.Open(params.path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", params.path, err)
+	}
+	defer func() { _ = file.Close() }()
+	archive, err := backup.Read(file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", params.path, err)
+	}
+	idMapPath := params.path + ".idmap.json"
+	ids, err := backup.ReadIDMap(idMapPath)
+	if err != nil {
+		return err
+	}
+
+	// 2. 現在のデータと比較して復元するものを決める
+	projectID := ""
+	if params.project != "" {
+		project, err := archive.FindProject(params.project)
+		if err != nil {
+			return err
+		}
+		projectID = project.ID
+	}
+	plan, err := e.repository.PlanRestore(ctx, archive, ids, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to compare backup: %w", err)
+	}
+	if plan.Count() == 0 {
+		e.output.Infof("Nothing to restore: everything in the backup still exists")
+		return nil
+	}
+
+	// 3. 差分を表示して確認（ドライランではコマンドを表示するだけなので確認しない）
+	e.output.Infof("Backup from %s: %d object(s) to re-create", archive.CreatedAt.Local().Format(time.DateTime), plan.Count())
+	e.displayRestorePlan(archive, plan)
+	if !params.yes && !e.cfg.DryRun {
+		e.output.PlainNoNewlinef("Re-create these objects? (y/N): ")
+		confirmation, err := readPromptLine()
+		if err != nil || (confirmation != "y" && confirmation != "Y") {
+			e.output.Errorf("Restore canceled")
+			return nil
+		}
+	}
+
+	// 4. 復元実行
+	result, restoreErr := e.repository.RestoreBackup(ctx, plan)
+	if result == nil {
+		return fmt.Errorf("failed to restore backup: %w", restoreErr)
+	}
+
+	// 5. 作成したオブジェクトのIDの対応を保存（再実行で重複して作成しないように）
+	if !e.cfg.DryRun && len(result.IDs) > 0 {
+		for oldID, id := range result.IDs {
+			ids[oldID] = id
+		}
+		if err := backup.WriteIDMap(idMapPath, ids); err != nil {
+			e.output.Warningf("Failed to write %s: %v", idMapPath, err)
+		}
+	}
+	if restoreErr != nil {
+		e.output.Warningf("The backup was restored partially (run the restore again to retry, or 'gotodoist undo' to remove it)")
+		return fmt.Errorf("failed to restore backup: %w", restoreErr)
+	}
+
+	// 6. 結果表示
+	e.output.Successf("♻️  Restored %d object(s) from %s", plan.Count(), params.path)
+	if IsVerbose() && result.Response != nil {
+		e.output.Plainf("Sync token: %s", result.Response.SyncToken)
+	}
+	return nil
+}
+
+// displayRestorePlan は復元で作成するオブジェクトを差分の形式で表示する
+func (e *backupExecutor) displayRestorePlan(archive *backup.Archive, plan *backup.Plan) {
+	projectNames := make(map[string]string, len(archive.Projects))
+	for i := range archive.Projects {
+		projectNames[archive.Projects[i].ID] = archive.Projects[i].Name
+	}
+	taskNames := make(map[string]string, len(archive.Tasks))
+	for i := range archive.Tasks {
+		taskNames[archive.Tasks[i].ID] = archive.Tasks[i].Content
+	}
+
+	for i := range plan.Labels {
+		e.output.Plainf("  + label   @%s", plan.Labels[i].Name)
+	}
+	for i := range plan.Projects {
+		e.output.Plainf("  + project %s", plan.Projects[i].Name)
+	}
+	for i := range plan.Sections {
+		section := plan.Sections[i]
+		e.output.Plainf("  + section %s / %s", projectNames[section.ProjectID], section.Name)
+	}
+	for i := range plan.Tasks {
+		task := plan.Tasks[i]
+		e.output.Plainf("  + task    %s / %s", projectNames[task.ProjectID], task.Content)
+	}
+	for i := range plan.Notes {
+		note := plan.Notes[i]
+		firstLine, _, _ := strings.Cut(note.Content, "\n")
+		e.output.Plainf("  + comment on %q: %s", taskNames[note.ItemID], firstLine)
+	}
+	for i := range plan.Filters {
+		e.output.Plainf("  + filter  %s (%s)", plan.Filters[i].Name, plan.Filters[i].Query)
+	}
+}
+
+// backupExecutor はバックアップ操作に必要な情報をまとめた構造体
+type backupExecutor struct {
+	cfg        *config.Config
+	repository *repository.Repository
+	output     *cli.Output
+}
+
+// setupBackupExecution はバックアップ操作の実行環境をセットアップする
+func setupBackupExecution(ctx context.Context) (*backupExecutor, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	cfg.DryRun = IsDryRun()
+
+	output := newOutput(cfg.DryRun, os.Stdout, os.Stderr)
+
+	repo, err := factory.NewRepository(cfg, IsVerbose())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	// ローカルストレージが有効な場合のみ初期化
+	if cfg.LocalStorage.Enabled {
+		if err := repo.Initialize(ctx); err != nil {
+			if closeErr := repo.Close(); closeErr != nil {
+				output.Warningf("failed to close repository after initialization error: %v", closeErr)
+			}
+			return nil, fmt.Errorf("failed to initialize repository: %w", err)
+		}
+	}
+
+	return &backupExecutor{
+		cfg:        cfg,
+		repository: repo,
+		output:     output,
+	}, nil
+}
+
+// cleanup はRepositoryのリソースクリーンアップを行う
+func (e *backupExecutor) cleanup() {
+	if err := e.repository.Close(); err != nil {
+		e.output.Warningf("failed to close repository: %v", err)
+	}
+}
@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/backup"
+)
+
+// setupTestBackupExecutor はテスト用のbackupExecutorを作成する
+func setupTestBackupExecutor(t *testing.T) (*testExecutorSetup, *backupExecutor) {
+	t.Helper()
+
+	base := setupTestExecutorBase(t)
+	return base, &backupExecutor{cfg: base.cfg, repository: base.repository, output: base.output}
+}
+
+// insertTestBackupData はバックアップ対象のデータを挿入する
+func insertTestBackupData(t *testing.T, dbPath string) {
+	t.Helper()
+
+	insertTestProjectsIntoDB(t, dbPath, []api.Project{
+		{ID: "project-inbox", Name: "Inbox", InboxProject: true},
+		{ID: "project-work", Name: "Work", Color: "blue"},
+	})
+	insertTestSectionsIntoDB(t, dbPath, []api.Section{{ID: "section-todo", Name: "Todo", ProjectID: "project-work"}})
+	insertTestTasksIntoDB(t, dbPath, []api.Item{
+		{ID: "task-report", ProjectID: "project-work", SectionID: "section-todo", Content: "Weekly report", Labels: []string{"office"}, Priority: 3},
+		{ID: "task-summary", ProjectID: "project-work", SectionID: "section-todo", ParentID: "task-report", Content: "Write summary"},
+		{ID: "task-milk", ProjectID: "project-inbox", Content: "Buy milk"},
+	})
+	insertTestLabelsIntoDB(t, dbPath, []api.Label{{ID: "label-office", Name: "office", Color: "red"}})
+	insertTestNotesIntoDB(t, dbPath, []api.Note{{ID: "note-1", ItemID: "task-report", Content: "Use the template"}})
+	insertTestFiltersIntoDB(t, dbPath, []api.Filter{{ID: "filter-1", Name: "Urgent", Query: "p1"}})
+}
+
+func TestExecuteBackupCreateWithOutput(t *testing.T) {
+	setup, executor := setupTestBackupExecutor(t)
+	defer setup.cleanup()
+	insertTestBackupData(t, setup.dbPath)
+	path := filepath.Join(t.TempDir(), "backup.json")
+
+	// Act
+	err := executor.executeBackupCreateWithOutput(context.Background(), &backupCreateParams{outputPath: path})
+
+	// Assert
+	require.NoError(t, err)
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	archive, err := backup.Read(file)
+	require.NoError(t, err)
+	assert.Len(t, archive.Projects, 2)
+	assert.Len(t, archive.Sections, 1)
+	assert.Len(t, archive.Tasks, 3)
+	require.Len(t, archive.Labels, 1)
+	assert.Equal(t, "red", archive.Labels[0].Color)
+	assert.Len(t, archive.Notes, 1)
+	assert.Len(t, archive.Filters, 1)
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, "Backed up to "+path)
+	assert.Contains(t, output, "Tasks: 3")
+	assert.Contains(t, output, "Comments: 1")
+}
+
+// writeTestBackup は現在のローカルのデータからバックアップファイルを作成する
+func writeTestBackup(t *testing.T, executor *backupExecutor) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "backup.json")
+	require.NoError(t, executor.executeBackupCreateWithOutput(context.Background(), &backupCreateParams{outputPath: path}))
+	return path
+}
+
+func TestExecuteBackupRestoreWithOutput(t *testing.T) {
+	setup, executor := setupTestBackupExecutor(t)
+	defer setup.cleanup()
+	insertTestBackupData(t, setup.dbPath)
+	path := writeTestBackup(t, executor)
+
+	// Arrange: インボックスと「Buy milk」以外を消した状態にする
+	require.NoError(t, setup.repository.ResetLocalStorage(context.Background()))
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-inbox", Name: "Inbox", InboxProject: true}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{{ID: "task-milk", ProjectID: "project-inbox", Content: "Buy milk"}})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+	sent := recordImportCommands(setup.mockClient)
+	setup.stdout.Reset()
+
+	// Act
+	err := executor.executeBackupRestoreWithOutput(context.Background(), &backupRestoreParams{path: path, yes: true})
+
+	// Assert: ラベル→プロジェクト→セクション→タスク→コメント→フィルタの順にtemp_idで関連付けて作成する
+	require.NoError(t, err)
+	commands := *sent
+	require.Len(t, commands, 7)
+	var types []string
+	for _, cmd := range commands {
+		types = append(types, cmd.Type)
+	}
+	assert.Equal(t, []string{
+		api.CommandLabelAdd, api.CommandProjectAdd, api.CommandSectionAdd,
+		api.CommandItemAdd, api.CommandItemAdd, api.CommandNoteAdd, api.CommandFilterAdd,
+	}, types)
+
+	label, project, section, report, summary, note := commands[0], commands[1], commands[2], commands[3], commands[4], commands[5]
+	assert.Equal(t, "red", label.Args["color"])
+	assert.Equal(t, "Work", project.Args["name"])
+	assert.Equal(t, "blue", project.Args["color"])
+	assert.Equal(t, project.TempID, section.Args["project_id"])
+	assert.Equal(t, "Weekly report", report.Args["content"])
+	assert.Equal(t, section.TempID, report.Args["section_id"])
+	assert.Equal(t, []string{"office"}, report.Args["labels"])
+	assert.Equal(t, report.TempID, summary.Args["parent_id"])
+	assert.Equal(t, report.TempID, note.Args["item_id"])
+
+	// 差分を表示してから実行する
+	output := setup.stdout.String()
+	assert.Contains(t, output, "7 object(s) to re-create")
+	assert.Contains(t, output, "+ project Work")
+	assert.Contains(t, output, "+ task    Work / Write summary")
+	assert.NotContains(t, output, "Buy milk")
+	assert.Contains(t, output, "Restored 7 object(s)")
+
+	// 再実行で重複しないようにIDの対応を保存する
+	ids, err := backup.ReadIDMap(path + ".idmap.json")
+	require.NoError(t, err)
+	assert.Len(t, ids, 7)
+	assert.NotEmpty(t, ids["task-report"])
+
+	// 復元したプロジェクトはプロジェクトごと取り消せる
+	entries, err := setup.repository.ListUndoEntries(1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, `create project "Work"`, entries[0].Description)
+}
+
+func TestExecuteBackupRestoreWithOutput_Project(t *testing.T) {
+	setup, executor := setupTestBackupExecutor(t)
+	defer setup.cleanup()
+	insertTestBackupData(t, setup.dbPath)
+	path := writeTestBackup(t, executor)
+
+	// Arrange: Workのタスクだけを消した状態にする
+	require.NoError(t, setup.repository.ResetLocalStorage(context.Background()))
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-inbox", Name: "Inbox", InboxProject: true},
+		{ID: "project-work", Name: "Work"},
+	})
+	insertTestSectionsIntoDB(t, setup.dbPath, []api.Section{{ID: "section-todo", Name: "Todo", ProjectID: "project-work"}})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+	sent := recordImportCommands(setup.mockClient)
+
+	// Act
+	err := executor.executeBackupRestoreWithOutput(context.Background(), &backupRestoreParams{path: path, project: "work", yes: true})
+
+	// Assert: 既存のプロジェクト・セクションにタスクを作成し、「Buy milk」とフィルタは対象外
+	require.NoError(t, err)
+	commands := *sent
+	tasks := commandsByContent(commands)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "project-work", tasks["Weekly report"].Args["project_id"])
+	assert.Equal(t, "section-todo", tasks["Weekly report"].Args["section_id"])
+	for _, cmd := range commands {
+		assert.NotEqual(t, api.CommandFilterAdd, cmd.Type)
+		assert.NotEqual(t, api.CommandProjectAdd, cmd.Type)
+	}
+
+	entries, err := setup.repository.ListUndoEntries(1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, `create task "Weekly report"`, entries[0].Description)
+}
+
+func TestExecuteBackupRestoreWithOutput_Canceled(t *testing.T) {
+	setup, executor := setupTestBackupExecutor(t)
+	defer setup.cleanup()
+	insertTestBackupData(t, setup.dbPath)
+	path := writeTestBackup(t, executor)
+	require.NoError(t, setup.repository.ResetLocalStorage(context.Background()))
+	sent := recordImportCommands(setup.mockClient)
+
+	originalReader := promptReader
+	promptReader = bufio.NewReader(strings.NewReader("n\n"))
+	defer func() { promptReader = originalReader }()
+
+	// Act
+	err := executor.executeBackupRestoreWithOutput(context.Background(), &backupRestoreParams{path: path})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, *sent)
+	assert.Contains(t, setup.stderr.String(), "Restore canceled")
+	_, err = os.Stat(path + ".idmap.json")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExecuteBackupRestoreWithOutput_NothingToRestore(t *testing.T) {
+	setup, executor := setupTestBackupExecutor(t)
+	defer setup.cleanup()
+	insertTestBackupData(t, setup.dbPath)
+	path := writeTestBackup(t, executor)
+	sent := recordImportCommands(setup.mockClient)
+
+	err := executor.executeBackupRestoreWithOutput(context.Background(), &backupRestoreParams{path: path, yes: true})
+
+	require.NoError(t, err)
+	assert.Empty(t, *sent)
+	assert.Contains(t, setup.stdout.String(), "Nothing to restore")
+}
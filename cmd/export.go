@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/filter"
+	"github.com/kyokomi/gotodoist/internal/ical"
+	"github.com/kyokomi/gotodoist/internal/plaintext"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	// export用のフラグ
+	exportCmd.Flags().String("format", plaintext.FormatMarkdown, "output format: "+strings.Join(plaintext.ExportFormats, ", ")+", "+ical.Format)
+	exportCmd.Flags().StringP("filter", "f", "", "export tasks matching a Todoist filter query instead of a project")
+	exportCmd.Flags().StringP("output", "o", "", "write to a file instead of stdout")
+	exportCmd.Flags().Bool("completed", false, "include completed tasks (as checked items)")
+	exportCmd.Flags().String("component", ical.ComponentEvent, "iCalendar entries to write with --format ics: "+strings.Join(ical.Components, ", "))
+}
+
+// exportCmd はタスクをテキスト形式で書き出すコマンド
+var exportCmd = &cobra.Command{
+	Use:   "export [project ID or name]",
+	Short: "Export tasks as Markdown, Org, TaskPaper or iCalendar",
+	Long: `Export the tasks of a project, or the tasks matching a filter query, from the
+local data as text that can be pasted into pull requests, wikis and notes.
+
+Sections are written as headings and tasks as nested checkboxes (subtasks
+below their parent). With --filter, tasks are grouped by project and section.
+
+  markdown   "- [ ] task", labels as #tag, priorities as ⏫ 🔼 🔽, due dates as 📅 (Obsidian Tasks)
+  org        "- [ ] task", priorities as [#A]-[#C], due dates as <2026-10-20 Tue>, labels as :tag:
+  taskpaper  "Project:" and "- task" lines, @priority(1-3), @due(...), @done and @tags
+  ics        iCalendar (RFC 5545) for calendar apps
+
+With --format ics the project and --filter are optional (all tasks by default).
+Tasks with due dates are written as events (or all tasks as to-dos with
+--component todo), with UIDs derived from the task IDs so that re-imported
+entries are updated instead of duplicated. Simple recurrences such as
+"every 2 weeks" or "every mon, fri" are written as RRULEs. Use
+'gotodoist serve ics' to let calendar apps subscribe to the same feed.`,
+	Example: `  gotodoist export "Release checklist"
+  gotodoist export Work --format org -o work.org
+  gotodoist export --filter "today | overdue" --format taskpaper
+  gotodoist export --format ics --filter "#Work" -o work.ics`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runExport,
+}
+
+// exportParams はタスク書き出しのパラメータ
+type exportParams struct {
+	projectIDOrName string
+	filterQuery     string
+	format          string
+	outputPath      string
+	completed       bool
+	component       string
+}
+
+// getExportParams はタスク書き出しのパラメータを取得する
+func getExportParams(cmd *cobra.Command, args []string) *exportParams {
+	filterQuery, _ := cmd.Flags().GetString("filter")
+	format, _ := cmd.Flags().GetString("format")
+	outputPath, _ := cmd.Flags().GetString("output")
+	completed, _ := cmd.Flags().GetBool("completed")
+	component, _ := cmd.Flags().GetString("component")
+	params := &exportParams{
+		filterQuery: filterQuery,
+		format:      format,
+		outputPath:  outputPath,
+		completed:   completed,
+		component:   component,
+	}
+	if len(args) > 0 {
+		params.projectIDOrName = args[0]
+	}
+	return params
+}
+
+// runExport はタスク書き出しの実際の処理
+func runExport(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getExportParams(cmd, args)
+	return executor.executeExportWithOutput(ctx, params)
+}
+
+// executeExportWithOutput はタスクの書き出しを実行する（テスト可能）
+func (e *taskExecutor) executeExportWithOutput(ctx context.Context, params *exportParams) error {
+	if params.format == ical.Format {
+		return e.executeCalendarExportWithOutput(ctx, params)
+	}
+	if (params.projectIDOrName == "") == (params.filterQuery == "") {
+		return fmt.Errorf("specify either a project or --filter")
+	}
+
+	// 1. 書き出す見出しとタスクを構築
+	var outline plaintext.Outline
+	var err error
+	if params.filterQuery != "" {
+		outline, err = e.buildFilterOutline(ctx, params.filterQuery, params.completed)
+	} else {
+		outline, err = e.buildProjectOutline(ctx, params.projectIDOrName, params.completed)
+	}
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := plaintext.Render(&buf, params.format, &outline); err != nil {
+		return err
+	}
+
+	// 2. 出力
+	if params.outputPath == "" {
+		e.output.PlainNoNewlinef("%s", buf.String())
+		return nil
+	}
+	if err := os.WriteFile(params.outputPath, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", params.outputPath, err)
+	}
+	e.output.Successf("📄 Exported %d task(s) to %s", outline.Count(), params.outputPath)
+	return nil
+}
+
+// executeCalendarExportWithOutput はタスクのiCalendar形式での書き出しを実行する
+func (e *taskExecutor) executeCalendarExportWithOutput(ctx context.Context, params *exportParams) error {
+	var buf bytes.Buffer
+	count, err := e.writeCalendar(ctx, &buf, &calendarParams{
+		projectIDOrName: params.projectIDOrName,
+		filterQuery:     params.filterQuery,
+		component:       params.component,
+		completed:       params.completed,
+	})
+	if err != nil {
+		return err
+	}
+
+	if params.outputPath == "" {
+		e.output.PlainNoNewlinef("%s", buf.String())
+		return nil
+	}
+	if err := os.WriteFile(params.outputPath, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", params.outputPath, err)
+	}
+	e.output.Successf("📅 Exported %d task(s) to %s", count, params.outputPath)
+	return nil
+}
+
+// calendarParams はiCalendar形式で書き出すタスクの指定
+type calendarParams struct {
+	projectIDOrName string
+	filterQuery     string
+	component       string
+	completed       bool
+}
+
+// writeCalendar はプロジェクト・フィルタクエリに一致するタスクをiCalendar形式で書き出し、書き出したエントリの数を返す
+// プロジェクトもフィルタクエリも指定しない場合はすべてのタスクを書き出す
+func (e *taskExecutor) writeCalendar(ctx context.Context, w io.Writer, params *calendarParams) (int, error) {
+	var tasks []api.Item
+	var err error
+	name := "Todoist"
+	if params.projectIDOrName != "" {
+		projectID, err := e.findProjectIDByName(ctx, params.projectIDOrName)
+		if err != nil {
+			return 0, err
+		}
+		projects, err := e.repository.GetAllProjects(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get projects: %w", err)
+		}
+		if tasks, err = e.repository.GetTasksByProject(ctx, projectID); err != nil {
+			return 0, fmt.Errorf("failed to get tasks: %w", err)
+		}
+		name = repository.ProjectPath(projects, projectID)
+	} else if tasks, err = e.repository.GetTasks(ctx); err != nil {
+		return 0, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	tasks = exportableTasks(tasks, params.completed)
+
+	if params.filterQuery != "" {
+		if tasks, err = e.filterTasksByQuery(ctx, tasks, params.filterQuery); err != nil {
+			return 0, err
+		}
+		if params.projectIDOrName == "" {
+			name = params.filterQuery
+		}
+	}
+
+	// 予定には期限のあるタスクのみを書き出す
+	if params.component != ical.ComponentTodo {
+		var withDue []api.Item
+		for i := range tasks {
+			if tasks[i].Due != nil && tasks[i].Due.Date != "" {
+				withDue = append(withDue, tasks[i])
+			}
+		}
+		tasks = withDue
+	}
+
+	opts := ical.Options{Name: name, Component: params.component, Now: time.Now()}
+	if err := ical.Write(w, tasks, opts); err != nil {
+		return 0, err
+	}
+	return len(tasks), nil
+}
+
+// filterTasksByQuery はTodoistのフィルタクエリでタスクを絞り込む
+func (e *taskExecutor) filterTasksByQuery(ctx context.Context, tasks []api.Item, queryText string) ([]api.Item, error) {
+	query, err := filter.Parse(queryText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter query: %w", err)
+	}
+	projects, err := e.repository.GetAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	sections, err := e.repository.GetAllSections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+	return query.Filter(tasks, &filter.Context{Now: time.Now(), Projects: projects, Sections: sections}), nil
+}
+
+// buildProjectOutline はプロジェクトのセクションとタスクから書き出す見出しを構築する
+func (e *taskExecutor) buildProjectOutline(ctx context.Context, nameOrID string, completed bool) (plaintext.Outline, error) {
+	projectID, err := e.findProjectIDByName(ctx, nameOrID)
+	if err != nil {
+		return plaintext.Outline{}, err
+	}
+	projects, err := e.repository.GetAllProjects(ctx)
+	if err != nil {
+		return plaintext.Outline{}, fmt.Errorf("failed to get projects: %w", err)
+	}
+	allSections, err := e.repository.GetAllSections(ctx)
+	if err != nil {
+		return plaintext.Outline{}, fmt.Errorf("failed to get sections: %w", err)
+	}
+	tasks, err := e.repository.GetTasksByProject(ctx, projectID)
+	if err != nil {
+		return plaintext.Outline{}, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var sections []api.Section
+	for i := range allSections {
+		if allSections[i].ProjectID == projectID && allSections[i].DateArchived == nil {
+			sections = append(sections, allSections[i])
+		}
+	}
+	title := repository.ProjectPath(projects, projectID)
+	return plaintext.ProjectOutline(title, sections, exportableTasks(tasks, completed), time.Local), nil
+}
+
+// buildFilterOutline はフィルタクエリに一致するタスクをプロジェクト・セクションごとの見出しにまとめる
+func (e *taskExecutor) buildFilterOutline(ctx context.Context, queryText string, completed bool) (plaintext.Outline, error) {
+	tasks, err := e.repository.GetTasks(ctx)
+	if err != nil {
+		return plaintext.Outline{}, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	if tasks, err = e.filterTasksByQuery(ctx, exportableTasks(tasks, completed), queryText); err != nil {
+		return plaintext.Outline{}, err
+	}
+	projects, err := e.repository.GetAllProjects(ctx)
+	if err != nil {
+		return plaintext.Outline{}, fmt.Errorf("failed to get projects: %w", err)
+	}
+	sections, err := e.repository.GetAllSections(ctx)
+	if err != nil {
+		return plaintext.Outline{}, fmt.Errorf("failed to get sections: %w", err)
+	}
+
+	// プロジェクトごとに、タスクのあるセクションだけを見出しにする
+	tasksByProject := make(map[string][]api.Item)
+	usedSections := make(map[string]bool)
+	for i := range tasks {
+		tasksByProject[tasks[i].ProjectID] = append(tasksByProject[tasks[i].ProjectID], tasks[i])
+		usedSections[tasks[i].SectionID] = true
+	}
+	outline := plaintext.Outline{Title: queryText}
+	for _, projectID := range projectTreeOrder(projects) {
+		if len(tasksByProject[projectID]) == 0 {
+			continue
+		}
+		var projectSections []api.Section
+		for i := range sections {
+			if sections[i].ProjectID == projectID && usedSections[sections[i].ID] {
+				projectSections = append(projectSections, sections[i])
+			}
+		}
+		title := repository.ProjectPath(projects, projectID)
+		outline.Sections = append(outline.Sections, plaintext.ProjectOutline(title, projectSections, tasksByProject[projectID], time.Local))
+	}
+	return outline, nil
+}
+
+// exportableTasks は書き出すタスク（completedがfalseの場合は未完了のタスクのみ）を返す
+func exportableTasks(tasks []api.Item, completed bool) []api.Item {
+	if completed {
+		return tasks
+	}
+	var result []api.Item
+	for i := range tasks {
+		if tasks[i].DateCompleted == nil {
+			result = append(result, tasks[i])
+		}
+	}
+	return result
+}
+
+// projectTreeOrder はプロジェクトのIDを親の直後に子を並べた順（プロジェクト一覧の表示順）で返す
+func projectTreeOrder(projects []api.Project) []string {
+	exists := make(map[string]bool, len(projects))
+	for i := range projects {
+		exists[projects[i].ID] = true
+	}
+	children := make(map[string][]string)
+	for i := range projects {
+		parentID := projects[i].ParentID
+		if !exists[parentID] {
+			parentID = ""
+		}
+		children[parentID] = append(children[parentID], projects[i].ID)
+	}
+
+	var order []string
+	var walk func(parentID string)
+	walk = func(parentID string) {
+		for _, id := range children[parentID] {
+			order = append(order, id)
+			walk(id)
+		}
+	}
+	walk("")
+	return order
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/ical"
+	"github.com/kyokomi/gotodoist/internal/plaintext"
+)
+
+// setupExportTest は書き出し用のプロジェクト・セクション・タスクを用意する
+func setupExportTest(t *testing.T) *testTaskExecutorSetup {
+	t.Helper()
+
+	setup := setupTestTaskExecutor(t)
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-work", Name: "Work", ChildOrder: 1},
+		{ID: "project-release", Name: "Release", ParentID: "project-work", ChildOrder: 1},
+		{ID: "project-home", Name: "Home", ChildOrder: 2},
+	})
+	insertTestSectionsIntoDB(t, setup.dbPath, []api.Section{
+		{ID: "section-prep", Name: "Prep", ProjectID: "project-release", SectionOrder: 1},
+		{ID: "section-ship", Name: "Ship", ProjectID: "project-release", SectionOrder: 2},
+	})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-run", Content: "Run tests", ProjectID: "project-release", SectionID: "section-prep", ParentID: "task-freeze", ChildOrder: 1, Priority: 1},
+		{ID: "task-freeze", Content: "Freeze branch", ProjectID: "project-release", SectionID: "section-prep", ChildOrder: 1, Priority: 4, Labels: []string{"release"}, Due: &api.Due{Date: "2026-11-02"}},
+		{ID: "task-tag", Content: "Tag release", ProjectID: "project-release", SectionID: "section-ship", ChildOrder: 1, Priority: 1},
+		{ID: "task-done", Content: "Old step", ProjectID: "project-release", Priority: 1, DateCompleted: &api.TodoistTime{Time: time.Now()}},
+		{ID: "task-milk", Content: "Buy milk", ProjectID: "project-home", Priority: 1, Labels: []string{"errand"}},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+	return setup
+}
+
+func TestExecuteExportWithOutput_Project(t *testing.T) {
+	setup := setupExportTest(t)
+	defer setup.cleanup()
+
+	// Act
+	err := setup.executor.executeExportWithOutput(context.Background(), &exportParams{
+		projectIDOrName: "Release",
+		format:          plaintext.FormatMarkdown,
+	})
+
+	// Assert: セクションを見出しに、サブタスクを入れ子のチェックボックスにする（完了済みのタスクは含めない）
+	require.NoError(t, err)
+	assert.Equal(t, `# Work/Release
+
+## Prep
+
+- [ ] Freeze branch #release ⏫ 📅 2026-11-02
+  - [ ] Run tests
+
+## Ship
+
+- [ ] Tag release
+`, setup.stdout.String())
+}
+
+func TestExecuteExportWithOutput_Completed(t *testing.T) {
+	setup := setupExportTest(t)
+	defer setup.cleanup()
+
+	err := setup.executor.executeExportWithOutput(context.Background(), &exportParams{
+		projectIDOrName: "Release",
+		format:          plaintext.FormatOrg,
+		completed:       true,
+	})
+
+	require.NoError(t, err)
+	output := setup.stdout.String()
+	assert.Contains(t, output, "#+TITLE: Work/Release\n- [x] Old step\n* Prep\n")
+	assert.Contains(t, output, "- [ ] [#A] Freeze branch <2026-11-02 Mon> :release:\n")
+}
+
+func TestExecuteExportWithOutput_Filter(t *testing.T) {
+	setup := setupExportTest(t)
+	defer setup.cleanup()
+	path := filepath.Join(t.TempDir(), "tasks.taskpaper")
+
+	// Act
+	err := setup.executor.executeExportWithOutput(context.Background(), &exportParams{
+		filterQuery: "@release | @errand",
+		format:      plaintext.FormatTaskPaper,
+		outputPath:  path,
+	})
+
+	// Assert: プロジェクトごとに、タスクのあるセクションだけを見出しにする
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "@release | @errand:\n"+
+		"\tWork/Release:\n"+
+		"\t\tPrep:\n"+
+		"\t\t\t- Freeze branch @release @priority(1) @due(2026-11-02)\n"+
+		"\tHome:\n"+
+		"\t\t- Buy milk @errand\n", string(data))
+	assert.Contains(t, setup.stdout.String(), "Exported 2 task(s) to "+path)
+}
+
+func TestExecuteExportWithOutput_Errors(t *testing.T) {
+	setup := setupExportTest(t)
+	defer setup.cleanup()
+	ctx := context.Background()
+
+	err := setup.executor.executeExportWithOutput(ctx, &exportParams{format: plaintext.FormatMarkdown})
+	assert.ErrorContains(t, err, "specify either a project or --filter")
+
+	err = setup.executor.executeExportWithOutput(ctx, &exportParams{projectIDOrName: "Release", filterQuery: "today", format: plaintext.FormatMarkdown})
+	assert.ErrorContains(t, err, "specify either a project or --filter")
+
+	err = setup.executor.executeExportWithOutput(ctx, &exportParams{projectIDOrName: "Release", format: "html"})
+	assert.ErrorContains(t, err, "unsupported format: html")
+
+	err = setup.executor.executeExportWithOutput(ctx, &exportParams{filterQuery: "(today", format: plaintext.FormatMarkdown})
+	assert.ErrorContains(t, err, "invalid filter query")
+}
+
+func TestExecuteExportWithOutput_ICS(t *testing.T) {
+	setup := setupExportTest(t)
+	defer setup.cleanup()
+	ctx := context.Background()
+
+	// Act: プロジェクトもフィルタも指定しない場合はすべてのタスク
+	err := setup.executor.executeExportWithOutput(ctx, &exportParams{format: ical.Format})
+
+	// Assert: 期限のあるタスクだけを予定にする
+	require.NoError(t, err)
+	output := setup.stdout.String()
+	assert.Equal(t, 1, strings.Count(output, "BEGIN:VEVENT"))
+	assert.Contains(t, output, "X-WR-CALNAME:Todoist\r\n")
+	assert.Contains(t, output, "UID:task-task-freeze@gotodoist\r\n")
+	assert.Contains(t, output, "DTSTART;VALUE=DATE:20261102\r\n")
+
+	// ToDoにはフィルタに一致するすべてのタスクを含める
+	setup.stdout.Reset()
+	path := filepath.Join(t.TempDir(), "errands.ics")
+	err = setup.executor.executeExportWithOutput(ctx, &exportParams{format: ical.Format, filterQuery: "@errand", component: ical.ComponentTodo, outputPath: path})
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "X-WR-CALNAME:@errand\r\n")
+	assert.Contains(t, string(data), "BEGIN:VTODO\r\nUID:task-task-milk@gotodoist\r\n")
+	assert.Equal(t, 1, strings.Count(string(data), "BEGIN:VTODO"))
+	assert.Contains(t, setup.stdout.String(), "Exported 1 task(s) to "+path)
+
+	err = setup.executor.executeExportWithOutput(ctx, &exportParams{format: ical.Format, component: "journal"})
+	assert.ErrorContains(t, err, "unsupported component: journal")
+}
@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/cli"
+	"github.com/kyokomi/gotodoist/internal/config"
+	"github.com/kyokomi/gotodoist/internal/factory"
+	"github.com/kyokomi/gotodoist/internal/filter"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	// サブコマンドを追加
+	filterCmd.AddCommand(filterListCmd)
+	filterCmd.AddCommand(filterAddCmd)
+	filterCmd.AddCommand(filterUpdateCmd)
+	filterCmd.AddCommand(filterDeleteCmd)
+
+	// filterコマンドをルートコマンドに追加
+	rootCmd.AddCommand(filterCmd)
+
+	// filter add用のフラグ
+	filterAddCmd.Flags().StringP("query", "q", "", "filter query (e.g. 'today & #Work')")
+	filterAddCmd.Flags().StringP("color", "c", "", "filter color")
+	filterAddCmd.Flags().BoolP("favorite", "f", false, "mark as favorite")
+	_ = filterAddCmd.MarkFlagRequired("query")
+
+	// filter update用のフラグ
+	filterUpdateCmd.Flags().StringP("name", "n", "", "new filter name")
+	filterUpdateCmd.Flags().StringP("query", "q", "", "new filter query")
+	filterUpdateCmd.Flags().StringP("color", "c", "", "new filter color")
+
+	// filter delete用のフラグ
+	filterDeleteCmd.Flags().BoolP("force", "f", false, "skip confirmation prompt")
+}
+
+// filterCmd は保存済みフィルタ関連のコマンド
+var filterCmd = &cobra.Command{
+	Use:   "filter",
+	Short: "Manage saved filters",
+	Long: `Manage Todoist saved filters.
+
+Saved filters are synced to the local database and can be evaluated against
+local tasks with 'gotodoist task list --saved <name>'.`,
+}
+
+// filterListCmd は保存済みフィルタの一覧表示コマンド
+var filterListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List saved filters",
+	RunE:    runFilterList,
+}
+
+// filterAddCmd は保存済みフィルタの追加コマンド
+var filterAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a saved filter",
+	Example: `  gotodoist filter add "Sprint board" --query "#Work & (today | overdue)"
+  gotodoist filter add "Errands" -q "@errand | /Shopping" --color green`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFilterAdd,
+}
+
+// filterUpdateCmd は保存済みフィルタの更新コマンド
+var filterUpdateCmd = &cobra.Command{
+	Use:   "update <name or ID>",
+	Short: "Update a saved filter",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runFilterUpdate,
+}
+
+// filterDeleteCmd は保存済みフィルタの削除コマンド
+var filterDeleteCmd = &cobra.Command{
+	Use:   "delete <name or ID>",
+	Short: "Delete a saved filter",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runFilterDelete,
+}
+
+// filterListParams は保存済みフィルタ一覧のパラメータ
+type filterListParams struct {
+	// 現在はパラメータなし
+}
+
+// filterAddParams は保存済みフィルタ追加のパラメータ
+type filterAddParams struct {
+	name       string
+	query      string
+	color      string
+	isFavorite bool
+}
+
+// filterUpdateParams は保存済みフィルタ更新のパラメータ
+type filterUpdateParams struct {
+	filterRef string
+	name      string
+	query     string
+	color     string
+}
+
+// filterDeleteParams は保存済みフィルタ削除のパラメータ
+type filterDeleteParams struct {
+	filterRef string
+	force     bool
+}
+
+// runFilterList は保存済みフィルタ一覧の実際の処理
+func runFilterList(_ *cobra.Command, _ []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupFilterExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// 実行
+	return executor.executeFilterListWithOutput(ctx, &filterListParams{})
+}
+
+// runFilterAdd は保存済みフィルタ追加の実際の処理
+func runFilterAdd(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupFilterExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	query, _ := cmd.Flags().GetString("query")
+	color, _ := cmd.Flags().GetString("color")
+	favorite, _ := cmd.Flags().GetBool("favorite")
+	params := &filterAddParams{
+		name:       args[0],
+		query:      query,
+		color:      color,
+		isFavorite: favorite,
+	}
+	return executor.executeFilterAddWithOutput(ctx, params)
+}
+
+// runFilterUpdate は保存済みフィルタ更新の実際の処理
+func runFilterUpdate(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupFilterExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	name, _ := cmd.Flags().GetString("name")
+	query, _ := cmd.Flags().GetString("query")
+	color, _ := cmd.Flags().GetString("color")
+	params := &filterUpdateParams{
+		filterRef: args[0],
+		name:      name,
+		query:     query,
+		color:     color,
+	}
+	return executor.executeFilterUpdateWithOutput(ctx, params)
+}
+
+// runFilterDelete は保存済みフィルタ削除の実際の処理
+func runFilterDelete(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupFilterExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	force, _ := cmd.Flags().GetBool("force")
+	return executor.executeFilterDeleteWithOutput(ctx, &filterDeleteParams{filterRef: args[0], force: force})
+}
+
+// executeFilterListWithOutput は保存済みフィルタ一覧の取得と表示を実行する（テスト可能）
+func (e *filterExecutor) executeFilterListWithOutput(ctx context.Context, _ *filterListParams) error {
+	// 1. フィルタを取得
+	filters, err := e.repository.GetFilters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get filters: %w", err)
+	}
+
+	// 2. 結果表示
+	if len(filters) == 0 {
+		e.output.Infof("📭 No saved filters found")
+		return nil
+	}
+
+	e.output.Listf("Found %d saved filter(s):", len(filters))
+	for i := range filters {
+		favorite := ""
+		if filters[i].IsFavorite {
+			favorite = " ⭐"
+		}
+		e.output.Plainf("  🔍 %s%s: %s (ID: %s)", filters[i].Name, favorite, filters[i].Query, filters[i].ID)
+	}
+	e.output.Plainf("")
+	e.output.Infof("💡 Use 'gotodoist task list --saved <name>' to list matching tasks")
+
+	return nil
+}
+
+// executeFilterAddWithOutput は保存済みフィルタの追加と結果表示を実行する（テスト可能）
+func (e *filterExecutor) executeFilterAddWithOutput(ctx context.Context, params *filterAddParams) error {
+	// 1. フィルタ作成実行
+	resp, err := e.repository.CreateFilter(ctx, &api.CreateFilterRequest{
+		Name:       params.name,
+		Query:      params.query,
+		Color:      params.color,
+		IsFavorite: params.isFavorite,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add filter: %w", err)
+	}
+
+	// 2. 結果表示
+	e.output.Successf("Filter %q added successfully!", params.name)
+	e.warnUnsupportedQuery(params.query)
+	if IsVerbose() && resp.SyncToken != "" {
+		e.output.Plainf("Sync token: %s", resp.SyncToken)
+	}
+
+	return nil
+}
+
+// executeFilterUpdateWithOutput は保存済みフィルタの更新と結果表示を実行する（テスト可能）
+func (e *filterExecutor) executeFilterUpdateWithOutput(ctx context.Context, params *filterUpdateParams) error {
+	// 1. 対象フィルタを解決
+	target, err := e.repository.FindFilter(ctx, params.filterRef)
+	if err != nil {
+		return err
+	}
+
+	// 2. フィルタ更新実行
+	resp, err := e.repository.UpdateFilter(ctx, target.ID, &api.UpdateFilterRequest{
+		Name:  params.name,
+		Query: params.query,
+		Color: params.color,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update filter: %w", err)
+	}
+
+	// 3. 結果表示
+	e.output.Successf("Filter %q updated successfully!", target.Name)
+	if params.query != "" {
+		e.warnUnsupportedQuery(params.query)
+	}
+	if IsVerbose() && resp.SyncToken != "" {
+		e.output.Plainf("Sync token: %s", resp.SyncToken)
+	}
+
+	return nil
+}
+
+// executeFilterDeleteWithOutput は保存済みフィルタの削除と結果表示を実行する（テスト可能）
+func (e *filterExecutor) executeFilterDeleteWithOutput(ctx context.Context, params *filterDeleteParams) error {
+	// 1. 対象フィルタを解決
+	target, err := e.repository.FindFilter(ctx, params.filterRef)
+	if err != nil {
+		return err
+	}
+
+	// 2. 確認プロンプト（forceフラグが無い場合）
+	if !params.force {
+		e.output.PlainNoNewlinef("Delete saved filter %q (%s)? (y/N): ", target.Name, target.Query)
+		confirmation, err := readPromptLine()
+		if err != nil || (confirmation != "y" && confirmation != "Y") {
+			e.output.Errorf("Filter deletion canceled")
+			return nil
+		}
+	}
+
+	// 3. フィルタ削除実行
+	if _, err := e.repository.DeleteFilter(ctx, target.ID); err != nil {
+		return fmt.Errorf("failed to delete filter: %w", err)
+	}
+
+	// 4. 結果表示
+	e.output.Successf("Filter %q deleted successfully!", target.Name)
+
+	return nil
+}
+
+// warnUnsupportedQuery はローカルで評価できないクエリの場合に警告を表示する
+// Todoist上では使えるため、作成・更新自体は行う
+func (e *filterExecutor) warnUnsupportedQuery(query string) {
+	if _, err := filter.Parse(query); err != nil {
+		e.output.Warningf("This query cannot be evaluated by 'task list --saved': %v", err)
+	}
+}
+
+// filterExecutor は保存済みフィルタ操作に必要な情報をまとめた構造体
+type filterExecutor struct {
+	cfg        *config.Config
+	repository *repository.Repository
+	output     *cli.Output
+}
+
+// setupFilterExecution は保存済みフィルタ操作の実行環境をセットアップする
+func setupFilterExecution(ctx context.Context) (*filterExecutor, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	cfg.DryRun = IsDryRun()
+
+	output := newOutput(cfg.DryRun, os.Stdout, os.Stderr)
+
+	repo, err := factory.NewRepository(cfg, IsVerbose())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	// ローカルストレージが有効な場合のみ初期化
+	if cfg.LocalStorage.Enabled {
+		if err := repo.Initialize(ctx); err != nil {
+			if closeErr := repo.Close(); closeErr != nil {
+				output.Warningf("failed to close repository after initialization error: %v", closeErr)
+			}
+			return nil, fmt.Errorf("failed to initialize repository: %w", err)
+		}
+	}
+
+	return &filterExecutor{
+		cfg:        cfg,
+		repository: repo,
+		output:     output,
+	}, nil
+}
+
+// cleanup はRepositoryのリソースクリーンアップを行う
+func (e *filterExecutor) cleanup() {
+	if err := e.repository.Close(); err != nil {
+		e.output.Warningf("failed to close repository: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// setupTestFilterExecutors はテスト用のtaskExecutorとfilterExecutorをセットアップするヘルパー関数
+func setupTestFilterExecutors(t *testing.T) (*testExecutorSetup, *taskExecutor, *filterExecutor) {
+	t.Helper()
+
+	base := setupTestExecutorBase(t)
+	taskExec := &taskExecutor{cfg: base.cfg, repository: base.repository, output: base.output}
+	filterExec := &filterExecutor{cfg: base.cfg, repository: base.repository, output: base.output}
+	return base, taskExec, filterExec
+}
+
+func TestExecuteTaskListWithOutput_SavedFilter(t *testing.T) {
+	// Arrange
+	setup, taskExec, _ := setupTestFilterExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-work", Name: "Work"},
+		{ID: "project-api", Name: "API", ParentID: "project-work"},
+		{ID: "project-home", Name: "Home"},
+	})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-api", Content: "Fix login bug", Priority: 4},
+		{ID: "task-2", ProjectID: "project-work", Content: "Update roadmap", Priority: 1},
+		{ID: "task-3", ProjectID: "project-home", Content: "Water plants", Priority: 4},
+	})
+	insertTestFiltersIntoDB(t, setup.dbPath, []api.Filter{
+		{ID: "filter-1", Name: "Sprint board", Query: "##Work & p1"},
+	})
+
+	// Act: 名前の大文字小文字は区別しない
+	err := taskExec.executeTaskListWithOutput(context.Background(), &taskListParams{savedFilter: "sprint board"})
+
+	// Assert
+	require.NoError(t, err)
+	output := setup.stdout.String()
+	assert.Contains(t, output, "Sprint board: ##Work & p1")
+	assert.Contains(t, output, "Found 1 task(s):")
+	assert.Contains(t, output, "Fix login bug")
+	assert.NotContains(t, output, "Update roadmap")
+	assert.NotContains(t, output, "Water plants")
+}
+
+func TestExecuteTaskListWithOutput_SavedFilterErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		savedFilter   string
+		expectedError string
+	}{
+		{name: "存在しないフィルタ", savedFilter: "Missing", expectedError: "filter not found"},
+		{name: "ローカルで評価できないクエリ", savedFilter: "Shared", expectedError: `unsupported filter term "shared"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup, taskExec, _ := setupTestFilterExecutors(t)
+			defer setup.cleanup()
+
+			insertTestFiltersIntoDB(t, setup.dbPath, []api.Filter{{ID: "filter-1", Name: "Shared", Query: "shared"}})
+
+			// Act
+			err := taskExec.executeTaskListWithOutput(context.Background(), &taskListParams{savedFilter: tt.savedFilter})
+
+			// Assert
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
+
+func TestExecuteFilterAddWithOutput(t *testing.T) {
+	// Arrange
+	setup, _, filterExec := setupTestFilterExecutors(t)
+	defer setup.cleanup()
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	var created *api.CreateFilterRequest
+	setup.mockClient.CreateFilterFunc = func(_ context.Context, req *api.CreateFilterRequest) (*api.SyncResponse, error) {
+		created = req
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+	setup.mockClient.SyncFunc = func(_ context.Context, _ *api.SyncRequest) (*api.SyncResponse, error) {
+		return &api.SyncResponse{
+			SyncToken: "next-token",
+			Filters:   []api.Filter{{ID: "filter-1", Name: "Sprint board", Query: "#Work & today"}},
+		}, nil
+	}
+
+	// Act
+	err := filterExec.executeFilterAddWithOutput(context.Background(), &filterAddParams{name: "Sprint board", query: "#Work & today"})
+
+	// Assert: 作成したフィルタが同期でローカルに反映される
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, "Sprint board", created.Name)
+	assert.Equal(t, "#Work & today", created.Query)
+	assert.Contains(t, setup.stdout.String(), `Filter "Sprint board" added successfully!`)
+
+	setup.stdout.Reset()
+	require.NoError(t, filterExec.executeFilterListWithOutput(context.Background(), &filterListParams{}))
+	assert.Contains(t, setup.stdout.String(), "Sprint board: #Work & today (ID: filter-1)")
+}
+
+func TestExecuteFilterAddWithOutput_WarnsUnsupportedQuery(t *testing.T) {
+	// Arrange
+	setup, _, filterExec := setupTestFilterExecutors(t)
+	defer setup.cleanup()
+
+	// Act: Todoistでは有効でもローカルで評価できないクエリ
+	err := filterExec.executeFilterAddWithOutput(context.Background(), &filterAddParams{name: "Team", query: "shared & assigned to: others"})
+
+	// Assert: 作成は行い、警告を表示する
+	require.NoError(t, err)
+	assert.Contains(t, setup.stdout.String(), `Filter "Team" added successfully!`)
+	assert.Contains(t, setup.stderr.String(), "cannot be evaluated by 'task list --saved'")
+}
+
+func TestExecuteFilterUpdateAndDeleteWithOutput(t *testing.T) {
+	// Arrange
+	setup, _, filterExec := setupTestFilterExecutors(t)
+	defer setup.cleanup()
+
+	insertTestFiltersIntoDB(t, setup.dbPath, []api.Filter{
+		{ID: "filter-1", Name: "Sprint board", Query: "#Work"},
+		{ID: "filter-2", Name: "Errands", Query: "@errand"},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	var updatedID string
+	var updateReq *api.UpdateFilterRequest
+	setup.mockClient.UpdateFilterFunc = func(_ context.Context, filterID string, req *api.UpdateFilterRequest) (*api.SyncResponse, error) {
+		updatedID, updateReq = filterID, req
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+	var deletedID string
+	setup.mockClient.DeleteFilterFunc = func(_ context.Context, filterID string) (*api.SyncResponse, error) {
+		deletedID = filterID
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+
+	// Act: 名前で指定して更新・削除
+	require.NoError(t, filterExec.executeFilterUpdateWithOutput(context.Background(), &filterUpdateParams{filterRef: "Sprint board", query: "#Work & p1"}))
+	require.NoError(t, filterExec.executeFilterDeleteWithOutput(context.Background(), &filterDeleteParams{filterRef: "errands", force: true}))
+
+	// Assert
+	assert.Equal(t, "filter-1", updatedID)
+	require.NotNil(t, updateReq)
+	assert.Equal(t, "#Work & p1", updateReq.Query)
+	assert.Equal(t, "filter-2", deletedID)
+
+	setup.stdout.Reset()
+	require.NoError(t, filterExec.executeFilterListWithOutput(context.Background(), &filterListParams{}))
+	assert.Contains(t, setup.stdout.String(), "Found 1 saved filter(s):")
+	assert.NotContains(t, setup.stdout.String(), "Errands")
+}
+
+func TestExecuteFilterChangesAreUndoable(t *testing.T) {
+	// Arrange: 保存済みフィルタを作成・更新・削除しておく
+	setup, _, filterExec := setupTestFilterExecutors(t)
+	defer setup.cleanup()
+	undoExec := &undoExecutor{cfg: setup.cfg, repository: setup.repository, output: setup.output}
+
+	insertTestFiltersIntoDB(t, setup.dbPath, []api.Filter{
+		{ID: "filter-1", Name: "Sprint board", Query: "#Work", Color: "red"},
+		{ID: "filter-2", Name: "Errands", Query: "@errand", IsFavorite: true},
+	})
+	setup.mockClient.CreateFilterFunc = func(_ context.Context, _ *api.CreateFilterRequest) (*api.SyncResponse, error) {
+		return &api.SyncResponse{SyncToken: "command-token", TempIDMapping: map[string]string{"temp-1": "filter-3"}}, nil
+	}
+
+	ctx := context.Background()
+	require.NoError(t, filterExec.executeFilterAddWithOutput(ctx, &filterAddParams{name: "Today", query: "today"}))
+	require.NoError(t, filterExec.executeFilterUpdateWithOutput(ctx, &filterUpdateParams{filterRef: "Sprint board", query: "#Work & p1"}))
+	require.NoError(t, filterExec.executeFilterDeleteWithOutput(ctx, &filterDeleteParams{filterRef: "Errands", force: true}))
+	executed := captureCommands(setup.mockClient)
+	setup.stdout.Reset()
+
+	// Act
+	err := undoExec.executeUndoWithOutput(ctx, &undoParams{count: 3})
+
+	// Assert: 削除は再作成、更新は変更前の内容に戻し、作成は削除する
+	require.NoError(t, err)
+	require.Len(t, *executed, 3)
+	assert.Equal(t, api.CommandFilterAdd, (*executed)[0].Type)
+	assert.Equal(t, "Errands", (*executed)[0].Args["name"])
+	assert.Equal(t, "@errand", (*executed)[0].Args["query"])
+	assert.Equal(t, true, (*executed)[0].Args["is_favorite"])
+	assert.Equal(t, api.CommandFilterUpdate, (*executed)[1].Type)
+	assert.Equal(t, "filter-1", (*executed)[1].Args["id"])
+	assert.Equal(t, "#Work", (*executed)[1].Args["query"])
+	assert.Equal(t, api.CommandFilterDelete, (*executed)[2].Type)
+	assert.Equal(t, "filter-3", (*executed)[2].Args["id"])
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, `Undone: delete filter "Errands"`)
+	assert.Contains(t, output, `Undone: update filter "Sprint board"`)
+	assert.Contains(t, output, `Undone: create filter "Today"`)
+}
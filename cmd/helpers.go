@@ -1,9 +1,27 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/cli"
 )
 
+// promptReader は対話プロンプトの入力元（テストで差し替え可能）
+var promptReader = bufio.NewReader(os.Stdin)
+
+// readPromptLine は対話プロンプトから1行読み取り、前後の空白を除いて返す
+func readPromptLine() (string, error) {
+	line, err := promptReader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
 // createBaseContext は統一されたベースコンテキストを作成する
 func createBaseContext() context.Context {
 	return context.Background()
@@ -24,6 +42,20 @@ func IsShowBenchmark() bool {
 	return globalFlags.ShowBenchmark
 }
 
+// IsDryRun はドライランモードかどうかを返す
+func IsDryRun() bool {
+	return globalFlags.DryRun
+}
+
+// newOutput はコマンドの出力を作成する
+// ドライラン時は標準出力を送信予定のコマンドのJSONだけにするため、メッセージも標準エラー出力に出す
+func newOutput(dryRun bool, stdout, stderr io.Writer) *cli.Output {
+	if dryRun {
+		stdout = stderr
+	}
+	return cli.NewWithWriters(stdout, stderr, IsVerbose())
+}
+
 // maskToken はトークンの一部を隠す
 func maskToken(token string) string {
 	if token == "" {
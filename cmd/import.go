@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/backup"
+	"github.com/kyokomi/gotodoist/internal/plaintext"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	// import用のフラグ
+	importCmd.Flags().String("from", "", "source format: "+strings.Join(plaintext.ImportFormats, ", ")+" (required)")
+	importCmd.Flags().StringP("project", "p", "", "project for tasks without a project (default: Inbox)")
+	importCmd.Flags().Bool("preview", false, "show what would be created without changing anything")
+	importCmd.Flags().Bool("skip-completed", false, "do not import completed tasks")
+	_ = importCmd.MarkFlagRequired("from")
+}
+
+// importCmd はテキスト形式のタスク管理ツールからの取り込みコマンド
+var importCmd = &cobra.Command{
+	Use:   "import --from <format> <file>",
+	Short: "Import tasks from todo.txt, Taskwarrior or TaskPaper",
+	Long: `Import tasks from plain-text task managers in a single batched request.
+
+  todotxt      todo.txt: (A)-(C) priorities, +project, @context labels, due:YYYY-MM-DD
+  taskwarrior  JSON from 'task export': project (Home.Garden), tags, H/M/L priorities, due, annotations
+  taskpaper    "Project:" lines, "- task" lines (indented for subtasks), @due(...), @priority(1-4), @done, @tags
+
+Projects that do not exist are created (nested projects are matched level by level).
+Completed tasks are created and completed, unless --skip-completed is given.
+
+Imported tasks are recorded in <file>.imported.json, so running the import again
+only creates tasks that were added to the file since.`,
+	Example: `  gotodoist import --from todotxt todo.txt --preview
+  gotodoist import --from taskwarrior tasks.json --skip-completed
+  gotodoist import --from taskpaper work.taskpaper --project Work`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImport,
+}
+
+// importParams はタスク取り込みのパラメータ
+type importParams struct {
+	path          string
+	format        string
+	project       string
+	preview       bool
+	skipCompleted bool
+}
+
+// getImportParams はタスク取り込みのパラメータを取得する
+func getImportParams(cmd *cobra.Command, args []string) *importParams {
+	format, _ := cmd.Flags().GetString("from")
+	project, _ := cmd.Flags().GetString("project")
+	preview, _ := cmd.Flags().GetBool("preview")
+	skipCompleted, _ := cmd.Flags().GetBool("skip-completed")
+	return &importParams{
+		path:          args[0],
+		format:        format,
+		project:       project,
+		preview:       preview,
+		skipCompleted: skipCompleted,
+	}
+}
+
+// runImport はタスク取り込みの実際の処理
+func runImport(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getImportParams(cmd, args)
+	return executor.executeImportWithOutput(ctx, params)
+}
+
+// executeImportWithOutput はタスクの取り込みと結果表示を実行する（テスト可能）
+func (e *taskExecutor) executeImportWithOutput(ctx context.Context, params *importParams) error {
+	// 1. ファイルを読み込む
+	file, err := os.Open(params.path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", params.path, err)
+	}
+	defer func() { _ = file.Close() }()
+	tasks, err := plaintext.Parse(params.format, file, time.Local)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", params.path, err)
+	}
+	if len(tasks) == 0 {
+		return fmt.Errorf("no tasks found in %s", params.path)
+	}
+
+	// 2. 取り込み済みのタスクを除いて取り込み計画を作成
+	importedPath := params.path + ".imported.json"
+	imported, err := backup.ReadIDMap(importedPath)
+	if err != nil {
+		return err
+	}
+	opts := repository.TaskImportOptions{Imported: imported, SkipCompleted: params.skipCompleted}
+	if params.project != "" {
+		if opts.ProjectID, err = e.findProjectIDByName(ctx, params.project); err != nil {
+			return err
+		}
+	}
+	plan, err := e.repository.PlanTaskImport(ctx, tasks, opts)
+	if err != nil {
+		return fmt.Errorf("failed to plan import: %w", err)
+	}
+
+	// 3. プレビューでは作成するものを表示するだけ
+	if params.preview {
+		e.displayImportPlan(plan)
+		return nil
+	}
+	if len(plan.Tasks) == 0 {
+		e.output.Infof("Nothing to import: all %d task(s) were already imported or skipped", plan.Skipped)
+		return nil
+	}
+
+	// 4. 取り込み実行
+	result, importErr := e.repository.ImportTasks(ctx, plan)
+	if result == nil {
+		return fmt.Errorf("failed to import tasks: %w", importErr)
+	}
+
+	// 5. 作成したタスクを記録（再実行で重複して作成しないように）
+	if !e.cfg.DryRun && len(result.IDs) > 0 {
+		for key, id := range result.IDs {
+			imported[key] = id
+		}
+		if err := backup.WriteIDMap(importedPath, imported); err != nil {
+			e.output.Warningf("Failed to write %s: %v", importedPath, err)
+		}
+	}
+	if importErr != nil {
+		e.output.Warningf("The tasks were imported partially (run the import again to retry, or 'gotodoist undo' to remove them)")
+		return fmt.Errorf("failed to import tasks: %w", importErr)
+	}
+
+	// 6. 結果表示
+	e.output.Successf("📥 Imported %d task(s) from %s", result.Tasks, params.path)
+	if result.Projects > 0 {
+		e.output.Plainf("   New projects: %d", result.Projects)
+	}
+	if result.Completed > 0 {
+		e.output.Plainf("   Completed: %d", result.Completed)
+	}
+	if plan.Skipped > 0 {
+		e.output.Plainf("   Skipped: %d (already imported or completed)", plan.Skipped)
+	}
+	if IsVerbose() && result.Response != nil {
+		e.output.Plainf("Sync token: %s", result.Response.SyncToken)
+	}
+	return nil
+}
+
+// displayImportPlan は取り込みで作成するプロジェクト・タスクを表示する
+func (e *taskExecutor) displayImportPlan(plan *repository.TaskImportPlan) {
+	e.output.Infof("Preview: %d task(s) and %d project(s) would be created, %d skipped", len(plan.Tasks), len(plan.Projects), plan.Skipped)
+	for _, path := range plan.Projects {
+		e.output.Plainf("  + project %s", path)
+	}
+	for i := range plan.Tasks {
+		planned := &plan.Tasks[i]
+		task := &planned.Task
+
+		var details []string
+		if task.Priority > 1 {
+			details = append(details, fmt.Sprintf("p%d", 5-task.Priority))
+		}
+		if task.Due != "" {
+			details = append(details, "due "+strings.Replace(task.Due, "T", " ", 1))
+		}
+		for _, label := range task.Labels {
+			details = append(details, "@"+label)
+		}
+		if task.Completed {
+			details = append(details, "completed")
+		}
+		line := fmt.Sprintf("  + task    %s / %s%s", planned.Project, strings.Repeat("  ", planned.Depth), task.Content)
+		if len(details) > 0 {
+			line += " (" + strings.Join(details, ", ") + ")"
+		}
+		e.output.Plainf("%s", line)
+	}
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/backup"
+	"github.com/kyokomi/gotodoist/internal/plaintext"
+)
+
+// testTodoTxt はテスト用のtodo.txt
+const testTodoTxt = `(A) Call mom +Family @phone due:2026-10-20
+x 2026-10-05 Pay rent +home
+Buy milk
+`
+
+// setupImportTest は取り込み先のプロジェクトとtodo.txtを用意する
+func setupImportTest(t *testing.T) (*testTaskExecutorSetup, string) {
+	t.Helper()
+
+	setup := setupTestTaskExecutor(t)
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-inbox", Name: "Inbox", InboxProject: true},
+		{ID: "project-home", Name: "Home"},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	path := filepath.Join(t.TempDir(), "todo.txt")
+	require.NoError(t, os.WriteFile(path, []byte(testTodoTxt), 0o600))
+	return setup, path
+}
+
+func TestExecuteImportWithOutput(t *testing.T) {
+	setup, path := setupImportTest(t)
+	defer setup.cleanup()
+	sent := recordImportCommands(setup.mockClient)
+
+	// Act
+	err := setup.executor.executeImportWithOutput(context.Background(), &importParams{path: path, format: plaintext.FormatTodoTxt})
+
+	// Assert: 存在しないプロジェクトを作成し、完了済みのタスクは作成後に完了にする
+	require.NoError(t, err)
+	commands := *sent
+	require.Len(t, commands, 5)
+	project := commands[0]
+	assert.Equal(t, api.CommandProjectAdd, project.Type)
+	assert.Equal(t, "Family", project.Args["name"])
+
+	tasks := commandsByContent(commands)
+	call := tasks["Call mom"]
+	assert.Equal(t, project.TempID, call.Args["project_id"])
+	assert.Equal(t, []string{"phone"}, call.Args["labels"])
+	assert.Equal(t, 4, call.Args["priority"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-10-20"}, call.Args["due"])
+
+	rent := tasks["Pay rent"]
+	assert.Equal(t, "project-home", rent.Args["project_id"], "プロジェクトは大文字小文字を区別せずに既存のものを使うべきです")
+	assert.Equal(t, api.CommandItemComplete, commands[3].Type)
+	assert.Equal(t, rent.TempID, commands[3].Args["id"])
+	assert.Equal(t, "project-inbox", tasks["Buy milk"].Args["project_id"])
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, "Imported 3 task(s)")
+	assert.Contains(t, output, "New projects: 1")
+	assert.Contains(t, output, "Completed: 1")
+
+	// 取り込んだタスクを記録する
+	imported, err := backup.ReadIDMap(path + ".imported.json")
+	require.NoError(t, err)
+	assert.Len(t, imported, 3)
+
+	// 取り込みを取り消せる（作成したプロジェクトと既存のプロジェクトのタスク）
+	entries, err := setup.repository.ListUndoEntries(1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "create 3 items", entries[0].Description)
+}
+
+func TestExecuteImportWithOutput_Rerun(t *testing.T) {
+	setup, path := setupImportTest(t)
+	defer setup.cleanup()
+	sent := recordImportCommands(setup.mockClient)
+	require.NoError(t, setup.executor.executeImportWithOutput(context.Background(), &importParams{path: path, format: plaintext.FormatTodoTxt}))
+
+	// Arrange: ファイルにタスクを1件追加する
+	require.NoError(t, os.WriteFile(path, []byte(testTodoTxt+"Book dentist +Family\n"), 0o600))
+	*sent = nil
+	setup.stdout.Reset()
+
+	// Act
+	err := setup.executor.executeImportWithOutput(context.Background(), &importParams{path: path, format: plaintext.FormatTodoTxt})
+
+	// Assert: 取り込み済みのタスクは作成しない
+	require.NoError(t, err)
+	tasks := commandsByContent(*sent)
+	require.Len(t, tasks, 1)
+	assert.Contains(t, tasks, "Book dentist")
+	assert.Contains(t, setup.stdout.String(), "Skipped: 3")
+
+	// すべて取り込み済みの場合は何もしない
+	*sent = nil
+	setup.stdout.Reset()
+	require.NoError(t, setup.executor.executeImportWithOutput(context.Background(), &importParams{path: path, format: plaintext.FormatTodoTxt}))
+	assert.Empty(t, *sent)
+	assert.Contains(t, setup.stdout.String(), "Nothing to import")
+}
+
+func TestExecuteImportWithOutput_Preview(t *testing.T) {
+	setup, path := setupImportTest(t)
+	defer setup.cleanup()
+	sent := recordImportCommands(setup.mockClient)
+
+	// Act
+	err := setup.executor.executeImportWithOutput(context.Background(), &importParams{
+		path: path, format: plaintext.FormatTodoTxt, preview: true, skipCompleted: true,
+	})
+
+	// Assert: 何も送信せず、ファイルも作成しない
+	require.NoError(t, err)
+	assert.Empty(t, *sent)
+	_, err = os.Stat(path + ".imported.json")
+	assert.True(t, os.IsNotExist(err))
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, "2 task(s) and 1 project(s) would be created, 1 skipped")
+	assert.Contains(t, output, "+ project Family")
+	assert.Contains(t, output, "+ task    Family / Call mom (p1, due 2026-10-20, @phone)")
+	assert.Contains(t, output, "+ task    Inbox / Buy milk")
+	assert.NotContains(t, output, "Pay rent")
+}
+
+func TestExecuteImportWithOutput_TaskPaperSubtasks(t *testing.T) {
+	setup, _ := setupImportTest(t)
+	defer setup.cleanup()
+	sent := recordImportCommands(setup.mockClient)
+	path := filepath.Join(t.TempDir(), "work.taskpaper")
+	require.NoError(t, os.WriteFile(path, []byte("Home:\n\t- Clean garage\n\t\t- Sort tools\n"), 0o600))
+
+	err := setup.executor.executeImportWithOutput(context.Background(), &importParams{path: path, format: plaintext.FormatTaskPaper})
+
+	require.NoError(t, err)
+	tasks := commandsByContent(*sent)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "project-home", tasks["Clean garage"].Args["project_id"])
+	assert.Equal(t, tasks["Clean garage"].TempID, tasks["Sort tools"].Args["parent_id"])
+
+	// サブタスクは親タスクと一緒に取り消されるため、親タスクのみ記録する
+	entries, err := setup.repository.ListUndoEntries(1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, `create task "Clean garage"`, entries[0].Description)
+}
+
+func TestExecuteImportWithOutput_Errors(t *testing.T) {
+	setup, path := setupImportTest(t)
+	defer setup.cleanup()
+
+	err := setup.executor.executeImportWithOutput(context.Background(), &importParams{path: path, format: "csv"})
+	assert.ErrorContains(t, err, "unsupported format: csv")
+
+	empty := filepath.Join(t.TempDir(), "empty.txt")
+	require.NoError(t, os.WriteFile(empty, []byte("\n"), 0o600))
+	err = setup.executor.executeImportWithOutput(context.Background(), &importParams{path: empty, format: plaintext.FormatTodoTxt})
+	assert.ErrorContains(t, err, "no tasks found")
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -619,8 +620,9 @@ func setupProjectExecution(ctx context.Context) (*projectExecutor, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
+	cfg.DryRun = IsDryRun()
 
-	output := cli.New(IsVerbose())
+	output := newOutput(cfg.DryRun, os.Stdout, os.Stderr)
 
 	repo, err := factory.NewRepository(cfg, IsVerbose())
 	if err != nil {
@@ -808,8 +810,7 @@ func (e *projectExecutor) promptProjectDeletionConfirmation(project *api.Project
 	}
 	e.output.PlainNoNewlinef("Enter your choice: ")
 
-	var confirmation string
-	_, err := fmt.Scanln(&confirmation)
+	confirmation, err := readPromptLine()
 	if err != nil {
 		e.output.Errorf("Project deletion canceled")
 		return false
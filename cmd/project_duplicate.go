@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	projectCmd.AddCommand(projectDuplicateCmd)
+
+	// project duplicate用のフラグ
+	projectDuplicateCmd.Flags().StringP("name", "n", "", `name of the new project (default "<source> (copy)")`)
+	projectDuplicateCmd.Flags().StringP("parent", "p", "", "parent project ID or name (default: same parent as the source)")
+	projectDuplicateCmd.Flags().String("shift", "", "shift due dates and deadlines (e.g. 7d, -3d, 2w)")
+	projectDuplicateCmd.Flags().String("start", "", "shift due dates so that the earliest one falls on this date (YYYY-MM-DD)")
+}
+
+// projectDuplicateCmd はプロジェクト複製コマンド
+var projectDuplicateCmd = &cobra.Command{
+	Use:   "duplicate <project ID or name>",
+	Short: "Duplicate a project with its sections and tasks",
+	Long: `Duplicate a project with its sections and open tasks (including subtasks).
+
+Labels, descriptions, priorities, durations, due dates and deadlines are copied.
+Recurring due dates are kept as they are; other due dates and deadlines can be
+shifted with --shift, or anchored with --start so that the earliest due date
+falls on the given day while keeping the relative spacing.
+
+Everything is created in a single batched request.`,
+	Example: `  gotodoist project duplicate "Release checklist" --name "Release 2.4"
+  gotodoist project duplicate "Release checklist" --name "Release 2.4" --parent Releases --start 2026-11-02
+  gotodoist project duplicate Sprint --shift 2w`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectDuplicate,
+}
+
+// projectDuplicateParams はプロジェクト複製のパラメータ
+type projectDuplicateParams struct {
+	projectIDOrName string
+	name            string
+	parent          string
+	shift           string
+	start           string
+}
+
+// getProjectDuplicateParams はプロジェクト複製のパラメータを取得する
+func getProjectDuplicateParams(cmd *cobra.Command, args []string) *projectDuplicateParams {
+	name, _ := cmd.Flags().GetString("name")
+	parent, _ := cmd.Flags().GetString("parent")
+	shift, _ := cmd.Flags().GetString("shift")
+	start, _ := cmd.Flags().GetString("start")
+	return &projectDuplicateParams{
+		projectIDOrName: args[0],
+		name:            name,
+		parent:          parent,
+		shift:           shift,
+		start:           start,
+	}
+}
+
+// runProjectDuplicate はプロジェクト複製の実際の処理
+func runProjectDuplicate(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getProjectDuplicateParams(cmd, args)
+	return executor.executeProjectDuplicateWithOutput(ctx, params)
+}
+
+// executeProjectDuplicateWithOutput はプロジェクト複製と結果表示を実行する（テスト可能）
+func (e *projectExecutor) executeProjectDuplicateWithOutput(ctx context.Context, params *projectDuplicateParams) error {
+	shift, err := parseDueShift(params.shift, params.start)
+	if err != nil {
+		return err
+	}
+
+	// 1. 複製元と複製先の親プロジェクトを解決
+	source, err := e.resolveProject(ctx, params.projectIDOrName)
+	if err != nil {
+		return err
+	}
+	opts := repository.DuplicateProjectOptions{
+		Name:     params.name,
+		ParentID: source.ParentID,
+		DueShift: shift,
+	}
+	if opts.Name == "" {
+		opts.Name = source.Name + " (copy)"
+	}
+	if params.parent != "" {
+		parent, err := e.resolveProject(ctx, params.parent)
+		if err != nil {
+			return fmt.Errorf("failed to find parent project: %w", err)
+		}
+		opts.ParentID = parent.ID
+	}
+
+	// 2. 複製実行
+	result, err := e.repository.DuplicateProject(ctx, source.ID, opts)
+	if err != nil {
+		if result != nil && result.ProjectID != "" {
+			e.output.Warningf("Project %q was created but some items could not be copied (run 'gotodoist undo' to remove it)", opts.Name)
+		}
+		return fmt.Errorf("failed to duplicate project: %w", err)
+	}
+
+	// 3. 結果表示
+	e.displaySuccessMessageForProject(fmt.Sprintf("📁 Duplicated project %q as %q", source.Name, opts.Name), result.Response.SyncToken)
+	e.output.Plainf("   ID: %s", result.ProjectID)
+	e.output.Plainf("   Sections: %d", result.Sections)
+	e.output.Plainf("   Tasks: %d", result.Tasks)
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// setupProjectDuplicateTest は複製元のプロジェクト・セクション・タスクを登録し、送信したコマンドを記録する
+func setupProjectDuplicateTest(t *testing.T) (*testProjectExecutorSetup, *[]api.Command) {
+	t.Helper()
+
+	setup := setupTestProjectExecutor(t)
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-releases", Name: "Releases"},
+		{ID: "project-release", Name: "Release checklist", ParentID: "project-releases", Color: "blue"},
+		{ID: "project-other", Name: "Other"},
+	})
+	insertTestSectionsIntoDB(t, setup.dbPath, []api.Section{
+		{ID: "section-prep", Name: "Prep", ProjectID: "project-release", SectionOrder: 1},
+		{ID: "section-ship", Name: "Ship", ProjectID: "project-release", SectionOrder: 2},
+		{ID: "section-other", Name: "Other", ProjectID: "project-other", SectionOrder: 1},
+	})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-run", Content: "Run tests", ProjectID: "project-release", SectionID: "section-prep", ParentID: "task-freeze", ChildOrder: 1, Due: &api.Due{Date: "2026-11-03T10:00:00"}},
+		{ID: "task-freeze", Content: "Freeze branch", Description: "Announce in #release", ProjectID: "project-release", SectionID: "section-prep", ChildOrder: 1, Priority: 4, Labels: []string{"release"}, Due: &api.Due{Date: "2026-11-02"}},
+		{ID: "task-tag", Content: "Tag release", ProjectID: "project-release", SectionID: "section-ship", ChildOrder: 1, Deadline: &api.Deadline{Date: "2026-11-05"}},
+		{ID: "task-sync", Content: "Weekly sync", ProjectID: "project-release", ChildOrder: 2, Due: &api.Due{Date: "2026-11-04", String: "every wednesday", IsRecurring: true}},
+		{ID: "task-done", Content: "Old step", ProjectID: "project-release", DateCompleted: &api.TodoistTime{Time: time.Now()}},
+		{ID: "task-other", Content: "Unrelated", ProjectID: "project-other", SectionID: "section-other"},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	var sent []api.Command
+	setup.mockClient.ExecuteCommandsFunc = func(_ context.Context, commands []api.Command) (*api.SyncResponse, error) {
+		sent = append(sent, commands...)
+		resp := &api.SyncResponse{
+			SyncToken:     "command-token",
+			SyncStatus:    make(map[string]interface{}),
+			TempIDMapping: make(map[string]string),
+		}
+		for i, cmd := range commands {
+			resp.SyncStatus[cmd.UUID] = "ok"
+			resp.TempIDMapping[cmd.TempID] = fmt.Sprintf("new-%d", i)
+		}
+		return resp, nil
+	}
+	return setup, &sent
+}
+
+// commandsByContent はitem_addコマンドをタスク名で引けるようにする
+func commandsByContent(commands []api.Command) map[string]api.Command {
+	tasks := make(map[string]api.Command)
+	for _, cmd := range commands {
+		if cmd.Type == api.CommandItemAdd {
+			tasks[cmd.Args["content"].(string)] = cmd
+		}
+	}
+	return tasks
+}
+
+func TestExecuteProjectDuplicateWithOutput(t *testing.T) {
+	setup, sent := setupProjectDuplicateTest(t)
+	defer setup.cleanup()
+
+	// Act
+	err := setup.executor.executeProjectDuplicateWithOutput(context.Background(), &projectDuplicateParams{
+		projectIDOrName: "Release checklist",
+		name:            "Release 2.4",
+	})
+
+	// Assert: プロジェクト→セクション→タスク（親が先）の順に一度にまとめて送信される
+	require.NoError(t, err)
+	commands := *sent
+	require.Len(t, commands, 7)
+	assert.Equal(t, api.CommandProjectAdd, commands[0].Type)
+	assert.Equal(t, api.CommandSectionAdd, commands[1].Type)
+	assert.Equal(t, api.CommandSectionAdd, commands[2].Type)
+
+	project := commands[0]
+	assert.Equal(t, "Release 2.4", project.Args["name"])
+	assert.Equal(t, "project-releases", project.Args["parent_id"])
+	assert.Equal(t, "blue", project.Args["color"])
+
+	// セクションは新しいプロジェクトのtemp_idを参照する
+	prep, ship := commands[1], commands[2]
+	assert.Equal(t, "Prep", prep.Args["name"])
+	assert.Equal(t, project.TempID, prep.Args["project_id"])
+	assert.Equal(t, "Ship", ship.Args["name"])
+
+	tasks := commandsByContent(commands)
+	require.Len(t, tasks, 4, "完了済みのタスクと他プロジェクトのタスクはコピーしない")
+
+	freeze := tasks["Freeze branch"]
+	assert.Equal(t, project.TempID, freeze.Args["project_id"])
+	assert.Equal(t, prep.TempID, freeze.Args["section_id"])
+	assert.Equal(t, "Announce in #release", freeze.Args["description"])
+	assert.Equal(t, []string{"release"}, freeze.Args["labels"])
+	assert.Equal(t, 4, freeze.Args["priority"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-02"}, freeze.Args["due"])
+
+	// サブタスクは親タスクのtemp_idを参照し、親より後に作成される
+	run := tasks["Run tests"]
+	assert.Equal(t, freeze.TempID, run.Args["parent_id"])
+	assert.Equal(t, prep.TempID, run.Args["section_id"])
+
+	assert.Equal(t, ship.TempID, tasks["Tag release"].Args["section_id"])
+	assert.NotContains(t, tasks["Weekly sync"].Args, "section_id")
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, `Duplicated project "Release checklist" as "Release 2.4"`)
+	assert.Contains(t, output, "Sections: 2")
+	assert.Contains(t, output, "Tasks: 4")
+}
+
+func TestExecuteProjectDuplicateWithOutput_DueShift(t *testing.T) {
+	setup, sent := setupProjectDuplicateTest(t)
+	defer setup.cleanup()
+
+	// Act: 最も早い期限（11/02）を12/01に合わせ、別の親の下に複製する
+	err := setup.executor.executeProjectDuplicateWithOutput(context.Background(), &projectDuplicateParams{
+		projectIDOrName: "Release checklist",
+		parent:          "Other",
+		start:           "2026-12-01",
+	})
+
+	// Assert: 相対的な間隔を保ったまま期限と締め切りがずれる（繰り返しの期限はそのまま）
+	require.NoError(t, err)
+	commands := *sent
+	assert.Equal(t, "Release checklist (copy)", commands[0].Args["name"])
+	assert.Equal(t, "project-other", commands[0].Args["parent_id"])
+
+	tasks := commandsByContent(commands)
+	assert.Equal(t, map[string]interface{}{"date": "2026-12-01"}, tasks["Freeze branch"].Args["due"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-12-02T10:00:00"}, tasks["Run tests"].Args["due"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-12-04"}, tasks["Tag release"].Args["deadline"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-04", "string": "every wednesday", "is_recurring": true}, tasks["Weekly sync"].Args["due"])
+}
+
+func TestExecuteProjectDuplicateWithOutput_CommandError(t *testing.T) {
+	setup, _ := setupProjectDuplicateTest(t)
+	defer setup.cleanup()
+
+	// タスクの作成だけが失敗する
+	setup.mockClient.ExecuteCommandsFunc = func(_ context.Context, commands []api.Command) (*api.SyncResponse, error) {
+		resp := &api.SyncResponse{SyncStatus: make(map[string]interface{}), TempIDMapping: make(map[string]string)}
+		for i, cmd := range commands {
+			if cmd.Type == api.CommandItemAdd {
+				resp.SyncStatus[cmd.UUID] = map[string]interface{}{"error": "Invalid argument value"}
+				continue
+			}
+			resp.SyncStatus[cmd.UUID] = "ok"
+			resp.TempIDMapping[cmd.TempID] = fmt.Sprintf("new-%d", i)
+		}
+		return resp, nil
+	}
+
+	err := setup.executor.executeProjectDuplicateWithOutput(context.Background(), &projectDuplicateParams{projectIDOrName: "Release checklist"})
+
+	assert.ErrorContains(t, err, "4 of 7 commands failed")
+	assert.Contains(t, setup.stderr.String(), "run 'gotodoist undo' to remove it")
+}
+
+func TestExecuteProjectDuplicateWithOutput_InvalidShift(t *testing.T) {
+	setup, sent := setupProjectDuplicateTest(t)
+	defer setup.cleanup()
+
+	err := setup.executor.executeProjectDuplicateWithOutput(context.Background(), &projectDuplicateParams{projectIDOrName: "Release checklist", shift: "soon"})
+
+	assert.ErrorContains(t, err, "invalid --shift value")
+	assert.Empty(t, *sent, "APIが呼ばれてはいけません")
+}
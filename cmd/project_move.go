@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func init() {
+	// サブコマンドを追加
+	projectCmd.AddCommand(projectMoveCmd)
+	projectCmd.AddCommand(projectReorderCmd)
+
+	// project move用のフラグ
+	projectMoveCmd.Flags().StringP("parent", "p", "", "new parent project ID or name")
+	projectMoveCmd.Flags().Bool("root", false, "move the project to the top level")
+}
+
+// projectMoveCmd はプロジェクト移動コマンド
+var projectMoveCmd = &cobra.Command{
+	Use:   "move <project ID or name>",
+	Short: "Move a project under another parent",
+	Long: `Move a project (with its sub-projects) under another project, or to the top level.
+
+A project cannot be moved under itself or one of its sub-projects.`,
+	Example: `  gotodoist project move API --parent Work
+  gotodoist project move "Work/API" --root`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectMove,
+}
+
+// projectReorderCmd はプロジェクト並び替えコマンド
+var projectReorderCmd = &cobra.Command{
+	Use:   "reorder <project ID or name>...",
+	Short: "Reorder sibling projects",
+	Long: `Reorder projects that share the same parent.
+
+The given projects are placed first, in the given order.
+The other projects under the same parent keep their relative order after them.`,
+	Example: `  gotodoist project reorder Work Home          # Work first, then Home, then the rest
+  gotodoist project reorder Work/API Work/Web`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runProjectReorder,
+}
+
+// projectMoveParams はプロジェクト移動のパラメータ
+type projectMoveParams struct {
+	projectIDOrName string
+	parent          string
+	root            bool
+}
+
+// projectReorderParams はプロジェクト並び替えのパラメータ
+type projectReorderParams struct {
+	projectIDOrNames []string
+}
+
+// getProjectMoveParams はプロジェクト移動のパラメータを取得する
+func getProjectMoveParams(cmd *cobra.Command, args []string) *projectMoveParams {
+	parent, _ := cmd.Flags().GetString("parent")
+	root, _ := cmd.Flags().GetBool("root")
+	return &projectMoveParams{
+		projectIDOrName: args[0],
+		parent:          parent,
+		root:            root,
+	}
+}
+
+// runProjectMove はプロジェクト移動の実際の処理
+func runProjectMove(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getProjectMoveParams(cmd, args)
+	return executor.executeProjectMoveWithOutput(ctx, params)
+}
+
+// runProjectReorder はプロジェクト並び替えの実際の処理
+func runProjectReorder(_ *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// 実行
+	return executor.executeProjectReorderWithOutput(ctx, &projectReorderParams{projectIDOrNames: args})
+}
+
+// executeProjectMoveWithOutput はプロジェクト移動と結果表示を実行する（テスト可能）
+func (e *projectExecutor) executeProjectMoveWithOutput(ctx context.Context, params *projectMoveParams) error {
+	if params.parent == "" && !params.root {
+		return fmt.Errorf("either --parent or --root is required")
+	}
+	if params.parent != "" && params.root {
+		return fmt.Errorf("--parent and --root cannot be used together")
+	}
+
+	// 1. 移動するプロジェクトと移動先を解決
+	project, err := e.resolveProject(ctx, params.projectIDOrName)
+	if err != nil {
+		return err
+	}
+	if project.InboxProject {
+		return fmt.Errorf("the Inbox project cannot be moved")
+	}
+
+	var parent *api.Project
+	if !params.root {
+		parent, err = e.resolveProject(ctx, params.parent)
+		if err != nil {
+			return err
+		}
+		projects, err := e.repository.GetAllProjects(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get projects: %w", err)
+		}
+		if isProjectInSubtree(projects, project.ID, parent.ID) {
+			return fmt.Errorf("cannot move project %q under itself or one of its sub-projects", project.Name)
+		}
+	}
+
+	// 2. プロジェクト移動実行
+	parentID := ""
+	if parent != nil {
+		parentID = parent.ID
+	}
+	resp, err := e.repository.MoveProject(ctx, project.ID, parentID)
+	if err != nil {
+		return fmt.Errorf("failed to move project: %w", err)
+	}
+
+	// 3. 結果表示
+	if parent == nil {
+		e.displaySuccessMessageForProject(fmt.Sprintf("📁 Moved project %q to the top level", project.Name), resp.SyncToken)
+	} else {
+		e.displaySuccessMessageForProject(fmt.Sprintf("📁 Moved project %q under %q", project.Name, parent.Name), resp.SyncToken)
+	}
+
+	return nil
+}
+
+// executeProjectReorderWithOutput はプロジェクト並び替えと結果表示を実行する（テスト可能）
+func (e *projectExecutor) executeProjectReorderWithOutput(ctx context.Context, params *projectReorderParams) error {
+	// 1. 並び替えるプロジェクトを解決
+	projects, err := e.repository.GetAllProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %w", err)
+	}
+	var ordered []*api.Project
+	for _, ref := range params.projectIDOrNames {
+		project, err := e.resolveProject(ctx, ref)
+		if err != nil {
+			return err
+		}
+		ordered = append(ordered, project)
+	}
+
+	// 2. 兄弟プロジェクト全体の新しい並び順を計算
+	siblings, err := reorderSiblingProjects(projects, ordered)
+	if err != nil {
+		return err
+	}
+	orders := make([]api.ProjectOrder, len(siblings))
+	for i := range siblings {
+		orders[i] = api.ProjectOrder{ID: siblings[i].ID, ChildOrder: i + 1}
+	}
+
+	// 3. 並び替え実行
+	resp, err := e.repository.ReorderProjects(ctx, orders)
+	if err != nil {
+		return fmt.Errorf("failed to reorder projects: %w", err)
+	}
+
+	// 4. 結果表示
+	e.displaySuccessMessageForProject(fmt.Sprintf("📁 Reordered %d project(s)", len(siblings)), resp.SyncToken)
+	for i := range siblings {
+		e.output.Plainf("  %d. %s", i+1, siblings[i].Name)
+	}
+
+	return nil
+}
+
+// isProjectInSubtree はtargetIDがprojectID自身またはその子孫のプロジェクトかを返す
+func isProjectInSubtree(projects []api.Project, projectID, targetID string) bool {
+	parents := make(map[string]string, len(projects))
+	for i := range projects {
+		parents[projects[i].ID] = projects[i].ParentID
+	}
+
+	// 移動先から親を辿り、移動するプロジェクトに行き着くかを確認する
+	visited := make(map[string]bool)
+	for id := targetID; id != "" && !visited[id]; id = parents[id] {
+		if id == projectID {
+			return true
+		}
+		visited[id] = true
+	}
+	return false
+}
+
+// reorderSiblingProjects は指定したプロジェクトを先頭に、残りの兄弟プロジェクトを元の順で後ろに並べる
+// 指定したプロジェクトは全て同じ親を持つ必要がある（Inboxは並び替えの対象外）
+func reorderSiblingProjects(projects []api.Project, ordered []*api.Project) ([]api.Project, error) {
+	parentID := ordered[0].ParentID
+	picked := make(map[string]bool, len(ordered))
+	for _, project := range ordered {
+		if project.InboxProject {
+			return nil, fmt.Errorf("the Inbox project cannot be reordered")
+		}
+		if project.ParentID != parentID {
+			return nil, fmt.Errorf("projects %q and %q do not share the same parent", ordered[0].Name, project.Name)
+		}
+		if picked[project.ID] {
+			return nil, fmt.Errorf("project %q is specified more than once", project.Name)
+		}
+		picked[project.ID] = true
+	}
+
+	var rest []api.Project
+	for i := range projects {
+		if projects[i].ParentID == parentID && !projects[i].InboxProject && !picked[projects[i].ID] {
+			rest = append(rest, projects[i])
+		}
+	}
+	sort.SliceStable(rest, func(i, j int) bool {
+		return rest[i].ChildOrder < rest[j].ChildOrder
+	})
+
+	siblings := make([]api.Project, 0, len(ordered)+len(rest))
+	for _, project := range ordered {
+		siblings = append(siblings, *project)
+	}
+	return append(siblings, rest...), nil
+}
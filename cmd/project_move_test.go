@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func testProjectHierarchy() []api.Project {
+	return []api.Project{
+		{ID: "project-inbox", Name: "Inbox", InboxProject: true},
+		{ID: "project-work", Name: "Work", ChildOrder: 1},
+		{ID: "project-home", Name: "Home", ChildOrder: 2},
+		{ID: "project-hobby", Name: "Hobby", ChildOrder: 3},
+		{ID: "project-api", Name: "API", ParentID: "project-work", ChildOrder: 1},
+		{ID: "project-v2", Name: "V2", ParentID: "project-api", ChildOrder: 1},
+	}
+}
+
+func TestExecuteProjectMoveWithOutput(t *testing.T) {
+	t.Run("親プロジェクトの下に移動しツリー表示に反映される", func(t *testing.T) {
+		setup := setupTestProjectExecutor(t)
+		defer setup.cleanup()
+		insertTestProjectsIntoDB(t, setup.dbPath, testProjectHierarchy())
+		markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+		var movedID, movedParentID string
+		setup.mockClient.MoveProjectFunc = func(_ context.Context, projectID, parentID string) (*api.SyncResponse, error) {
+			movedID, movedParentID = projectID, parentID
+			return &api.SyncResponse{SyncToken: "command-token"}, nil
+		}
+
+		err := setup.executor.executeProjectMoveWithOutput(context.Background(), &projectMoveParams{projectIDOrName: "Hobby", parent: "Home"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "project-hobby", movedID)
+		assert.Equal(t, "project-home", movedParentID)
+		assert.Contains(t, setup.stdout.String(), `Moved project "Hobby" under "Home"`)
+
+		setup.stdout.Reset()
+		require.NoError(t, setup.executor.executeProjectList(context.Background(), &projectListParams{showTree: true}))
+		assert.Contains(t, setup.stdout.String(), "├─ 📁 Home\n  ├─ 📁 Hobby")
+	})
+
+	t.Run("ルートに移動", func(t *testing.T) {
+		setup := setupTestProjectExecutor(t)
+		defer setup.cleanup()
+		insertTestProjectsIntoDB(t, setup.dbPath, testProjectHierarchy())
+		markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+		movedParentID := "unset"
+		setup.mockClient.MoveProjectFunc = func(_ context.Context, _, parentID string) (*api.SyncResponse, error) {
+			movedParentID = parentID
+			return &api.SyncResponse{SyncToken: "command-token"}, nil
+		}
+
+		err := setup.executor.executeProjectMoveWithOutput(context.Background(), &projectMoveParams{projectIDOrName: "Work/API", root: true})
+
+		require.NoError(t, err)
+		assert.Empty(t, movedParentID)
+		assert.Contains(t, setup.stdout.String(), `Moved project "API" to the top level`)
+
+		projects, err := setup.repository.GetAllProjects(context.Background())
+		require.NoError(t, err)
+		for i := range projects {
+			if projects[i].ID == "project-api" {
+				assert.Empty(t, projects[i].ParentID)
+			}
+		}
+	})
+
+	errorTests := []struct {
+		name        string
+		params      *projectMoveParams
+		expectError string
+	}{
+		{name: "自分の子孫の下には移動できない", params: &projectMoveParams{projectIDOrName: "Work", parent: "V2"}, expectError: "under itself or one of its sub-projects"},
+		{name: "自分の下には移動できない", params: &projectMoveParams{projectIDOrName: "Work", parent: "Work"}, expectError: "under itself or one of its sub-projects"},
+		{name: "Inboxは移動できない", params: &projectMoveParams{projectIDOrName: "Inbox", parent: "Work"}, expectError: "Inbox project cannot be moved"},
+		{name: "移動先の指定なし", params: &projectMoveParams{projectIDOrName: "Work"}, expectError: "either --parent or --root is required"},
+		{name: "移動先の同時指定", params: &projectMoveParams{projectIDOrName: "Work", parent: "Home", root: true}, expectError: "cannot be used together"},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup := setupTestProjectExecutor(t)
+			defer setup.cleanup()
+			insertTestProjectsIntoDB(t, setup.dbPath, testProjectHierarchy())
+			markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+			called := false
+			setup.mockClient.MoveProjectFunc = func(_ context.Context, _, _ string) (*api.SyncResponse, error) {
+				called = true
+				return &api.SyncResponse{}, nil
+			}
+
+			err := setup.executor.executeProjectMoveWithOutput(context.Background(), tt.params)
+
+			assert.ErrorContains(t, err, tt.expectError)
+			assert.False(t, called, "APIが呼ばれてはいけません")
+		})
+	}
+}
+
+func TestExecuteProjectReorderWithOutput(t *testing.T) {
+	setup := setupTestProjectExecutor(t)
+	defer setup.cleanup()
+	insertTestProjectsIntoDB(t, setup.dbPath, testProjectHierarchy())
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	var sent []api.ProjectOrder
+	setup.mockClient.ReorderProjectsFunc = func(_ context.Context, orders []api.ProjectOrder) (*api.SyncResponse, error) {
+		sent = orders
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+
+	// Act: HobbyとHomeを先頭に並べる
+	err := setup.executor.executeProjectReorderWithOutput(context.Background(), &projectReorderParams{projectIDOrNames: []string{"Hobby", "Home"}})
+
+	// Assert: Inboxを除くルートの兄弟全体の並び順が送信される
+	require.NoError(t, err)
+	assert.Equal(t, []api.ProjectOrder{
+		{ID: "project-hobby", ChildOrder: 1},
+		{ID: "project-home", ChildOrder: 2},
+		{ID: "project-work", ChildOrder: 3},
+	}, sent)
+
+	// ツリー表示にすぐ反映される
+	setup.stdout.Reset()
+	require.NoError(t, setup.executor.executeProjectList(context.Background(), &projectListParams{showTree: true}))
+	output := setup.stdout.String()
+	assert.Less(t, strings.Index(output, "Hobby"), strings.Index(output, "Home"))
+	assert.Less(t, strings.Index(output, "Home"), strings.Index(output, "Work"))
+}
+
+func TestExecuteProjectReorderWithOutput_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		refs        []string
+		expectError string
+	}{
+		{name: "親が異なる", refs: []string{"Home", "API"}, expectError: "do not share the same parent"},
+		{name: "重複指定", refs: []string{"Home", "Home"}, expectError: "specified more than once"},
+		{name: "Inboxは並び替えできない", refs: []string{"Inbox"}, expectError: "Inbox project cannot be reordered"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup := setupTestProjectExecutor(t)
+			defer setup.cleanup()
+			insertTestProjectsIntoDB(t, setup.dbPath, testProjectHierarchy())
+			markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+			err := setup.executor.executeProjectReorderWithOutput(context.Background(), &projectReorderParams{projectIDOrNames: tt.refs})
+
+			assert.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	// サブコマンドを追加
+	projectCmd.AddCommand(projectShareCmd)
+	projectCmd.AddCommand(projectUnshareCmd)
+	projectCmd.AddCommand(projectMembersCmd)
+
+	// project unshare用のフラグ
+	projectUnshareCmd.Flags().BoolP("force", "f", false, "skip confirmation prompt")
+}
+
+// projectShareCmd はプロジェクト共有コマンド
+var projectShareCmd = &cobra.Command{
+	Use:   "share <project ID or name> <email>",
+	Short: "Share a project with someone",
+	Long: `Invite someone to a project by email address.
+
+The invitation stays pending until it is accepted in Todoist.
+Pending invitations are listed by 'gotodoist project members'.`,
+	Example: `  gotodoist project share Work alice@example.com`,
+	Args:    cobra.ExactArgs(2),
+	RunE:    runProjectShare,
+}
+
+// projectUnshareCmd はプロジェクト共有解除コマンド
+var projectUnshareCmd = &cobra.Command{
+	Use:   "unshare <project ID or name> <email or name>",
+	Short: "Remove a member from a shared project",
+	Long: `Remove a member from a shared project, or cancel a pending invitation.
+
+The member can be specified by email address, full name or user ID.`,
+	Example: `  gotodoist project unshare Work alice@example.com
+  gotodoist project unshare Work "Alice Smith" --force`,
+	Args: cobra.ExactArgs(2),
+	RunE: runProjectUnshare,
+}
+
+// projectMembersCmd はプロジェクト参加者一覧コマンド
+var projectMembersCmd = &cobra.Command{
+	Use:   "members <project ID or name>",
+	Short: "List members of a shared project",
+	Long:  `List the members of a shared project, including pending invitations.`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProjectMembers,
+}
+
+// projectShareParams はプロジェクト共有のパラメータ
+type projectShareParams struct {
+	projectIDOrName string
+	email           string
+}
+
+// projectUnshareParams はプロジェクト共有解除のパラメータ
+type projectUnshareParams struct {
+	projectIDOrName string
+	memberRef       string
+	force           bool
+}
+
+// projectMembersParams はプロジェクト参加者一覧のパラメータ
+type projectMembersParams struct {
+	projectIDOrName string
+}
+
+// runProjectShare はプロジェクト共有の実際の処理
+func runProjectShare(_ *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := &projectShareParams{projectIDOrName: args[0], email: args[1]}
+	return executor.executeProjectShareWithOutput(ctx, params)
+}
+
+// runProjectUnshare はプロジェクト共有解除の実際の処理
+func runProjectUnshare(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	force, _ := cmd.Flags().GetBool("force")
+	params := &projectUnshareParams{projectIDOrName: args[0], memberRef: args[1], force: force}
+	return executor.executeProjectUnshareWithOutput(ctx, params)
+}
+
+// runProjectMembers はプロジェクト参加者一覧の実際の処理
+func runProjectMembers(_ *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// 実行
+	return executor.executeProjectMembersWithOutput(ctx, &projectMembersParams{projectIDOrName: args[0]})
+}
+
+// executeProjectShareWithOutput はプロジェクト共有と結果表示を実行する（テスト可能）
+func (e *projectExecutor) executeProjectShareWithOutput(ctx context.Context, params *projectShareParams) error {
+	// 1. 対象プロジェクトを解決
+	project, err := e.resolveProject(ctx, params.projectIDOrName)
+	if err != nil {
+		return err
+	}
+
+	// 2. 共有実行
+	resp, err := e.repository.ShareProject(ctx, project.ID, params.email)
+	if err != nil {
+		return fmt.Errorf("failed to share project: %w", err)
+	}
+
+	// 3. 結果表示
+	e.displaySuccessMessageForProject(fmt.Sprintf("%s Invited %s to project %q", iconShared, params.email, project.Name), resp.SyncToken)
+	e.output.Infof("💡 The invitation stays pending until it is accepted")
+
+	return nil
+}
+
+// executeProjectUnshareWithOutput はプロジェクト共有解除と結果表示を実行する（テスト可能）
+func (e *projectExecutor) executeProjectUnshareWithOutput(ctx context.Context, params *projectUnshareParams) error {
+	// 1. 対象プロジェクトと参加者を解決
+	project, err := e.resolveProject(ctx, params.projectIDOrName)
+	if err != nil {
+		return err
+	}
+	members, err := e.repository.GetProjectMembers(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+	email, label, err := resolveProjectMemberEmail(members, params.memberRef)
+	if err != nil {
+		return err
+	}
+
+	// 2. 確認プロンプト（forceフラグが無い場合）
+	if !params.force {
+		e.output.PlainNoNewlinef("Remove %s from project %q? (y/N): ", label, project.Name)
+		confirmation, err := readPromptLine()
+		if err != nil || (confirmation != "y" && confirmation != "Y") {
+			e.output.Errorf("Project unshare canceled")
+			return nil
+		}
+	}
+
+	// 3. 共有解除実行
+	resp, err := e.repository.UnshareProject(ctx, project.ID, email)
+	if err != nil {
+		return fmt.Errorf("failed to unshare project: %w", err)
+	}
+
+	// 4. 結果表示
+	e.displaySuccessMessageForProject(fmt.Sprintf("Removed %s from project %q", label, project.Name), resp.SyncToken)
+
+	return nil
+}
+
+// executeProjectMembersWithOutput はプロジェクト参加者の取得と表示を実行する（テスト可能）
+func (e *projectExecutor) executeProjectMembersWithOutput(ctx context.Context, params *projectMembersParams) error {
+	// 1. 対象プロジェクトと参加者を取得
+	project, err := e.resolveProject(ctx, params.projectIDOrName)
+	if err != nil {
+		return err
+	}
+	members, err := e.repository.GetProjectMembers(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
+	// 2. 結果表示
+	if len(members) == 0 {
+		e.output.Infof("📭 Project %q is not shared", project.Name)
+		e.output.Infof("💡 Use 'gotodoist project share %s <email>' to invite someone", project.Name)
+		return nil
+	}
+
+	var active, invited []repository.ProjectMember
+	for i := range members {
+		if members[i].IsInvited() {
+			invited = append(invited, members[i])
+		} else {
+			active = append(active, members[i])
+		}
+	}
+
+	e.output.Listf("%s Members of %q (%d):", iconShared, project.Name, len(active))
+	for i := range active {
+		e.output.Plainf("  %s", formatProjectMember(&active[i]))
+	}
+	if len(invited) > 0 {
+		e.output.Plainf("")
+		e.output.Listf("✉️  Pending invitations (%d):", len(invited))
+		for i := range invited {
+			e.output.Plainf("  %s", formatProjectMember(&invited[i]))
+		}
+	}
+
+	return nil
+}
+
+// resolveProject はプロジェクトIDまたは名前からプロジェクトを取得する
+func (e *projectExecutor) resolveProject(ctx context.Context, nameOrID string) (*api.Project, error) {
+	projectID, err := e.findProjectIDByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	return e.findProjectByID(ctx, projectID)
+}
+
+// resolveProjectMemberEmail は参加者の指定（メールアドレス・名前・ID）から共有解除に使うメールアドレスを解決する
+// 未同期の招待などで参加者に見つからない場合も、メールアドレスの指定はそのまま使う
+func resolveProjectMemberEmail(members []repository.ProjectMember, ref string) (email, label string, err error) {
+	for i := range members {
+		member := &members[i]
+		if member.ID != ref && !strings.EqualFold(member.Email, ref) && !strings.EqualFold(member.FullName, ref) {
+			continue
+		}
+		if member.Email == "" {
+			return "", "", fmt.Errorf("email address of %s is not synced yet (run 'gotodoist sync')", member.Label())
+		}
+		if member.FullName != "" {
+			return member.Email, fmt.Sprintf("%s <%s>", member.FullName, member.Email), nil
+		}
+		return member.Email, member.Email, nil
+	}
+
+	if strings.Contains(ref, "@") {
+		return ref, ref, nil
+	}
+	return "", "", fmt.Errorf("%w: %s is not a member of the project", repository.ErrCollaboratorNotFound, ref)
+}
+
+// formatProjectMember は参加者を1行で表示する形式に整形する
+func formatProjectMember(member *repository.ProjectMember) string {
+	line := "👤 " + member.Label()
+	if member.FullName != "" && member.Email != "" {
+		line += fmt.Sprintf(" <%s>", member.Email)
+	}
+	if member.IsCurrentUser {
+		line += " (you)"
+	}
+	return line
+}
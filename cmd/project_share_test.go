@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	gosync "sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// fakeSharingServer はプロジェクト共有のコマンドを処理するSync APIの簡易実装
+type fakeSharingServer struct {
+	mu            gosync.Mutex
+	projects      []api.Project
+	user          api.User
+	collaborators []api.Collaborator
+	states        []api.CollaboratorState
+	commands      []api.Command
+	requests      int
+}
+
+func (s *fakeSharingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var req api.SyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.requests++
+
+	status := make(map[string]interface{}, len(req.Commands))
+	for _, cmd := range req.Commands {
+		s.commands = append(s.commands, cmd)
+		status[cmd.UUID] = s.apply(cmd)
+	}
+
+	resp := api.SyncResponse{
+		SyncToken:  fmt.Sprintf("token-%d", s.requests),
+		SyncStatus: status,
+	}
+	if len(req.ResourceTypes) > 0 {
+		user := s.user
+		resp.User = &user
+		resp.Projects = s.projects
+		resp.Collaborators = s.collaborators
+		resp.CollaboratorStates = s.states
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// apply はコマンドを適用し、sync_statusの値を返す
+func (s *fakeSharingServer) apply(cmd api.Command) interface{} {
+	projectID, _ := cmd.Args["project_id"].(string)
+	email, _ := cmd.Args["email"].(string)
+
+	switch cmd.Type {
+	case api.CommandShareProject:
+		userID := "user-" + strings.Split(email, "@")[0]
+		for i := range s.collaborators {
+			if s.collaborators[i].Email == email {
+				userID = s.collaborators[i].ID
+			}
+		}
+		if state := s.findState(projectID, userID); state == nil {
+			s.collaborators = append(s.collaborators, api.Collaborator{ID: userID, Email: email})
+			s.states = append(s.states, api.CollaboratorState{ProjectID: projectID, UserID: userID, State: api.CollaboratorStateInvited})
+		} else if state.IsDeleted {
+			// 外したユーザーは招待中として戻る
+			state.IsDeleted = false
+			state.State = api.CollaboratorStateInvited
+		}
+		return "ok"
+
+	case api.CommandDeleteCollaborator:
+		for i := range s.collaborators {
+			if s.collaborators[i].Email != email {
+				continue
+			}
+			if state := s.findState(projectID, s.collaborators[i].ID); state != nil && !state.IsDeleted {
+				state.IsDeleted = true
+				return "ok"
+			}
+		}
+		return map[string]interface{}{"error_code": 20, "error": "Collaborator not found"}
+
+	default:
+		return map[string]interface{}{"error_code": 1, "error": "Unsupported command"}
+	}
+}
+
+func (s *fakeSharingServer) findState(projectID, userID string) *api.CollaboratorState {
+	for i := range s.states {
+		if s.states[i].ProjectID == projectID && s.states[i].UserID == userID {
+			return &s.states[i]
+		}
+	}
+	return nil
+}
+
+// setupTestSharingExecutor はローカルのSync APIに接続したexecutorをセットアップする
+func setupTestSharingExecutor(t *testing.T) (*testExecutorSetup, *projectExecutor, *fakeSharingServer) {
+	t.Helper()
+
+	fake := &fakeSharingServer{
+		projects: []api.Project{{ID: "project-1", Name: "Work", Shared: true}},
+		user:     api.User{ID: "user-me", Email: "me@example.com", FullName: "Taro Yamada"},
+		collaborators: []api.Collaborator{
+			{ID: "user-me", Email: "me@example.com", FullName: "Taro Yamada"},
+			{ID: "user-alice", Email: "alice@example.com", FullName: "Alice Smith"},
+		},
+		states: []api.CollaboratorState{
+			{ProjectID: "project-1", UserID: "user-me", State: api.CollaboratorStateActive},
+			{ProjectID: "project-1", UserID: "user-alice", State: api.CollaboratorStateActive},
+		},
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient("test-token")
+	require.NoError(t, err)
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	base := setupTestExecutorBaseWithClient(t, client, true)
+	executor := &projectExecutor{cfg: base.cfg, repository: base.repository, output: base.output}
+	return base, executor, fake
+}
+
+func TestProjectSharing_ShareMembersUnshare(t *testing.T) {
+	setup, executor, fake := setupTestSharingExecutor(t)
+	defer setup.cleanup()
+	ctx := context.Background()
+
+	// Act: 招待する
+	err := executor.executeProjectShareWithOutput(ctx, &projectShareParams{projectIDOrName: "Work", email: "bob@example.com"})
+
+	// Assert: share_projectが送信される
+	require.NoError(t, err)
+	require.Len(t, fake.commands, 1)
+	assert.Equal(t, api.CommandShareProject, fake.commands[0].Type)
+	assert.Equal(t, "project-1", fake.commands[0].Args["project_id"])
+	assert.Equal(t, "bob@example.com", fake.commands[0].Args["email"])
+	assert.Contains(t, setup.stdout.String(), `Invited bob@example.com to project "Work"`)
+
+	// Act: 参加者一覧
+	setup.stdout.Reset()
+	require.NoError(t, executor.executeProjectMembersWithOutput(ctx, &projectMembersParams{projectIDOrName: "Work"}))
+
+	// Assert: 参加中のメンバーと招待中のユーザーが分けて表示される
+	output := setup.stdout.String()
+	assert.Contains(t, output, `Members of "Work" (2)`)
+	assert.Contains(t, output, "Alice Smith <alice@example.com>")
+	assert.Contains(t, output, "Taro Yamada <me@example.com> (you)")
+	assert.Contains(t, output, "Pending invitations (1)")
+	assert.Contains(t, output, "bob@example.com")
+	assert.Less(t, strings.Index(output, "Alice Smith"), strings.Index(output, "Pending invitations"))
+	assert.Greater(t, strings.Index(output, "bob@example.com"), strings.Index(output, "Pending invitations"))
+
+	// Act: 招待を取り消し、名前で指定したメンバーを外す
+	require.NoError(t, executor.executeProjectUnshareWithOutput(ctx, &projectUnshareParams{projectIDOrName: "Work", memberRef: "bob@example.com", force: true}))
+	require.NoError(t, executor.executeProjectUnshareWithOutput(ctx, &projectUnshareParams{projectIDOrName: "project-1", memberRef: "alice smith", force: true}))
+
+	// Assert: delete_collaboratorがメールアドレスで送信され、ローカルの参加者からも消える
+	require.Len(t, fake.commands, 3)
+	assert.Equal(t, api.CommandDeleteCollaborator, fake.commands[1].Type)
+	assert.Equal(t, "bob@example.com", fake.commands[1].Args["email"])
+	assert.Equal(t, api.CommandDeleteCollaborator, fake.commands[2].Type)
+	assert.Equal(t, "alice@example.com", fake.commands[2].Args["email"])
+
+	members, err := setup.repository.GetProjectMembers(ctx, "project-1")
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, "user-me", members[0].ID)
+}
+
+func TestProjectSharing_Undo(t *testing.T) {
+	// Arrange: Bobを招待し、Aliceを外しておく
+	setup, executor, fake := setupTestSharingExecutor(t)
+	defer setup.cleanup()
+	undoExec := &undoExecutor{cfg: setup.cfg, repository: setup.repository, output: setup.output}
+	ctx := context.Background()
+
+	require.NoError(t, executor.executeProjectShareWithOutput(ctx, &projectShareParams{projectIDOrName: "Work", email: "bob@example.com"}))
+	require.NoError(t, executor.executeProjectUnshareWithOutput(ctx, &projectUnshareParams{projectIDOrName: "Work", memberRef: "alice@example.com", force: true}))
+	setup.stdout.Reset()
+
+	// Act
+	err := undoExec.executeUndoWithOutput(ctx, &undoParams{count: 2})
+
+	// Assert: 外したAliceは再度招待し、招待したBobは招待を取り消す
+	require.NoError(t, err)
+	require.Len(t, fake.commands, 4)
+	assert.Equal(t, api.CommandShareProject, fake.commands[2].Type)
+	assert.Equal(t, "alice@example.com", fake.commands[2].Args["email"])
+	assert.Equal(t, api.CommandDeleteCollaborator, fake.commands[3].Type)
+	assert.Equal(t, "bob@example.com", fake.commands[3].Args["email"])
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, `Undone: unshare project "Work" (alice@example.com)`)
+	assert.Contains(t, output, `Undone: share project "Work" (bob@example.com)`)
+
+	members, err := setup.repository.GetProjectMembers(ctx, "project-1")
+	require.NoError(t, err)
+	var emails []string
+	for _, member := range members {
+		emails = append(emails, member.Email)
+	}
+	assert.ElementsMatch(t, []string{"me@example.com", "alice@example.com"}, emails)
+}
+
+func TestProjectSharing_UnshareUnknownMember(t *testing.T) {
+	setup, executor, fake := setupTestSharingExecutor(t)
+	defer setup.cleanup()
+
+	err := executor.executeProjectUnshareWithOutput(context.Background(), &projectUnshareParams{projectIDOrName: "Work", memberRef: "Carol", force: true})
+
+	assert.ErrorContains(t, err, "Carol is not a member of the project")
+	assert.Empty(t, fake.commands)
+}
+
+func TestProjectSharing_CommandError(t *testing.T) {
+	setup, executor, _ := setupTestSharingExecutor(t)
+	defer setup.cleanup()
+
+	// 参加していないメールアドレスはサーバー側でエラーになる
+	err := executor.executeProjectUnshareWithOutput(context.Background(), &projectUnshareParams{projectIDOrName: "Work", memberRef: "carol@example.com", force: true})
+
+	assert.ErrorContains(t, err, "Collaborator not found")
+}
+
+func TestProjectSharing_InvalidEmail(t *testing.T) {
+	setup, executor, fake := setupTestSharingExecutor(t)
+	defer setup.cleanup()
+
+	err := executor.executeProjectShareWithOutput(context.Background(), &projectShareParams{projectIDOrName: "Work", email: "bob"})
+
+	assert.ErrorContains(t, err, "invalid email address")
+	assert.Empty(t, fake.commands)
+}
+
+func TestExecuteProjectMembersWithOutput_NotShared(t *testing.T) {
+	setup := setupTestProjectExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Home"}})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	err := setup.executor.executeProjectMembersWithOutput(context.Background(), &projectMembersParams{projectIDOrName: "Home"})
+
+	require.NoError(t, err)
+	assert.Contains(t, setup.stdout.String(), `Project "Home" is not shared`)
+}
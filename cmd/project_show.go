@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+// defaultProjectShowTop はproject showで表示する優先度の高いタスクの件数
+const defaultProjectShowTop = 5
+
+func init() {
+	projectCmd.AddCommand(projectShowCmd)
+
+	// project show用のフラグ
+	projectShowCmd.Flags().IntP("top", "n", defaultProjectShowTop, "number of top-priority tasks to show")
+	projectShowCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+// projectShowCmd はプロジェクト概要表示コマンド
+var projectShowCmd = &cobra.Command{
+	Use:   "show <project ID or name>",
+	Short: "Show project overview",
+	Long: `Display an overview of a project from the local database:
+path, color, sharing and archived state, open/overdue/due-today counts
+per section, tasks completed in the last 7 days, and the top tasks by priority.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectShow,
+}
+
+// projectShowParams はプロジェクト概要表示のパラメータ
+type projectShowParams struct {
+	projectIDOrName string
+	top             int
+	jsonOutput      bool
+}
+
+// projectSectionStats はプロジェクト概要に含めるセクションごとのタスク数
+type projectSectionStats struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	Open     int    `json:"open"`
+	Overdue  int    `json:"overdue"`
+	DueToday int    `json:"due_today"`
+}
+
+// projectDetail はプロジェクト概要表示のデータ
+type projectDetail struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Path               string                `json:"path"`
+	Color              string                `json:"color,omitempty"`
+	IsFavorite         bool                  `json:"is_favorite"`
+	IsArchived         bool                  `json:"is_archived"`
+	Shared             bool                  `json:"shared"`
+	Members            int                   `json:"members,omitempty"`
+	PendingInvitations int                   `json:"pending_invitations,omitempty"`
+	Sections           []projectSectionStats `json:"sections"`
+	Total              projectSectionStats   `json:"total"`
+	CompletedLast7Days int                   `json:"completed_last_7_days"`
+	TopTasks           []api.Item            `json:"top_tasks,omitempty"`
+}
+
+// getProjectShowParams はプロジェクト概要表示のパラメータを取得する
+func getProjectShowParams(cmd *cobra.Command, args []string) *projectShowParams {
+	top, _ := cmd.Flags().GetInt("top")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	return &projectShowParams{
+		projectIDOrName: args[0],
+		top:             top,
+		jsonOutput:      jsonOutput,
+	}
+}
+
+// runProjectShow はプロジェクト概要表示の実際の処理
+func runProjectShow(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getProjectShowParams(cmd, args)
+	return executor.executeProjectShowWithOutput(ctx, params)
+}
+
+// executeProjectShowWithOutput はプロジェクト概要の取得と表示を実行する（テスト可能）
+func (e *projectExecutor) executeProjectShowWithOutput(ctx context.Context, params *projectShowParams) error {
+	// 1. プロジェクトIDを解決
+	projectID, err := e.findProjectIDByName(ctx, params.projectIDOrName)
+	if err != nil {
+		return err
+	}
+
+	// 2. 概要データを構築
+	detail, err := e.buildProjectDetail(ctx, projectID, time.Now(), params.top)
+	if err != nil {
+		return err
+	}
+
+	// 3. 出力
+	if params.jsonOutput {
+		return e.output.JSON(detail)
+	}
+	e.displayProjectDetail(detail)
+
+	return nil
+}
+
+// buildProjectDetail はローカルデータからプロジェクト概要を構築する
+func (e *projectExecutor) buildProjectDetail(ctx context.Context, projectID string, now time.Time, top int) (*projectDetail, error) {
+	overview, err := e.repository.GetProjectOverview(projectID, now, top)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project overview: %w", err)
+	}
+
+	project := overview.Project
+	detail := &projectDetail{
+		ID:                 project.ID,
+		Name:               project.Name,
+		Path:               overview.Path,
+		Color:              project.Color,
+		IsFavorite:         project.IsFavorite,
+		IsArchived:         project.IsArchived,
+		Shared:             project.Shared,
+		Sections:           make([]projectSectionStats, 0, len(overview.Sections)),
+		Total:              newProjectSectionStats(&overview.Total),
+		CompletedLast7Days: overview.CompletedCount,
+		TopTasks:           overview.TopTasks,
+	}
+	for i := range overview.Sections {
+		detail.Sections = append(detail.Sections, newProjectSectionStats(&overview.Sections[i]))
+	}
+
+	// 共有プロジェクトの参加者数（取得に失敗しても概要表示は続行）
+	if project.Shared {
+		members, err := e.repository.GetProjectMembers(ctx, project.ID)
+		if err != nil {
+			e.output.Warningf("Failed to load project members: %v", err)
+		}
+		for i := range members {
+			if members[i].IsInvited() {
+				detail.PendingInvitations++
+			} else {
+				detail.Members++
+			}
+		}
+	}
+
+	return detail, nil
+}
+
+// newProjectSectionStats はセクションの集計を表示用に変換する
+func newProjectSectionStats(stats *repository.SectionStats) projectSectionStats {
+	return projectSectionStats{
+		ID:       stats.SectionID,
+		Name:     stats.Name,
+		Open:     stats.Open,
+		Overdue:  stats.Overdue,
+		DueToday: stats.DueToday,
+	}
+}
+
+// displayProjectDetail はプロジェクト概要を表示する
+func (e *projectExecutor) displayProjectDetail(detail *projectDetail) {
+	icon := iconFolder
+	if detail.Shared {
+		icon = iconShared
+	}
+	e.output.Plainf("%s %s", icon, detail.Name)
+	e.output.Plainf("   ID: %s", detail.ID)
+	e.output.Plainf("   Path: %s", detail.Path)
+	if detail.Color != "" {
+		e.output.Plainf("   Color: %s", detail.Color)
+	}
+	if detail.IsFavorite {
+		e.output.Plainf("   Favorite: Yes ⭐")
+	}
+	switch {
+	case !detail.Shared:
+		e.output.Plainf("   Shared: No")
+	case detail.PendingInvitations > 0:
+		e.output.Plainf("   Shared: Yes 👥 (%d member(s), %d pending invitation(s))", detail.Members, detail.PendingInvitations)
+	default:
+		e.output.Plainf("   Shared: Yes 👥 (%d member(s))", detail.Members)
+	}
+	if detail.IsArchived {
+		e.output.Plainf("   Status: Archived 📦")
+	} else {
+		e.output.Plainf("   Status: Active")
+	}
+
+	// セクション別の集計
+	e.output.Plainf("")
+	if len(detail.Sections) == 0 {
+		e.output.Plainf("   No open tasks")
+	} else {
+		width := len("Section")
+		for _, section := range detail.Sections {
+			width = max(width, len([]rune(section.Name)))
+		}
+		e.output.Plainf("   %-*s %6s %8s %6s", width, "Section", "Open", "Overdue", "Today")
+		for _, section := range detail.Sections {
+			e.output.Plainf("   %s %6d %8d %6d", padRight(section.Name, width), section.Open, section.Overdue, section.DueToday)
+		}
+		if len(detail.Sections) > 1 {
+			e.output.Plainf("   %s %6d %8d %6d", padRight(detail.Total.Name, width), detail.Total.Open, detail.Total.Overdue, detail.Total.DueToday)
+		}
+	}
+
+	e.output.Plainf("")
+	e.output.Plainf("   Completed in the last 7 days: %d", detail.CompletedLast7Days)
+
+	// 優先度の高いタスク
+	if len(detail.TopTasks) > 0 {
+		e.output.Plainf("")
+		e.output.Plainf("   Top tasks:")
+		for i := range detail.TopTasks {
+			task := &detail.TopTasks[i]
+			due := ""
+			if task.Due != nil {
+				due = fmt.Sprintf(" (due %s)", task.Due.Date)
+			}
+			e.output.Plainf("     %s %s%s", getPriorityIcon(task.Priority), task.Content, due)
+		}
+	}
+}
+
+// padRight は表示幅が揃うよう文字数に合わせて右側を空白で埋める
+func padRight(s string, width int) string {
+	if n := len([]rune(s)); n < width {
+		return s + fmt.Sprintf("%*s", width-n, "")
+	}
+	return s
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func TestBuildProjectDetail(t *testing.T) {
+	// Arrange
+	setup := setupTestProjectExecutor(t)
+	defer setup.cleanup()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-work", Name: "Work", Color: "red"},
+		{ID: "project-api", Name: "API", ParentID: "project-work", Color: "blue", Shared: true},
+	})
+	insertTestSectionsIntoDB(t, setup.dbPath, []api.Section{
+		{ID: "section-todo", Name: "Todo", ProjectID: "project-api", SectionOrder: 1},
+		{ID: "section-doing", Name: "Doing", ProjectID: "project-api", SectionOrder: 2},
+		{ID: "section-empty", Name: "Someday", ProjectID: "project-api", SectionOrder: 3},
+	})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "t1", Content: "Inbox triage", ProjectID: "project-api", Priority: 1},
+		{ID: "t2", Content: "Fix login bug", ProjectID: "project-api", SectionID: "section-todo", Priority: 4, Due: &api.Due{Date: "2026-10-17"}},
+		{ID: "t3", Content: "Write docs", ProjectID: "project-api", SectionID: "section-todo", Priority: 2, Due: &api.Due{Date: "2026-10-18"}},
+		{ID: "t4", Content: "Review PR", ProjectID: "project-api", SectionID: "section-doing", Priority: 4, Due: &api.Due{Date: "2026-10-20"}},
+		{ID: "t5", Content: "Deploy", ProjectID: "project-api", SectionID: "section-doing", Priority: 3},
+		{ID: "done-recent", Content: "Old bug", ProjectID: "project-api", SectionID: "section-todo", Priority: 4, DateCompleted: &api.TodoistTime{Time: now.Add(-48 * time.Hour)}},
+		{ID: "done-old", Content: "Older bug", ProjectID: "project-api", DateCompleted: &api.TodoistTime{Time: now.Add(-10 * 24 * time.Hour)}},
+		{ID: "other", Content: "Other project", ProjectID: "project-work", Priority: 4},
+	})
+	insertTestCollaboratorsIntoDB(t, setup.dbPath, &api.CollaboratorList{
+		Collaborators: []api.Collaborator{
+			{ID: "user-me", Email: "me@example.com", FullName: "Taro Yamada"},
+			{ID: "user-bob", Email: "bob@example.com"},
+		},
+		States: []api.CollaboratorState{
+			{ProjectID: "project-api", UserID: "user-me", State: api.CollaboratorStateActive},
+			{ProjectID: "project-api", UserID: "user-bob", State: api.CollaboratorStateInvited},
+		},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	// Act
+	detail, err := setup.executor.buildProjectDetail(context.Background(), "project-api", now, 3)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Work/API", detail.Path)
+	assert.Equal(t, "blue", detail.Color)
+	assert.True(t, detail.Shared)
+	assert.Equal(t, 1, detail.Members)
+	assert.Equal(t, 1, detail.PendingInvitations)
+
+	assert.Equal(t, []projectSectionStats{
+		{Name: "(No section)", Open: 1},
+		{ID: "section-todo", Name: "Todo", Open: 2, Overdue: 1, DueToday: 1},
+		{ID: "section-doing", Name: "Doing", Open: 2},
+		{ID: "section-empty", Name: "Someday"},
+	}, detail.Sections)
+	assert.Equal(t, projectSectionStats{Name: "Total", Open: 5, Overdue: 1, DueToday: 1}, detail.Total)
+	assert.Equal(t, 1, detail.CompletedLast7Days, "7日より前の完了は数えません")
+
+	// 優先度の高い順、同じ優先度は期限の近い順
+	require.Len(t, detail.TopTasks, 3)
+	assert.Equal(t, "t2", detail.TopTasks[0].ID)
+	assert.Equal(t, "t4", detail.TopTasks[1].ID)
+	assert.Equal(t, "t5", detail.TopTasks[2].ID)
+}
+
+func TestExecuteProjectShowWithOutput(t *testing.T) {
+	setup := setupTestProjectExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-home", Name: "Home", IsArchived: true}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "t1", Content: "Laundry", ProjectID: "project-home", Priority: 3},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	t.Run("テキスト表示", func(t *testing.T) {
+		setup.stdout.Reset()
+
+		err := setup.executor.executeProjectShowWithOutput(context.Background(), &projectShowParams{projectIDOrName: "Home", top: defaultProjectShowTop})
+
+		require.NoError(t, err)
+		output := setup.stdout.String()
+		assert.Contains(t, output, "📁 Home")
+		assert.Contains(t, output, "Shared: No")
+		assert.Contains(t, output, "Status: Archived 📦")
+		assert.Contains(t, output, "Section        Open  Overdue  Today")
+		assert.Contains(t, output, "(No section)      1        0      0")
+		assert.Contains(t, output, "Completed in the last 7 days: 0")
+		assert.Contains(t, output, "🟡 Laundry")
+	})
+
+	t.Run("JSON出力", func(t *testing.T) {
+		setup.stdout.Reset()
+
+		err := setup.executor.executeProjectShowWithOutput(context.Background(), &projectShowParams{projectIDOrName: "Home", jsonOutput: true})
+
+		require.NoError(t, err)
+		var detail map[string]interface{}
+		require.NoError(t, json.Unmarshal(setup.stdout.Bytes(), &detail))
+		assert.Equal(t, "Home", detail["path"])
+		assert.Equal(t, true, detail["is_archived"])
+		assert.Equal(t, float64(1), detail["total"].(map[string]interface{})["open"])
+		assert.NotContains(t, detail, "top_tasks", "--topが0の場合はタスクを含めません")
+	})
+}
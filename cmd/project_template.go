@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/csvtemplate"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+// formatTodoistCSV はTodoistのCSVプロジェクトテンプレート形式
+const formatTodoistCSV = "todoist-csv"
+
+func init() {
+	projectCmd.AddCommand(projectExportCmd)
+	projectCmd.AddCommand(projectImportCmd)
+
+	// project export用のフラグ
+	projectExportCmd.Flags().String("format", formatTodoistCSV, "output format (todoist-csv)")
+	projectExportCmd.Flags().StringP("output", "o", "", "write to a file instead of stdout")
+
+	// project import用のフラグ
+	projectImportCmd.Flags().String("into", "", "import into an existing project (name, path or ID)")
+	projectImportCmd.Flags().StringP("name", "n", "", "name of the new project (default: file name)")
+}
+
+// projectExportCmd はプロジェクトのテンプレート書き出しコマンド
+var projectExportCmd = &cobra.Command{
+	Use:   "export <project ID or name>",
+	Short: "Export a project as a Todoist CSV template",
+	Long: `Export the sections, open tasks (with subtasks) and comments of a project
+as a Todoist CSV project template, which can be imported from the Todoist web app
+or with 'gotodoist project import'.
+
+Labels are written into the task content as @label.`,
+	Example: `  gotodoist project export "Release checklist" > release.csv
+  gotodoist project export "Release checklist" -o release.csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectExport,
+}
+
+// projectImportCmd はテンプレートの取り込みコマンド
+var projectImportCmd = &cobra.Command{
+	Use:   "import <file.csv>",
+	Short: "Import a Todoist CSV template",
+	Long: `Create sections, tasks, subtasks (from INDENT) and comments from a Todoist CSV
+project template. Everything is created in a single batched request.
+
+By default a new project named after the file is created; use --into to add
+the contents to an existing project instead.`,
+	Example: `  gotodoist project import release.csv --name "Release 2.4"
+  gotodoist project import qa-checklist.csv --into "Feature X"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectImport,
+}
+
+// projectExportParams はテンプレート書き出しのパラメータ
+type projectExportParams struct {
+	projectIDOrName string
+	format          string
+	outputPath      string
+}
+
+// projectImportParams はテンプレート取り込みのパラメータ
+type projectImportParams struct {
+	path string
+	into string
+	name string
+}
+
+// getProjectExportParams はテンプレート書き出しのパラメータを取得する
+func getProjectExportParams(cmd *cobra.Command, args []string) *projectExportParams {
+	format, _ := cmd.Flags().GetString("format")
+	outputPath, _ := cmd.Flags().GetString("output")
+	return &projectExportParams{
+		projectIDOrName: args[0],
+		format:          format,
+		outputPath:      outputPath,
+	}
+}
+
+// getProjectImportParams はテンプレート取り込みのパラメータを取得する
+func getProjectImportParams(cmd *cobra.Command, args []string) *projectImportParams {
+	into, _ := cmd.Flags().GetString("into")
+	name, _ := cmd.Flags().GetString("name")
+	return &projectImportParams{
+		path: args[0],
+		into: into,
+		name: name,
+	}
+}
+
+// runProjectExport はテンプレート書き出しの実際の処理
+func runProjectExport(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getProjectExportParams(cmd, args)
+	return executor.executeProjectExportWithOutput(ctx, params)
+}
+
+// runProjectImport はテンプレート取り込みの実際の処理
+func runProjectImport(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupProjectExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getProjectImportParams(cmd, args)
+	return executor.executeProjectImportWithOutput(ctx, params)
+}
+
+// executeProjectExportWithOutput はテンプレートの書き出しを実行する（テスト可能）
+func (e *projectExecutor) executeProjectExportWithOutput(ctx context.Context, params *projectExportParams) error {
+	if params.format != formatTodoistCSV {
+		return fmt.Errorf("unsupported format: %s (supported: %s)", params.format, formatTodoistCSV)
+	}
+
+	// 1. プロジェクトを解決
+	project, err := e.resolveProject(ctx, params.projectIDOrName)
+	if err != nil {
+		return err
+	}
+
+	// 2. テンプレートの行を構築
+	rows, err := e.buildProjectTemplate(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := csvtemplate.Write(&buf, rows); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	// 3. 出力
+	if params.outputPath == "" {
+		e.output.PlainNoNewlinef("%s", buf.String())
+		return nil
+	}
+	if err := os.WriteFile(params.outputPath, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", params.outputPath, err)
+	}
+	e.output.Successf("📄 Exported project %q to %s", project.Name, params.outputPath)
+	return nil
+}
+
+// buildProjectTemplate はプロジェクトのセクション・タスク・コメントからテンプレートの行を構築する
+func (e *projectExecutor) buildProjectTemplate(ctx context.Context, projectID string) ([]csvtemplate.Row, error) {
+	allSections, err := e.repository.GetAllSections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+	var sections []api.Section
+	for i := range allSections {
+		if allSections[i].ProjectID == projectID {
+			sections = append(sections, allSections[i])
+		}
+	}
+
+	tasks, err := e.repository.GetTasksByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	// コメントの取得に失敗しても書き出しは続行する
+	notes := make(map[string][]api.Note)
+	for i := range tasks {
+		if tasks[i].DateCompleted != nil {
+			continue
+		}
+		taskNotes, err := e.repository.GetNotesByTask(ctx, tasks[i].ID)
+		if err != nil {
+			e.output.Warningf("Failed to load comments of %q: %v", tasks[i].Content, err)
+			continue
+		}
+		notes[tasks[i].ID] = taskNotes
+	}
+
+	return csvtemplate.FromProject(sections, tasks, notes), nil
+}
+
+// executeProjectImportWithOutput はテンプレートの取り込みと結果表示を実行する（テスト可能）
+func (e *projectExecutor) executeProjectImportWithOutput(ctx context.Context, params *projectImportParams) error {
+	if params.into != "" && params.name != "" {
+		return fmt.Errorf("--into and --name cannot be used together")
+	}
+
+	// 1. テンプレートを読み込む
+	file, err := os.Open(params.path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", params.path, err)
+	}
+	defer func() { _ = file.Close() }()
+	rows, err := csvtemplate.Read(file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", params.path, err)
+	}
+
+	// 2. 取り込み先を解決
+	opts := repository.TemplateImportOptions{ProjectName: params.name}
+	target := params.name
+	if params.into != "" {
+		project, err := e.resolveProject(ctx, params.into)
+		if err != nil {
+			return err
+		}
+		opts = repository.TemplateImportOptions{ProjectID: project.ID}
+		target = project.Name
+	} else if opts.ProjectName == "" {
+		opts.ProjectName = strings.TrimSuffix(filepath.Base(params.path), filepath.Ext(params.path))
+		target = opts.ProjectName
+	}
+
+	// 3. 取り込み実行
+	result, err := e.repository.ImportTemplate(ctx, rows, opts)
+	if err != nil {
+		if result != nil {
+			e.output.Warningf("The template was imported partially (run 'gotodoist undo' to remove it)")
+		}
+		return fmt.Errorf("failed to import template: %w", err)
+	}
+
+	// 4. 結果表示
+	e.displaySuccessMessageForProject(fmt.Sprintf("📥 Imported %s into %q", params.path, target), result.Response.SyncToken)
+	e.output.Plainf("   Sections: %d", result.Sections)
+	e.output.Plainf("   Tasks: %d", result.Tasks)
+	if result.Notes > 0 {
+		e.output.Plainf("   Comments: %d", result.Notes)
+	}
+	if result.Skipped > 0 {
+		e.output.Warningf("Skipped %d row(s) that could not be imported", result.Skipped)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func TestExecuteProjectExportWithOutput(t *testing.T) {
+	setup, _ := setupProjectDuplicateTest(t)
+	defer setup.cleanup()
+	insertTestNotesIntoDB(t, setup.dbPath, []api.Note{
+		{ID: "note-1", ItemID: "task-freeze", Content: "See runbook"},
+	})
+
+	// Act
+	err := setup.executor.executeProjectExportWithOutput(context.Background(), &projectExportParams{
+		projectIDOrName: "Release checklist",
+		format:          formatTodoistCSV,
+	})
+
+	// Assert: セクションなしのタスク→セクションごとのタスクの順に、サブタスクはINDENT 2で出力される
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"TYPE,CONTENT,DESCRIPTION,PRIORITY,INDENT,AUTHOR,RESPONSIBLE,DATE,DATE_LANG,TIMEZONE,DURATION,DURATION_UNIT,DEADLINE,DEADLINE_LANG",
+		"task,Weekly sync,,4,1,,,every wednesday,en,,,,,",
+		",,,,,,,,,,,,,",
+		"section,Prep,,,,,,,,,,,,",
+		"task,Freeze branch @release,Announce in #release,1,1,,,2026-11-02,en,,,,,",
+		"note,See runbook,,,1,,,,,,,,,",
+		"task,Run tests,,4,2,,,2026-11-03 10:00,en,,,,,",
+		",,,,,,,,,,,,,",
+		"section,Ship,,,,,,,,,,,,",
+		"task,Tag release,,4,1,,,,,,,,2026-11-05,en",
+		"",
+	}, "\n"), setup.stdout.String())
+}
+
+func TestExecuteProjectExportWithOutput_File(t *testing.T) {
+	setup, _ := setupProjectDuplicateTest(t)
+	defer setup.cleanup()
+	path := filepath.Join(t.TempDir(), "release.csv")
+
+	err := setup.executor.executeProjectExportWithOutput(context.Background(), &projectExportParams{
+		projectIDOrName: "Release checklist",
+		format:          formatTodoistCSV,
+		outputPath:      path,
+	})
+
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "section,Prep")
+	assert.Contains(t, setup.stdout.String(), `Exported project "Release checklist" to `+path)
+
+	err = setup.executor.executeProjectExportWithOutput(context.Background(), &projectExportParams{projectIDOrName: "Release checklist", format: "xml"})
+	assert.ErrorContains(t, err, "unsupported format: xml")
+}
+
+// writeTestTemplate はテスト用のテンプレートCSVを書き出す
+func writeTestTemplate(t *testing.T, name string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	content := strings.Join([]string{
+		"TYPE,CONTENT,DESCRIPTION,PRIORITY,INDENT,AUTHOR,RESPONSIBLE,DATE,DATE_LANG,TIMEZONE",
+		"meta,view_style=list,,,,,,,,",
+		"task,Kickoff @team,,2,1,,,tomorrow,en,",
+		",,,,,,,,,",
+		"section,QA,,,,,,,,",
+		"task,Test plan,Write it down,4,1,,,,,",
+		"note,Use the template,,,1,,,,,",
+		"task,Unit tests,,4,2,,,,,",
+		"task,Coverage report,,4,3,,,,,",
+		"task,E2E tests,,4,2,,,,,",
+		"task,Sign-off,,1,1,,,every friday,en,",
+	}, "\n")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// recordImportCommands は送信したコマンドを記録し、全て成功したレスポンスを返すよう設定する
+func recordImportCommands(mockClient *api.MockClient) *[]api.Command {
+	var sent []api.Command
+	mockClient.ExecuteCommandsFunc = func(_ context.Context, commands []api.Command) (*api.SyncResponse, error) {
+		sent = append(sent, commands...)
+		resp := &api.SyncResponse{SyncToken: "command-token", SyncStatus: make(map[string]interface{}), TempIDMapping: make(map[string]string)}
+		for i, cmd := range commands {
+			resp.SyncStatus[cmd.UUID] = "ok"
+			resp.TempIDMapping[cmd.TempID] = fmt.Sprintf("new-%d", i)
+		}
+		return resp, nil
+	}
+	return &sent
+}
+
+func TestExecuteProjectImportWithOutput(t *testing.T) {
+	setup := setupTestProjectExecutor(t)
+	defer setup.cleanup()
+	sent := recordImportCommands(setup.mockClient)
+	path := writeTestTemplate(t, "QA checklist.csv")
+
+	// Act: ファイル名のプロジェクトを新しく作成して取り込む
+	err := setup.executor.executeProjectImportWithOutput(context.Background(), &projectImportParams{path: path})
+
+	// Assert
+	require.NoError(t, err)
+	commands := *sent
+	require.Len(t, commands, 9)
+	project := commands[0]
+	assert.Equal(t, api.CommandProjectAdd, project.Type)
+	assert.Equal(t, "QA checklist", project.Args["name"])
+
+	tasks := commandsByContent(commands)
+	kickoff := tasks["Kickoff"]
+	assert.Equal(t, project.TempID, kickoff.Args["project_id"])
+	assert.NotContains(t, kickoff.Args, "section_id")
+	assert.Equal(t, []string{"team"}, kickoff.Args["labels"])
+	assert.Equal(t, 3, kickoff.Args["priority"])
+	assert.Equal(t, map[string]interface{}{"string": "tomorrow", "lang": "en"}, kickoff.Args["due"])
+
+	section := commands[2]
+	assert.Equal(t, api.CommandSectionAdd, section.Type)
+	assert.Equal(t, project.TempID, section.Args["project_id"])
+
+	// INDENTでサブタスクの親子関係が決まる
+	plan := tasks["Test plan"]
+	assert.Equal(t, section.TempID, plan.Args["section_id"])
+	assert.Equal(t, "Write it down", plan.Args["description"])
+	assert.Equal(t, 1, plan.Args["priority"])
+	assert.Equal(t, plan.TempID, tasks["Unit tests"].Args["parent_id"])
+	assert.Equal(t, tasks["Unit tests"].TempID, tasks["Coverage report"].Args["parent_id"])
+	assert.Equal(t, plan.TempID, tasks["E2E tests"].Args["parent_id"])
+	assert.NotContains(t, tasks["Sign-off"].Args, "parent_id")
+	assert.Equal(t, 4, tasks["Sign-off"].Args["priority"])
+
+	// コメントは直前のタスクに付く
+	note := commands[4]
+	assert.Equal(t, api.CommandNoteAdd, note.Type)
+	assert.Equal(t, plan.TempID, note.Args["item_id"])
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, `into "QA checklist"`)
+	assert.Contains(t, output, "Sections: 1")
+	assert.Contains(t, output, "Tasks: 6")
+	assert.Contains(t, output, "Comments: 1")
+}
+
+func TestExecuteProjectImportWithOutput_Into(t *testing.T) {
+	setup := setupTestProjectExecutor(t)
+	defer setup.cleanup()
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-feature", Name: "Feature X"}})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+	sent := recordImportCommands(setup.mockClient)
+	path := writeTestTemplate(t, "qa.csv")
+
+	err := setup.executor.executeProjectImportWithOutput(context.Background(), &projectImportParams{path: path, into: "Feature X"})
+
+	// Assert: プロジェクトは作成せず、既存のプロジェクトに追加する
+	require.NoError(t, err)
+	commands := *sent
+	require.Len(t, commands, 8)
+	assert.Equal(t, api.CommandItemAdd, commands[0].Type)
+	assert.Equal(t, "project-feature", commands[0].Args["project_id"])
+	assert.Equal(t, "project-feature", commands[1].Args["project_id"])
+	assert.Contains(t, setup.stdout.String(), `into "Feature X"`)
+
+	// 取り込んだ最上位のタスクをまとめて取り消せる
+	entries, err := setup.repository.ListUndoEntries(1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "create 3 tasks", entries[0].Description)
+}
+
+func TestExecuteProjectImportWithOutput_Errors(t *testing.T) {
+	setup := setupTestProjectExecutor(t)
+	defer setup.cleanup()
+	sent := recordImportCommands(setup.mockClient)
+
+	notTemplate := filepath.Join(t.TempDir(), "tasks.csv")
+	require.NoError(t, os.WriteFile(notTemplate, []byte("Name,Due\nBuy milk,today\n"), 0o600))
+
+	tests := []struct {
+		name        string
+		params      *projectImportParams
+		expectError string
+	}{
+		{name: "テンプレートではないCSV", params: &projectImportParams{path: notTemplate}, expectError: "missing TYPE column"},
+		{name: "存在しないファイル", params: &projectImportParams{path: filepath.Join(t.TempDir(), "missing.csv")}, expectError: "failed to open"},
+		{name: "取り込み先の同時指定", params: &projectImportParams{path: notTemplate, into: "Work", name: "New"}, expectError: "cannot be used together"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := setup.executor.executeProjectImportWithOutput(context.Background(), tt.params)
+
+			assert.ErrorContains(t, err, tt.expectError)
+		})
+	}
+	assert.Empty(t, *sent, "APIが呼ばれてはいけません")
+}
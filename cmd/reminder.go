@@ -0,0 +1,325 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func init() {
+	// サブコマンドを追加
+	reminderCmd.AddCommand(reminderListCmd)
+	reminderCmd.AddCommand(reminderAddCmd)
+	reminderCmd.AddCommand(reminderDeleteCmd)
+
+	// reminderコマンドをルートコマンドに追加
+	rootCmd.AddCommand(reminderCmd)
+}
+
+// reminderCmd はリマインダー関連のコマンド
+var reminderCmd = &cobra.Command{
+	Use:   "reminder",
+	Short: "Manage task reminders",
+	Long: `Manage Todoist reminders for your tasks.
+
+Reminders are either relative to the task's due time ("30m before due") or
+set for an absolute date and time ("2025-01-10 09:00").`,
+}
+
+// reminderListCmd はリマインダー一覧表示コマンド
+var reminderListCmd = &cobra.Command{
+	Use:     "list [task]",
+	Aliases: []string{"ls"},
+	Short:   "List reminders",
+	Long:    `List reminders of a single task, or of all tasks when no task is given.`,
+	Args:    cobra.MaximumNArgs(1),
+	RunE:    runReminderList,
+}
+
+// reminderAddCmd はリマインダー追加コマンド
+var reminderAddCmd = &cobra.Command{
+	Use:   "add <task> <when>",
+	Short: "Add a reminder to a task",
+	Long: `Add a reminder to a task.
+
+<when> is either relative to the task's due time or an absolute date and time:
+  30m before due, 2h before, 1d before, at due   relative (the task needs a due time)
+  2025-01-10 09:00, 2025-01-10T09:00             absolute (local time)
+
+The task can be specified by its full ID, any unique ID prefix, its
+number in the last 'task list' output, or its content.`,
+	Example: `  gotodoist reminder add 1 30m before due
+  gotodoist reminder add "Submit report" 2025-01-10 09:00`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runReminderAdd,
+}
+
+// reminderDeleteCmd はリマインダー削除コマンド
+var reminderDeleteCmd = &cobra.Command{
+	Use:   "delete <reminder ID>",
+	Short: "Delete a reminder",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runReminderDelete,
+}
+
+// reminderListParams はリマインダー一覧のパラメータ
+type reminderListParams struct {
+	taskRef string // 空の場合は全タスクのリマインダー
+}
+
+// reminderAddParams はリマインダー追加のパラメータ
+type reminderAddParams struct {
+	taskRef string
+	when    string
+}
+
+// reminderDeleteParams はリマインダー削除のパラメータ
+type reminderDeleteParams struct {
+	reminderID string
+}
+
+// runReminderList はリマインダー一覧の実際の処理
+func runReminderList(_ *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := &reminderListParams{}
+	if len(args) > 0 {
+		params.taskRef = args[0]
+	}
+	return executor.executeReminderListWithOutput(ctx, params)
+}
+
+// runReminderAdd はリマインダー追加の実際の処理
+func runReminderAdd(_ *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行（日時指定は空白を含むため残りの引数を連結する）
+	params := &reminderAddParams{
+		taskRef: args[0],
+		when:    strings.Join(args[1:], " "),
+	}
+	return executor.executeReminderAddWithOutput(ctx, params)
+}
+
+// runReminderDelete はリマインダー削除の実際の処理
+func runReminderDelete(_ *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// 実行
+	return executor.executeReminderDeleteWithOutput(ctx, &reminderDeleteParams{reminderID: args[0]})
+}
+
+// executeReminderListWithOutput はリマインダー一覧の取得と表示を実行する（テスト可能）
+func (e *taskExecutor) executeReminderListWithOutput(ctx context.Context, params *reminderListParams) error {
+	// 1. 対象タスクを解決
+	var task *api.Item
+	if params.taskRef != "" {
+		found, err := e.findTaskByID(ctx, params.taskRef)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("task not found: %s", params.taskRef)
+		}
+		task = found
+	}
+
+	// 2. リマインダーを取得
+	taskID := ""
+	if task != nil {
+		taskID = task.ID
+	}
+	reminders, err := e.repository.GetReminders(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get reminders: %w", err)
+	}
+
+	// 3. 結果表示
+	if len(reminders) == 0 {
+		e.output.Infof("📭 No reminders found")
+		return nil
+	}
+
+	if task != nil {
+		e.output.Listf("Reminders for %q (%d):", task.Content, len(reminders))
+		for i := range reminders {
+			e.output.Plainf("  ⏰ %s (ID: %s)", formatReminder(&reminders[i]), reminders[i].ID)
+		}
+		return nil
+	}
+
+	tasks, err := e.repository.GetTasks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tasks: %w", err)
+	}
+	taskNames := make(map[string]string, len(tasks))
+	for i := range tasks {
+		taskNames[tasks[i].ID] = tasks[i].Content
+	}
+
+	e.output.Listf("Found %d reminder(s):", len(reminders))
+	for i := range reminders {
+		name := taskNames[reminders[i].ItemID]
+		if name == "" {
+			name = reminders[i].ItemID
+		}
+		e.output.Plainf("  ⏰ %s - %s (ID: %s)", formatReminder(&reminders[i]), name, reminders[i].ID)
+	}
+
+	return nil
+}
+
+// executeReminderAddWithOutput はリマインダーの追加と結果表示を実行する（テスト可能）
+func (e *taskExecutor) executeReminderAddWithOutput(ctx context.Context, params *reminderAddParams) error {
+	// 1. 通知タイミングを解析
+	req, err := parseReminderSpec(params.when)
+	if err != nil {
+		return err
+	}
+
+	// 2. 対象タスクを解決
+	task, err := e.findTaskByID(ctx, params.taskRef)
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		return fmt.Errorf("task not found: %s", params.taskRef)
+	}
+	if req.Type == api.ReminderTypeRelative && !hasDueTime(task) {
+		return fmt.Errorf("task %q has no due time; relative reminders need one (set it with 'gotodoist task update --due')", task.Content)
+	}
+	req.ItemID = task.ID
+
+	// 3. リマインダー作成実行
+	resp, err := e.repository.CreateReminder(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to add reminder: %w", err)
+	}
+
+	// 4. 結果表示
+	reminder := api.Reminder{Type: req.Type, MinuteOffset: req.MinuteOffset}
+	if req.Type == api.ReminderTypeAbsolute {
+		reminder.Due = &api.Due{Date: req.DueDate}
+	}
+	e.displaySuccessMessage(fmt.Sprintf("Reminder added to %q: %s", task.Content, formatReminder(&reminder)), resp.SyncToken)
+
+	return nil
+}
+
+// executeReminderDeleteWithOutput はリマインダーの削除と結果表示を実行する（テスト可能）
+func (e *taskExecutor) executeReminderDeleteWithOutput(ctx context.Context, params *reminderDeleteParams) error {
+	// 1. リマインダー削除実行
+	resp, err := e.repository.DeleteReminder(ctx, params.reminderID)
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+
+	// 2. 結果表示
+	e.displaySuccessMessage("Reminder deleted successfully!", resp.SyncToken)
+
+	return nil
+}
+
+// relativeReminderPattern は期限からの相対指定（例: 30m before due, 2 hours before, 1d）
+var relativeReminderPattern = regexp.MustCompile(`^(\d+)\s*(m|mins?|minutes?|h|hrs?|hours?|d|days?)(?:\s+before(?:\s+due)?)?$`)
+
+// absoluteReminderLayouts は日時指定として受け付ける形式
+var absoluteReminderLayouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+}
+
+// parseReminderSpec は通知タイミングの指定をリマインダー作成リクエストに変換する
+func parseReminderSpec(spec string) (*api.CreateReminderRequest, error) {
+	spec = strings.ToLower(strings.Join(strings.Fields(spec), " "))
+
+	if spec == "at due" || spec == "on due" {
+		return &api.CreateReminderRequest{Type: api.ReminderTypeRelative}, nil
+	}
+
+	if m := relativeReminderPattern.FindStringSubmatch(spec); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid reminder offset: %s", m[1])
+		}
+		switch m[2][0] {
+		case 'h':
+			n *= 60
+		case 'd':
+			n *= 24 * 60
+		}
+		return &api.CreateReminderRequest{Type: api.ReminderTypeRelative, MinuteOffset: n}, nil
+	}
+
+	for _, layout := range absoluteReminderLayouts {
+		if t, err := time.ParseInLocation(layout, strings.ToUpper(spec), time.Local); err == nil {
+			return &api.CreateReminderRequest{Type: api.ReminderTypeAbsolute, DueDate: t.Format("2006-01-02T15:04:05")}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid reminder time %q (use e.g. '30m before due', '2h before' or '2025-01-10 09:00')", spec)
+}
+
+// hasDueTime はタスクの期限に時刻が指定されているかを返す
+func hasDueTime(task *api.Item) bool {
+	return task.Due != nil && len(task.Due.Date) > len("2006-01-02")
+}
+
+// formatReminder はリマインダーの通知タイミングを表示用の文字列に整形する
+func formatReminder(reminder *api.Reminder) string {
+	if reminder.Type != api.ReminderTypeRelative {
+		if reminder.Due == nil {
+			return "unknown time"
+		}
+		if t, err := time.Parse("2006-01-02T15:04:05", reminder.Due.Date); err == nil {
+			return t.Format("2006-01-02 15:04")
+		}
+		if t, err := time.Parse(time.RFC3339, reminder.Due.Date); err == nil {
+			return t.Local().Format("2006-01-02 15:04")
+		}
+		return reminder.Due.Date
+	}
+
+	offset := reminder.MinuteOffset
+	switch {
+	case offset == 0:
+		return "at due time"
+	case offset%(24*60) == 0:
+		return fmt.Sprintf("%dd before due", offset/(24*60))
+	case offset%60 == 0:
+		return fmt.Sprintf("%dh before due", offset/60)
+	default:
+		return fmt.Sprintf("%dm before due", offset)
+	}
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func TestParseReminderSpec(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         string
+		expectedType string
+		minuteOffset int
+		dueDate      string
+		expectError  bool
+	}{
+		{name: "分単位の相対指定", spec: "30m before due", expectedType: api.ReminderTypeRelative, minuteOffset: 30},
+		{name: "時間単位の相対指定", spec: "2 hours before", expectedType: api.ReminderTypeRelative, minuteOffset: 120},
+		{name: "日単位の相対指定", spec: "1d", expectedType: api.ReminderTypeRelative, minuteOffset: 1440},
+		{name: "期限ちょうど", spec: "at due", expectedType: api.ReminderTypeRelative, minuteOffset: 0},
+		{name: "空白区切りの日時指定", spec: "2025-01-10 09:00", expectedType: api.ReminderTypeAbsolute, dueDate: "2025-01-10T09:00:00"},
+		{name: "T区切りの日時指定", spec: "2025-01-10T18:30", expectedType: api.ReminderTypeAbsolute, dueDate: "2025-01-10T18:30:00"},
+		{name: "不正な指定", spec: "tomorrow morning", expectError: true},
+		{name: "不正な日時", spec: "2025-13-10 09:00", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := parseReminderSpec(tt.spec)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedType, req.Type)
+			assert.Equal(t, tt.minuteOffset, req.MinuteOffset)
+			assert.Equal(t, tt.dueDate, req.DueDate)
+		})
+	}
+}
+
+func TestExecuteReminderAddWithOutput_Relative(t *testing.T) {
+	// Arrange: 時刻付きの期限を持つタスク
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-1", Content: "Team meeting", Due: &api.Due{Date: "2025-01-10T10:00:00"}},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	var created *api.CreateReminderRequest
+	setup.mockClient.CreateReminderFunc = func(_ context.Context, req *api.CreateReminderRequest) (*api.SyncResponse, error) {
+		created = req
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+	setup.mockClient.SyncFunc = func(_ context.Context, _ *api.SyncRequest) (*api.SyncResponse, error) {
+		return &api.SyncResponse{
+			SyncToken: "next-token",
+			Reminders: []api.Reminder{{ID: "reminder-1", ItemID: "task-1", Type: api.ReminderTypeRelative, MinuteOffset: 30}},
+		}, nil
+	}
+
+	// Act
+	err := setup.executor.executeReminderAddWithOutput(context.Background(), &reminderAddParams{taskRef: "task-1", when: "30m before due"})
+
+	// Assert: リマインダーが作成され、同期でローカルに反映される
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, "task-1", created.ItemID)
+	assert.Equal(t, api.ReminderTypeRelative, created.Type)
+	assert.Equal(t, 30, created.MinuteOffset)
+	assert.Contains(t, setup.stdout.String(), `Reminder added to "Team meeting": 30m before due`)
+
+	setup.stdout.Reset()
+	require.NoError(t, setup.executor.executeReminderListWithOutput(context.Background(), &reminderListParams{}))
+	assert.Contains(t, setup.stdout.String(), "30m before due - Team meeting (ID: reminder-1)")
+}
+
+func TestExecuteReminderAddWithOutput_RelativeRequiresDueTime(t *testing.T) {
+	// Arrange: 日付のみの期限を持つタスク
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-1", Content: "Pay rent", Due: &api.Due{Date: "2025-01-10"}},
+	})
+	setup.mockClient.CreateReminderFunc = func(_ context.Context, _ *api.CreateReminderRequest) (*api.SyncResponse, error) {
+		t.Fatal("期限に時刻が無いタスクに相対リマインダーを作成するべきではありません")
+		return nil, nil
+	}
+
+	// Act
+	err := setup.executor.executeReminderAddWithOutput(context.Background(), &reminderAddParams{taskRef: "task-1", when: "1h before"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no due time")
+}
+
+func TestExecuteReminderDeleteWithOutput(t *testing.T) {
+	// Arrange
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{{ID: "task-1", ProjectID: "project-1", Content: "Call mom"}})
+	insertTestRemindersIntoDB(t, setup.dbPath, []api.Reminder{
+		{ID: "reminder-1", ItemID: "task-1", Type: api.ReminderTypeAbsolute, Due: &api.Due{Date: "2025-01-10T09:00:00"}},
+		{ID: "reminder-2", ItemID: "task-1", Type: api.ReminderTypeRelative, MinuteOffset: 60},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	var deletedID string
+	setup.mockClient.DeleteReminderFunc = func(_ context.Context, reminderID string) (*api.SyncResponse, error) {
+		deletedID = reminderID
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+
+	// Act
+	err := setup.executor.executeReminderDeleteWithOutput(context.Background(), &reminderDeleteParams{reminderID: "reminder-1"})
+
+	// Assert: 削除したリマインダーだけがローカルから消える
+	require.NoError(t, err)
+	assert.Equal(t, "reminder-1", deletedID)
+
+	setup.stdout.Reset()
+	require.NoError(t, setup.executor.executeReminderListWithOutput(context.Background(), &reminderListParams{taskRef: "task-1"}))
+	output := setup.stdout.String()
+	assert.Contains(t, output, `Reminders for "Call mom" (1):`)
+	assert.Contains(t, output, "1h before due (ID: reminder-2)")
+	assert.NotContains(t, output, "reminder-1")
+}
@@ -22,6 +22,7 @@ type GlobalFlags struct {
 	Verbose       bool
 	Debug         bool
 	ShowBenchmark bool
+	DryRun        bool
 }
 
 var (
@@ -36,6 +37,7 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&globalFlags.Verbose, "verbose", "v", false, "enable verbose output")
 	rootCmd.PersistentFlags().BoolVar(&globalFlags.Debug, "debug", false, "enable debug mode")
 	rootCmd.PersistentFlags().BoolVar(&globalFlags.ShowBenchmark, "benchmark", false, "show detailed performance timing")
+	rootCmd.PersistentFlags().BoolVar(&globalFlags.DryRun, "dry-run", false, "print the Sync commands as JSON instead of sending them (local storage is not modified)")
 
 	// 設定の初期化
 	cobra.OnInitialize(initConfig)
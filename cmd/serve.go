@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/ical"
+)
+
+// defaultServeAddr はサーバーの既定の待ち受けアドレス（ローカルからのみ接続できる）
+const defaultServeAddr = "127.0.0.1:8787"
+
+func init() {
+	// サブコマンドを追加
+	serveCmd.AddCommand(serveICSCmd)
+
+	// serveコマンドをルートコマンドに追加
+	rootCmd.AddCommand(serveCmd)
+
+	// serve ics用のフラグ
+	serveICSCmd.Flags().String("addr", defaultServeAddr, "address to listen on")
+	serveICSCmd.Flags().StringP("project", "p", "", "serve only the tasks of this project (name or ID)")
+	serveICSCmd.Flags().StringP("filter", "f", "", "serve only the tasks matching a Todoist filter query")
+	serveICSCmd.Flags().String("component", ical.ComponentEvent, "iCalendar entries to serve: "+strings.Join(ical.Components, ", "))
+	serveICSCmd.Flags().Bool("completed", false, "include completed tasks")
+	serveICSCmd.Flags().Duration("sync-interval", 0, "sync the local data at this interval (e.g. 15m; 0 disables)")
+}
+
+// serveCmd はローカルのデータを配信するコマンド
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the local data over HTTP",
+	Long:  `Serve the locally synced Todoist data over HTTP, e.g. as a calendar feed.`,
+}
+
+// serveICSCmd はiCalendar形式のフィードの配信コマンド
+var serveICSCmd = &cobra.Command{
+	Use:   "ics",
+	Short: "Serve tasks as an iCalendar feed for calendar apps",
+	Long: `Serve the same iCalendar feed as 'gotodoist export --format ics' from the local
+data, so that calendar apps can subscribe to it. The feed is generated on every
+request and is available at /calendar.ics.
+
+The query parameters project, filter and component override the flags for a
+single subscription (e.g. /calendar.ics?filter=%23Work).
+
+The feed reflects the local data: run 'gotodoist sync' regularly, or use
+--sync-interval to sync while serving. This command requires local storage
+to be enabled.`,
+	Example: `  gotodoist serve ics
+  gotodoist serve ics --addr 127.0.0.1:9000 --filter "#Work" --sync-interval 15m`,
+	Args: cobra.NoArgs,
+	RunE: runServeICS,
+}
+
+// serveICSParams はフィード配信のパラメータ
+type serveICSParams struct {
+	addr         string
+	calendar     calendarParams
+	syncInterval time.Duration
+}
+
+// getServeICSParams はフィード配信のパラメータを取得する
+func getServeICSParams(cmd *cobra.Command) *serveICSParams {
+	addr, _ := cmd.Flags().GetString("addr")
+	project, _ := cmd.Flags().GetString("project")
+	filterQuery, _ := cmd.Flags().GetString("filter")
+	component, _ := cmd.Flags().GetString("component")
+	completed, _ := cmd.Flags().GetBool("completed")
+	syncInterval, _ := cmd.Flags().GetDuration("sync-interval")
+	return &serveICSParams{
+		addr: addr,
+		calendar: calendarParams{
+			projectIDOrName: project,
+			filterQuery:     filterQuery,
+			component:       component,
+			completed:       completed,
+		},
+		syncInterval: syncInterval,
+	}
+}
+
+// runServeICS はフィード配信の実際の処理
+func runServeICS(cmd *cobra.Command, _ []string) error {
+	ctx, stop := signal.NotifyContext(createBaseContext(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getServeICSParams(cmd)
+	return executor.executeServeICSWithOutput(ctx, params)
+}
+
+// executeServeICSWithOutput はフィードの配信を実行する（中断されるまで戻らない）
+func (e *taskExecutor) executeServeICSWithOutput(ctx context.Context, params *serveICSParams) error {
+	if e.cfg.LocalStorage == nil || !e.cfg.LocalStorage.Enabled {
+		return fmt.Errorf("local storage is disabled. Enable it in config to use serve command")
+	}
+
+	// 起動時に設定の誤りがわかるように、一度フィードを生成しておく
+	var buf bytes.Buffer
+	if _, err := e.writeCalendar(ctx, &buf, &params.calendar); err != nil {
+		return err
+	}
+
+	feed := newCalendarFeed(e, params.calendar)
+	server := &http.Server{
+		Addr:              params.addr,
+		Handler:           feed,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	if params.syncInterval > 0 {
+		go feed.syncEvery(ctx, params.syncInterval)
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	e.output.Infof("📅 Serving calendar feed at http://%s/calendar.ics (press Ctrl+C to stop)", params.addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve: %w", err)
+	}
+	return nil
+}
+
+// calendarFeed はリクエストごとにローカルのデータからフィードを生成するハンドラ
+type calendarFeed struct {
+	executor *taskExecutor
+	params   calendarParams
+	mu       sync.Mutex // ローカルのデータの読み込みと同期を直列にする
+}
+
+// newCalendarFeed はフィードのハンドラを作成する
+func newCalendarFeed(e *taskExecutor, params calendarParams) *calendarFeed {
+	return &calendarFeed{executor: e, params: params}
+}
+
+// ServeHTTP は /calendar.ics（および /）へのリクエストにフィードを返す
+func (f *calendarFeed) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" && r.URL.Path != "/calendar.ics" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// クエリパラメータでフラグの指定を上書きできる
+	params := f.params
+	query := r.URL.Query()
+	if query.Has("project") {
+		params.projectIDOrName = query.Get("project")
+	}
+	if query.Has("filter") {
+		params.filterQuery = query.Get("filter")
+	}
+	if query.Has("component") {
+		params.component = query.Get("component")
+	}
+
+	var buf bytes.Buffer
+	f.mu.Lock()
+	_, err := f.executor.writeCalendar(r.Context(), &buf, &params)
+	f.mu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(buf.Bytes())
+}
+
+// syncEvery はctxが終了するまで一定の間隔でローカルのデータを同期する
+func (f *calendarFeed) syncEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.mu.Lock()
+			err := f.executor.repository.Sync(ctx)
+			f.mu.Unlock()
+			if err != nil {
+				f.executor.output.Warningf("Failed to sync: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/ical"
+)
+
+func TestCalendarFeed(t *testing.T) {
+	setup := setupExportTest(t)
+	defer setup.cleanup()
+	server := httptest.NewServer(newCalendarFeed(setup.executor, calendarParams{projectIDOrName: "Release"}))
+	defer server.Close()
+
+	// Act
+	resp, err := http.Get(server.URL + "/calendar.ics")
+
+	// Assert: exportと同じフィードを返す
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, ical.ContentType, resp.Header.Get("Content-Type"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "X-WR-CALNAME:Work/Release\r\n")
+	assert.Contains(t, string(body), "UID:task-task-freeze@gotodoist\r\n")
+}
+
+func TestCalendarFeed_QueryOverrides(t *testing.T) {
+	setup := setupExportTest(t)
+	defer setup.cleanup()
+	feed := newCalendarFeed(setup.executor, calendarParams{projectIDOrName: "Release"})
+
+	// クエリパラメータでプロジェクト・フィルタ・コンポーネントを上書きできる
+	rec := httptest.NewRecorder()
+	feed.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/calendar.ics?project=&filter=%40errand&component=todo", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "SUMMARY:Buy milk\r\n")
+	assert.NotContains(t, rec.Body.String(), "Freeze branch")
+
+	rec = httptest.NewRecorder()
+	feed.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/calendar.ics?filter=(today", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid filter query")
+
+	rec = httptest.NewRecorder()
+	feed.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/other", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	feed.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/calendar.ics", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -289,8 +290,9 @@ func setupSyncExecution(ctx context.Context) (*syncExecutor, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
+	cfg.DryRun = IsDryRun()
 
-	output := cli.New(IsVerbose())
+	output := newOutput(cfg.DryRun, os.Stdout, os.Stderr)
 
 	repo, err := factory.NewRepository(cfg, IsVerbose())
 	if err != nil {
@@ -393,8 +395,7 @@ func (e *syncExecutor) promptResetConfirmation() bool {
 	e.output.Plainf("")
 	e.output.PlainNoNewlinef("Are you sure you want to reset local storage? (y/N): ")
 
-	var confirmation string
-	_, err := fmt.Scanln(&confirmation)
+	confirmation, err := readPromptLine()
 	if err != nil {
 		e.output.Errorf("Reset canceled")
 		return false
@@ -2,9 +2,12 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -12,9 +15,13 @@ import (
 	"github.com/kyokomi/gotodoist/internal/cli"
 	"github.com/kyokomi/gotodoist/internal/config"
 	"github.com/kyokomi/gotodoist/internal/factory"
+	"github.com/kyokomi/gotodoist/internal/filter"
 	"github.com/kyokomi/gotodoist/internal/repository"
 )
 
+// defaultCompletedSince は--completed時の対象期間の既定値
+const defaultCompletedSince = "7d"
+
 func init() {
 	// サブコマンドを追加
 	taskCmd.AddCommand(taskListCmd)
@@ -31,23 +38,38 @@ func init() {
 	taskListCmd.Flags().StringP("project", "p", "", "filter by project name or ID")
 	taskListCmd.Flags().StringP("filter", "f", "", "filter expression (p1-p4 for priority, @label for labels, keywords for content)")
 	taskListCmd.Flags().BoolP("all", "a", false, "show all tasks including completed")
+	taskListCmd.Flags().Bool("completed", false, "show completed tasks from the Todoist completion history")
+	taskListCmd.Flags().String("saved", "", "show tasks matching a saved filter (name or ID), evaluated against local tasks")
+	taskListCmd.Flags().String("assigned-to", "", "show tasks assigned to a collaborator (email, name or 'me')")
+	taskListCmd.Flags().String("since", defaultCompletedSince, "with --completed, show tasks completed within this period (e.g. 24h, 7d, 2w) or since a date (YYYY-MM-DD)")
 
 	// task add用のフラグ
 	taskAddCmd.Flags().StringP("project", "p", "", "project name or ID to add task to")
 	taskAddCmd.Flags().StringP("priority", "P", "", "task priority (1-4)")
 	taskAddCmd.Flags().StringP("due", "d", "", "due date (e.g., 'today', 'tomorrow', '2024-12-25')")
+	taskAddCmd.Flags().String("due-datetime", "", "due date and time (e.g., '2024-12-25 09:30', or RFC3339 for a fixed time zone)")
+	taskAddCmd.Flags().String("duration", "", "time estimate (e.g., 45m, 1h30m, 1d)")
+	taskAddCmd.Flags().String("deadline", "", "deadline date (YYYY-MM-DD)")
 	taskAddCmd.Flags().StringP("description", "D", "", "task description")
 	taskAddCmd.Flags().StringP("labels", "l", "", "comma-separated labels")
+	taskAddCmd.Flags().String("assign", "", "assign to a collaborator of the project (email, name or 'me')")
 
 	// task update用のフラグ
 	taskUpdateCmd.Flags().StringP("content", "c", "", "new task content")
 	taskUpdateCmd.Flags().StringP("priority", "P", "", "task priority (1-4)")
 	taskUpdateCmd.Flags().StringP("due", "d", "", "due date (e.g., 'today', 'tomorrow', '2024-12-25')")
+	taskUpdateCmd.Flags().String("due-datetime", "", "due date and time (e.g., '2024-12-25 09:30', or RFC3339 for a fixed time zone)")
+	taskUpdateCmd.Flags().String("duration", "", "time estimate (e.g., 45m, 1h30m, 1d)")
+	taskUpdateCmd.Flags().String("deadline", "", "deadline date (YYYY-MM-DD)")
 	taskUpdateCmd.Flags().StringP("description", "D", "", "task description")
 	taskUpdateCmd.Flags().StringP("labels", "l", "", "comma-separated labels")
+	taskUpdateCmd.Flags().String("assign", "", "assign to a collaborator of the project (email, name or 'me')")
 
 	// task delete用のフラグ
 	taskDeleteCmd.Flags().BoolP("force", "f", false, "skip confirmation prompt")
+
+	// task complete用のフラグ
+	taskCompleteCmd.Flags().Bool("forever", false, "complete recurring tasks permanently instead of rescheduling them")
 }
 
 // taskCmd はタスク関連のコマンド
@@ -61,53 +83,96 @@ var taskCmd = &cobra.Command{
 var taskListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all tasks",
-	Long:  `Display a list of all your Todoist tasks.`,
-	RunE:  runTaskList,
+	Long: `Display a list of all your Todoist tasks.
+
+Use --completed to show tasks completed within the --since period (default: 7d),
+newest first. Completed tasks are fetched from Todoist's completion history and
+cached in the local database.
+
+Use --saved to show tasks matching one of your Todoist saved filters. The
+filter's query is evaluated against local tasks and supports priorities (p1-p4),
+#Project, ##Project, /Section, @label, today, tomorrow, overdue, no date,
+N days, due before:/after:, deadline before:/after:, no deadline, search:, and
+the &, |, ! and () operators.`,
+	Example: `  gotodoist task list
+  gotodoist task list --saved "Sprint board"
+  gotodoist task list --completed --since 7d --project Work
+  gotodoist task list --completed --since 2025-01-01`,
+	RunE: runTaskList,
 }
 
 // taskAddCmd はタスク追加コマンド
 var taskAddCmd = &cobra.Command{
 	Use:   "add [task content]",
 	Short: "Add a new task",
-	Long:  `Add a new task to your Todoist.`,
-	Args:  cobra.MinimumNArgs(1),
-	RunE:  runTaskAdd,
+	Long: `Add a new task to your Todoist.
+
+Use --due for natural language dates, or --due-datetime for an exact date and
+time. --duration sets a time estimate and --deadline a date the task must be
+finished by, independent of its due date.`,
+	Example: `  gotodoist task add "Write report" --due tomorrow --duration 45m
+  gotodoist task add "Team sync" --due-datetime "2025-01-10 09:30" --duration 30m
+  gotodoist task add "Tax return" --deadline 2025-03-15`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTaskAdd,
 }
 
 // taskUpdateCmd はタスク更新コマンド
 var taskUpdateCmd = &cobra.Command{
-	Use:   "update [task ID]",
-	Short: "Update an existing task",
-	Long:  `Update the content of an existing task.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTaskUpdate,
+	Use:   "update [task ID...]",
+	Short: "Update existing tasks",
+	Long: `Update one or more existing tasks.
+
+Tasks can be specified by ID, a unique ID prefix, their numbers in the last 'task list' output,
+their content (partial matches are confirmed before use), or all at once with --filter.
+Bulk updates show a preview and ask for confirmation (skip with --yes).`,
+	Args: taskRefsOrFilterArgs,
+	RunE: runTaskUpdate,
 }
 
 // taskDeleteCmd はタスク削除コマンド
 var taskDeleteCmd = &cobra.Command{
-	Use:   "delete [task ID]",
-	Short: "Delete a task",
-	Long:  `Delete a task from your Todoist.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTaskDelete,
+	Use:   "delete [task ID...]",
+	Short: "Delete tasks",
+	Long: `Delete one or more tasks from your Todoist.
+
+Tasks can be specified by ID, a unique ID prefix, their numbers in the last 'task list' output,
+their content (partial matches are confirmed before use), or all at once with --filter.
+Bulk deletions show a preview and ask for confirmation (skip with --force).`,
+	Args: taskRefsOrFilterArgs,
+	RunE: runTaskDelete,
 }
 
 // taskCompleteCmd はタスク完了コマンド
 var taskCompleteCmd = &cobra.Command{
-	Use:   "complete [task ID]",
-	Short: "Mark a task as completed",
-	Long:  `Mark a task as completed in your Todoist.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTaskComplete,
+	Use:   "complete [task ID...]",
+	Short: "Mark tasks as completed",
+	Long: `Mark one or more tasks as completed in your Todoist.
+
+Tasks can be specified by ID, a unique ID prefix, their numbers in the last 'task list' output,
+their content (partial matches are confirmed before use), or all at once with --filter.
+Bulk operations show a preview and ask for confirmation (skip with --yes):
+
+  gotodoist task complete 3 5 7
+  gotodoist task complete "renew passport"
+  gotodoist task complete --filter "@sprint-12"
+
+Recurring tasks are rescheduled to their next occurrence, as in the Todoist apps.
+Use --forever to complete a recurring task permanently and stop the recurrence.`,
+	Args: taskRefsOrFilterArgs,
+	RunE: runTaskComplete,
 }
 
 // taskUncompleteCmd はタスク未完了コマンド
 var taskUncompleteCmd = &cobra.Command{
-	Use:   "uncomplete [task ID]",
-	Short: "Mark a task as uncompleted",
-	Long:  `Mark a completed task as uncompleted in your Todoist.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTaskUncomplete,
+	Use:   "uncomplete [task ID...]",
+	Short: "Mark tasks as uncompleted",
+	Long: `Mark one or more completed tasks as uncompleted in your Todoist.
+
+Tasks can be specified by ID, a unique ID prefix, their numbers in the last 'task list' output,
+or their content (partial matches are confirmed before use).`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTaskUncomplete,
 }
 
 // taskListParams はタスクリスト実行のパラメータ
@@ -115,26 +180,73 @@ type taskListParams struct {
 	projectFilter    string
 	filterExpression string
 	showAll          bool
+	savedFilter      string    // 保存済みフィルタの名前またはID
+	assignedTo       string    // 担当者（メールアドレス・名前・me）
+	completed        bool      // 完了済みタスクの履歴を表示する
+	since            time.Time // completed時の対象期間の開始日時
 }
 
 // taskListData はタスクリスト実行で取得したデータ
 type taskListData struct {
-	tasks       []api.Item
-	projectsMap map[string]string
-	sectionsMap map[string]string
+	tasks        []api.Item
+	projectsMap  map[string]string
+	sectionsMap  map[string]string
+	assigneesMap map[string]string
 }
 
 // getTaskListParams はコマンドフラグからパラメータを取得する
-func getTaskListParams(cmd *cobra.Command) *taskListParams {
+func getTaskListParams(cmd *cobra.Command) (*taskListParams, error) {
 	projectFilter, _ := cmd.Flags().GetString("project")
 	filterExpression, _ := cmd.Flags().GetString("filter")
 	showAll, _ := cmd.Flags().GetBool("all")
+	completed, _ := cmd.Flags().GetBool("completed")
+	savedFilter, _ := cmd.Flags().GetString("saved")
+	sinceValue, _ := cmd.Flags().GetString("since")
+	assignedTo, _ := cmd.Flags().GetString("assigned-to")
+
+	if completed && savedFilter != "" {
+		return nil, fmt.Errorf("--saved cannot be combined with --completed")
+	}
+	if completed && assignedTo != "" {
+		return nil, fmt.Errorf("--assigned-to cannot be combined with --completed")
+	}
+
+	since, err := parseSince(sinceValue, time.Now())
+	if err != nil {
+		return nil, err
+	}
 
 	return &taskListParams{
 		projectFilter:    projectFilter,
 		filterExpression: filterExpression,
 		showAll:          showAll,
+		savedFilter:      savedFilter,
+		assignedTo:       assignedTo,
+		completed:        completed,
+		since:            since,
+	}, nil
+}
+
+// parseSince は期間（24h, 7d, 2w）または日付（YYYY-MM-DD）から開始日時を求める
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if date, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return date, nil
 	}
+
+	unitDurations := map[byte]time.Duration{
+		'h': time.Hour,
+		'd': 24 * time.Hour,
+		'w': 7 * 24 * time.Hour,
+	}
+	if len(value) >= 2 {
+		if unit, ok := unitDurations[value[len(value)-1]]; ok {
+			if n, err := strconv.Atoi(value[:len(value)-1]); err == nil && n > 0 {
+				return now.Add(-time.Duration(n) * unit), nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --since value: %s (use e.g. 24h, 7d, 2w or YYYY-MM-DD)", value)
 }
 
 // runTaskList はタスク一覧表示の実際の処理
@@ -149,12 +261,19 @@ func runTaskList(cmd *cobra.Command, _ []string) error {
 	defer executor.cleanup()
 
 	// パラメータ取得と実行
-	params := getTaskListParams(cmd)
+	params, err := getTaskListParams(cmd)
+	if err != nil {
+		return err
+	}
 	return executor.executeTaskListWithOutput(ctx, params)
 }
 
 // executeTaskListWithOutput はタスク一覧表示と結果表示を実行する（テスト可能）
 func (e *taskExecutor) executeTaskListWithOutput(ctx context.Context, params *taskListParams) error {
+	if params.completed {
+		return e.executeCompletedTaskListWithOutput(ctx, params)
+	}
+
 	// 1. データ取得
 	data, err := e.fetchAllTaskListData(ctx, params)
 	if err != nil {
@@ -163,13 +282,142 @@ func (e *taskExecutor) executeTaskListWithOutput(ctx context.Context, params *ta
 
 	// 2. フィルタリング
 	filteredTasks := applyTaskFilters(data.tasks, params)
+	if params.savedFilter != "" {
+		filteredTasks, err = e.applySavedFilter(ctx, filteredTasks, params.savedFilter)
+		if err != nil {
+			return err
+		}
+	}
+
+	if params.assignedTo != "" {
+		filteredTasks, err = e.applyAssigneeFilter(ctx, filteredTasks, params.assignedTo)
+		if err != nil {
+			return err
+		}
+	}
 
 	// 3. 出力
-	e.displayTaskResults(data.projectsMap, data.sectionsMap, filteredTasks)
+	e.displayTaskResults(data, filteredTasks)
+
+	// 4. 一覧番号を保存（task complete 3 などで参照するため）
+	e.saveTaskListIndex(ctx, filteredTasks)
+
+	return nil
+}
+
+// applySavedFilter は保存済みフィルタのクエリでタスクを絞り込む
+func (e *taskExecutor) applySavedFilter(ctx context.Context, tasks []api.Item, nameOrID string) ([]api.Item, error) {
+	saved, err := e.repository.FindFilter(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := filter.Parse(saved.Query)
+	if err != nil {
+		return nil, fmt.Errorf("cannot evaluate saved filter %q locally: %w", saved.Name, err)
+	}
+
+	projects, err := e.repository.GetAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	sections, err := e.repository.GetAllSections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+
+	e.output.Infof("🔍 %s: %s", saved.Name, saved.Query)
+	return query.Filter(tasks, &filter.Context{Now: time.Now(), Projects: projects, Sections: sections}), nil
+}
+
+// applyAssigneeFilter は担当者でタスクを絞り込む
+func (e *taskExecutor) applyAssigneeFilter(ctx context.Context, tasks []api.Item, ref string) ([]api.Item, error) {
+	assignee, err := e.repository.FindCollaborator(ctx, ref, "")
+	if err != nil {
+		return nil, err
+	}
+
+	e.output.Infof("👤 Assigned to %s", assignee.DisplayName())
+	assigned := make([]api.Item, 0, len(tasks))
+	for i := range tasks {
+		if tasks[i].ResponsibleUID == assignee.ID {
+			assigned = append(assigned, tasks[i])
+		}
+	}
+	return assigned, nil
+}
+
+// executeCompletedTaskListWithOutput は完了済みタスクの一覧表示を実行する（テスト可能）
+func (e *taskExecutor) executeCompletedTaskListWithOutput(ctx context.Context, params *taskListParams) error {
+	// 1. プロジェクト指定を解決
+	projectID := ""
+	if params.projectFilter != "" {
+		id, err := e.findProjectIDByName(ctx, params.projectFilter)
+		if err != nil {
+			return fmt.Errorf("failed to find project: %w", err)
+		}
+		projectID = id
+	}
+
+	// 2. 完了済みタスクを取得
+	tasks, err := e.repository.GetCompletedTasks(ctx, params.since, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get completed tasks: %w", err)
+	}
+
+	// 3. フィルタ式による絞り込み
+	if params.filterExpression != "" {
+		tasks = filterTasks(tasks, params.filterExpression)
+	}
+
+	// 4. 出力
+	if len(tasks) == 0 {
+		e.output.Infof("📭 No tasks completed since %s", params.since.Format("2006-01-02 15:04"))
+		return nil
+	}
+
+	projectsMap := e.buildProjectsMap(ctx, IsVerbose())
+	e.output.Listf("Found %d task(s) completed since %s:", len(tasks), params.since.Format("2006-01-02 15:04"))
+	e.output.Plainf("")
+	for i := range tasks {
+		e.displayCompletedTask(i+1, &tasks[i], projectsMap)
+	}
+
+	// 5. 一覧番号を保存（task uncomplete 3 などで参照するため）
+	e.saveTaskListIndex(ctx, tasks)
 
 	return nil
 }
 
+// displayCompletedTask は完了済みタスクを完了日時とプロジェクト付きで表示する
+func (e *taskExecutor) displayCompletedTask(index int, task *api.Item, projects map[string]string) {
+	completedAt := "unknown"
+	if task.DateCompleted != nil {
+		completedAt = task.DateCompleted.Local().Format("2006-01-02 15:04")
+	}
+
+	projectName, exists := projects[task.ProjectID]
+	if !exists {
+		projectName = task.ProjectID
+	}
+
+	e.output.Plainf("%d. ✅ %s  (%s, completed %s)", index, task.Content, projectName, completedAt)
+	if IsVerbose() {
+		e.output.Plainf("   ID: %s", task.ID)
+	}
+}
+
+// saveTaskListIndex は表示したタスクの一覧番号を保存する
+func (e *taskExecutor) saveTaskListIndex(ctx context.Context, tasks []api.Item) {
+	taskIDs := make([]string, 0, len(tasks))
+	for i := range tasks {
+		taskIDs = append(taskIDs, tasks[i].ID)
+	}
+	if err := e.repository.SaveTaskListIndex(ctx, taskIDs); err != nil {
+		e.output.Warningf("Failed to save task list index: %v", err)
+	}
+}
+
 // taskAddParams はタスク追加のパラメータ
 type taskAddParams struct {
 	content     string
@@ -178,6 +426,8 @@ type taskAddParams struct {
 	dueDate     string
 	description string
 	labels      string
+	assign      string
+	schedule    taskScheduleParams
 }
 
 // getTaskAddParams はタスク追加のパラメータを取得する
@@ -187,6 +437,8 @@ func getTaskAddParams(cmd *cobra.Command, args []string) *taskAddParams {
 	dueDate, _ := cmd.Flags().GetString("due")
 	description, _ := cmd.Flags().GetString("description")
 	labels, _ := cmd.Flags().GetString("labels")
+	assign, _ := cmd.Flags().GetString("assign")
+	schedule := getTaskScheduleParams(cmd)
 
 	return &taskAddParams{
 		content:     strings.Join(args, " "),
@@ -195,6 +447,8 @@ func getTaskAddParams(cmd *cobra.Command, args []string) *taskAddParams {
 		dueDate:     dueDate,
 		description: description,
 		labels:      labels,
+		assign:      assign,
+		schedule:    schedule,
 	}
 }
 
@@ -230,18 +484,21 @@ func (e *taskExecutor) executeTaskAddWithOutput(ctx context.Context, params *tas
 
 // taskCompleteParams はタスク完了のパラメータ
 type taskCompleteParams struct {
-	taskID string
+	taskID  string
+	forever bool // 繰り返しタスクも再スケジュールせずに完了にする
 }
 
-// getTaskCompleteParams はタスク完了のパラメータを取得する
-func getTaskCompleteParams(args []string) *taskCompleteParams {
-	return &taskCompleteParams{
-		taskID: args[0],
+// getTaskCompleteParams はタスク完了のパラメータを取得する（引数ごとに1つ）
+func getTaskCompleteParams(args []string) []*taskCompleteParams {
+	params := make([]*taskCompleteParams, 0, len(args))
+	for _, arg := range args {
+		params = append(params, &taskCompleteParams{taskID: arg})
 	}
+	return params
 }
 
 // runTaskComplete はタスク完了の実際の処理
-func runTaskComplete(_ *cobra.Command, args []string) error {
+func runTaskComplete(cmd *cobra.Command, args []string) error {
 	ctx := createBaseContext()
 
 	// セットアップ
@@ -251,25 +508,52 @@ func runTaskComplete(_ *cobra.Command, args []string) error {
 	}
 	defer executor.cleanup()
 
+	forever, _ := cmd.Flags().GetBool("forever")
+
+	// 複数タスク・フィルタ指定の場合は一括操作
+	if bulkParams := getTaskBulkParams(cmd, args, "yes"); bulkParams.isBulk() {
+		return executor.executeTaskBulkWithOutput(ctx, bulkParams, newCompleteBulkAction(forever))
+	}
+
 	// パラメータ取得と実行
-	params := getTaskCompleteParams(args)
+	params := getTaskCompleteParams(args)[0]
+	params.forever = forever
 	return executor.executeTaskCompleteWithOutput(ctx, params)
 }
 
 // executeTaskCompleteWithOutput はタスク完了と結果表示を実行する（テスト可能）
 func (e *taskExecutor) executeTaskCompleteWithOutput(ctx context.Context, params *taskCompleteParams) error {
 	// 1. タスク完了実行
-	resp, err := e.executeTaskComplete(ctx, params)
+	taskID, resp, err := e.executeTaskComplete(ctx, params)
 	if err != nil {
 		return fmt.Errorf("failed to complete task: %w", err)
 	}
 
 	// 2. 結果表示
 	e.displaySuccessMessage("Task completed successfully!", resp.SyncToken)
+	if !params.forever {
+		e.displayRescheduledTask(ctx, taskID)
+	}
 
 	return nil
 }
 
+// displayRescheduledTask は繰り返しタスクが再スケジュールされた場合に次回の期限を表示する
+func (e *taskExecutor) displayRescheduledTask(ctx context.Context, taskID string) {
+	tasks, err := e.repository.GetTasks(ctx)
+	if err != nil {
+		return
+	}
+	for i := range tasks {
+		task := &tasks[i]
+		if task.ID == taskID && task.DateCompleted == nil && task.Due != nil && task.Due.IsRecurring {
+			e.output.Infof("🔁 Recurring task rescheduled to %s (%s)", task.Due.Date, task.Due.String)
+			e.output.Infof("💡 Use --forever to complete it permanently")
+			return
+		}
+	}
+}
+
 // runTaskUncomplete はタスク未完了の実際の処理
 func runTaskUncomplete(_ *cobra.Command, args []string) error {
 	ctx := createBaseContext()
@@ -282,8 +566,12 @@ func runTaskUncomplete(_ *cobra.Command, args []string) error {
 	defer executor.cleanup()
 
 	// パラメータ取得と実行
-	params := getTaskCompleteParams(args) // 同じパラメータ構造を使用
-	return executor.executeTaskUncompleteWithOutput(ctx, params)
+	for _, params := range getTaskCompleteParams(args) { // 同じパラメータ構造を使用
+		if err := executor.executeTaskUncompleteWithOutput(ctx, params); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // executeTaskUncompleteWithOutput はタスク未完了と結果表示を実行する（テスト可能）
@@ -306,13 +594,17 @@ type taskDeleteParams struct {
 	force  bool
 }
 
-// getTaskDeleteParams はタスク削除のパラメータを取得する
-func getTaskDeleteParams(cmd *cobra.Command, args []string) *taskDeleteParams {
+// getTaskDeleteParams はタスク削除のパラメータを取得する（引数ごとに1つ）
+func getTaskDeleteParams(cmd *cobra.Command, args []string) []*taskDeleteParams {
 	force, _ := cmd.Flags().GetBool("force")
-	return &taskDeleteParams{
-		taskID: args[0],
-		force:  force,
-	}
+	params := make([]*taskDeleteParams, 0, len(args))
+	for _, arg := range args {
+		params = append(params, &taskDeleteParams{
+			taskID: arg,
+			force:  force,
+		})
+	}
+	return params
 }
 
 // runTaskDelete はタスク削除の実際の処理
@@ -326,8 +618,13 @@ func runTaskDelete(cmd *cobra.Command, args []string) error {
 	}
 	defer executor.cleanup()
 
+	// 複数タスク・フィルタ指定の場合は一括操作
+	if bulkParams := getTaskBulkParams(cmd, args, "force"); bulkParams.isBulk() {
+		return executor.executeTaskBulkWithOutput(ctx, bulkParams, newDeleteBulkAction())
+	}
+
 	// パラメータ取得と実行
-	params := getTaskDeleteParams(cmd, args)
+	params := getTaskDeleteParams(cmd, args)[0]
 	return executor.executeTaskDeleteWithOutput(ctx, params)
 }
 
@@ -362,23 +659,34 @@ type taskUpdateParams struct {
 	dueDate     string
 	description string
 	labels      string
+	assign      string
+	schedule    taskScheduleParams
 }
 
-// getTaskUpdateParams はタスク更新のパラメータを取得する
+// getTaskUpdateParams はタスク更新のパラメータを取得する（一括更新時のtaskIDは先頭の参照）
 func getTaskUpdateParams(cmd *cobra.Command, args []string) *taskUpdateParams {
 	content, _ := cmd.Flags().GetString("content")
 	priority, _ := cmd.Flags().GetString("priority")
 	dueDate, _ := cmd.Flags().GetString("due")
 	description, _ := cmd.Flags().GetString("description")
 	labels, _ := cmd.Flags().GetString("labels")
+	assign, _ := cmd.Flags().GetString("assign")
+	schedule := getTaskScheduleParams(cmd)
+
+	taskID := ""
+	if len(args) > 0 {
+		taskID = args[0]
+	}
 
 	return &taskUpdateParams{
-		taskID:      args[0],
+		taskID:      taskID,
 		content:     content,
 		priority:    priority,
 		dueDate:     dueDate,
 		description: description,
 		labels:      labels,
+		assign:      assign,
+		schedule:    schedule,
 	}
 }
 
@@ -393,8 +701,19 @@ func runTaskUpdate(cmd *cobra.Command, args []string) error {
 	}
 	defer executor.cleanup()
 
-	// パラメータ取得と実行
+	// パラメータ取得
 	params := getTaskUpdateParams(cmd, args)
+
+	// 複数タスク・フィルタ指定の場合は一括操作
+	if bulkParams := getTaskBulkParams(cmd, args, "yes"); bulkParams.isBulk() {
+		req, err := executor.buildUpdateTaskRequest(params)
+		if err != nil {
+			return err
+		}
+		return executor.executeTaskBulkWithOutput(ctx, bulkParams, executor.newUpdateBulkAction(ctx, req, params.assign))
+	}
+
+	// 実行
 	return executor.executeTaskUpdateWithOutput(ctx, params)
 }
 
@@ -477,7 +796,7 @@ func matchesFilter(task *api.Item, filter string) bool {
 }
 
 // displayTaskResults はタスク結果を表示する
-func (e *taskExecutor) displayTaskResults(projectsMap, sectionsMap map[string]string, tasks []api.Item) {
+func (e *taskExecutor) displayTaskResults(data *taskListData, tasks []api.Item) {
 	if len(tasks) == 0 {
 		e.output.Infof("📭 No tasks found")
 		return
@@ -487,27 +806,37 @@ func (e *taskExecutor) displayTaskResults(projectsMap, sectionsMap map[string]st
 	e.output.Listf("Found %d task(s):", len(tasks))
 	e.output.Plainf("")
 	for i := range tasks {
-		e.displayTask(&tasks[i], projectsMap, sectionsMap)
+		e.displayTask(i+1, &tasks[i], data)
 	}
 }
 
-// displayTask はタスクを表示する
-func (e *taskExecutor) displayTask(task *api.Item, projects map[string]string, sections map[string]string) {
+// displayTask はタスクを一覧番号付きで表示する
+func (e *taskExecutor) displayTask(index int, task *api.Item, data *taskListData) {
 	priorityIcon := getPriorityIcon(task.Priority)
 
 	// セクション名を取得
 	sectionName := ""
 	if task.SectionID != "" {
-		if name, exists := sections[task.SectionID]; exists {
+		if name, exists := data.sectionsMap[task.SectionID]; exists {
 			sectionName = fmt.Sprintf(" [%s]", name)
 		}
 	}
 
-	e.output.Plainf("%s %s%s", priorityIcon, task.Content, sectionName)
+	// 担当者名を取得（共同作業者が未同期の場合はUID）
+	assignee := ""
+	if task.ResponsibleUID != "" {
+		name, exists := data.assigneesMap[task.ResponsibleUID]
+		if !exists {
+			name = task.ResponsibleUID
+		}
+		assignee = fmt.Sprintf(" 👤 %s", name)
+	}
+
+	e.output.Plainf("%d. %s %s%s%s", index, priorityIcon, task.Content, sectionName, assignee)
 
 	if IsVerbose() {
 		e.output.Plainf("   ID: %s", task.ID)
-		projectName, exists := projects[task.ProjectID]
+		projectName, exists := data.projectsMap[task.ProjectID]
 		if exists {
 			e.output.Plainf("   Project: %s (%s)", projectName, task.ProjectID)
 		} else {
@@ -516,6 +845,12 @@ func (e *taskExecutor) displayTask(task *api.Item, projects map[string]string, s
 		if task.Due != nil {
 			e.output.Plainf("   Due: %s", task.Due.String)
 		}
+		if task.Duration != nil {
+			e.output.Plainf("   Duration: %s", task.Duration.String())
+		}
+		if task.Deadline != nil {
+			e.output.Plainf("   Deadline: %s", task.Deadline.Date)
+		}
 		if len(task.Labels) > 0 {
 			e.output.Plainf("   Labels: %s", strings.Join(task.Labels, ", "))
 		}
@@ -594,8 +929,9 @@ func setupTaskExecution(ctx context.Context) (*taskExecutor, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
+	cfg.DryRun = IsDryRun()
 
-	output := cli.New(IsVerbose())
+	output := newOutput(cfg.DryRun, os.Stdout, os.Stderr)
 
 	repo, err := factory.NewRepository(cfg, IsVerbose())
 	if err != nil {
@@ -660,6 +996,22 @@ func (e *taskExecutor) buildSectionsMap(ctx context.Context) map[string]string {
 	return sectionsMap
 }
 
+// buildAssigneesMap は担当者のUIDから表示名へのマップを構築する
+func (e *taskExecutor) buildAssigneesMap(ctx context.Context) map[string]string {
+	list, err := e.repository.GetCollaborators(ctx)
+	if err != nil {
+		// 担当者情報の取得に失敗してもタスク表示は続行
+		e.output.Warningf("Failed to load collaborator names: %v", err)
+		return make(map[string]string)
+	}
+
+	assigneesMap := make(map[string]string)
+	for i := range list.Collaborators {
+		assigneesMap[list.Collaborators[i].ID] = list.Collaborators[i].DisplayName()
+	}
+	return assigneesMap
+}
+
 // findProjectIDByName はプロジェクト検索を実行する（Repository層に移植済み）
 func (e *taskExecutor) findProjectIDByName(ctx context.Context, nameOrID string) (string, error) {
 	return e.repository.FindProjectIDByName(ctx, nameOrID)
@@ -697,10 +1049,20 @@ func (e *taskExecutor) fetchAllTaskListData(ctx context.Context, params *taskLis
 		}
 	}
 
+	// 担当者のいるタスクがある場合のみ共同作業者を取得
+	assigneesMap := make(map[string]string)
+	for i := range tasks {
+		if tasks[i].ResponsibleUID != "" {
+			assigneesMap = e.buildAssigneesMap(ctx)
+			break
+		}
+	}
+
 	return &taskListData{
-		tasks:       tasks,
-		projectsMap: projectsMap,
-		sectionsMap: sectionsMap,
+		tasks:        tasks,
+		projectsMap:  projectsMap,
+		sectionsMap:  sectionsMap,
+		assigneesMap: assigneesMap,
 	}, nil
 }
 
@@ -734,10 +1096,21 @@ func (e *taskExecutor) executeTaskAdd(ctx context.Context, params *taskAddParams
 		req.Priority = priority
 	}
 
+	if params.dueDate != "" && params.schedule.dueDatetime != "" {
+		return nil, fmt.Errorf("--due and --due-datetime cannot be used together")
+	}
 	if params.dueDate != "" {
 		req.DueString = params.dueDate
 	}
 
+	schedule, err := parseTaskSchedule(&params.schedule)
+	if err != nil {
+		return nil, err
+	}
+	req.DueDatetime = schedule.dueDatetime
+	req.Duration = schedule.duration
+	req.DeadlineDate = schedule.deadlineDate
+
 	if params.labels != "" {
 		labels := strings.Split(params.labels, ",")
 		for i, label := range labels {
@@ -746,24 +1119,103 @@ func (e *taskExecutor) executeTaskAdd(ctx context.Context, params *taskAddParams
 		req.Labels = labels
 	}
 
+	if params.assign != "" {
+		assignee, err := e.repository.FindCollaborator(ctx, params.assign, req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		req.AssigneeID = assignee.ID
+	}
+
 	// タスクを作成
 	return repo.CreateTask(ctx, req)
 }
 
-// executeTaskComplete はタスク完了を実行する
-func (e *taskExecutor) executeTaskComplete(ctx context.Context, params *taskCompleteParams) (*api.SyncResponse, error) {
+// executeTaskComplete はタスク完了を実行し、完了したタスクのIDを返す
+func (e *taskExecutor) executeTaskComplete(ctx context.Context, params *taskCompleteParams) (string, *api.SyncResponse, error) {
+	taskID, err := e.resolveTaskID(ctx, params.taskID)
+	if err != nil {
+		return "", nil, err
+	}
+
 	repo := e.repository
-	return repo.CloseTask(ctx, params.taskID)
+	if params.forever {
+		resp, err := repo.CompleteTaskForever(ctx, taskID)
+		return taskID, resp, err
+	}
+	resp, err := repo.CloseTask(ctx, taskID)
+	return taskID, resp, err
 }
 
 // executeTaskUncomplete はタスク未完了を実行する
 func (e *taskExecutor) executeTaskUncomplete(ctx context.Context, params *taskCompleteParams) (*api.SyncResponse, error) {
+	taskID, err := e.resolveTaskID(ctx, params.taskID)
+	if err != nil {
+		return nil, err
+	}
+
 	repo := e.repository
-	return repo.ReopenTask(ctx, params.taskID)
+	return repo.ReopenTask(ctx, taskID)
+}
+
+// errTaskSelectionCanceled はタスクの確認・選択がキャンセルされた場合のエラー
+var errTaskSelectionCanceled = errors.New("task selection canceled")
+
+// resolveTaskID はタスク参照（ID・IDプレフィックス・一覧番号・内容）からタスクIDを解決する
+// 内容検索が一意に確定しない場合は確認または番号選択を求める
+func (e *taskExecutor) resolveTaskID(ctx context.Context, ref string) (string, error) {
+	taskID, err := e.repository.ResolveTaskID(ctx, ref)
+	var matchErr *repository.TaskContentMatchError
+	if !errors.As(err, &matchErr) {
+		return taskID, err
+	}
+
+	if len(matchErr.Candidates) == 1 {
+		return e.confirmTaskCandidate(matchErr.Candidates[0])
+	}
+	return e.chooseTaskCandidate(matchErr)
+}
+
+// confirmTaskCandidate は部分一致したタスクで良いか確認する
+func (e *taskExecutor) confirmTaskCandidate(candidate repository.Candidate) (string, error) {
+	e.output.PlainNoNewlinef("Did you mean \"%s\" (%s)? (y/N): ", candidate.Label, candidate.ID)
+
+	confirmation, err := readPromptLine()
+	if err != nil || (confirmation != "y" && confirmation != "Y") {
+		return "", errTaskSelectionCanceled
+	}
+	return candidate.ID, nil
+}
+
+// chooseTaskCandidate は複数一致したタスクから番号で選択させる
+func (e *taskExecutor) chooseTaskCandidate(matchErr *repository.TaskContentMatchError) (string, error) {
+	e.output.Infof("Multiple tasks match %q:", matchErr.Query)
+	for i, candidate := range matchErr.Candidates {
+		e.output.Plainf("  %d. %s (%s)", i+1, candidate.Label, candidate.ID)
+	}
+	e.output.PlainNoNewlinef("Select a task [1-%d] (Enter to cancel): ", len(matchErr.Candidates))
+
+	choice, err := readPromptLine()
+	if err != nil || choice == "" {
+		return "", errTaskSelectionCanceled
+	}
+	index, err := strconv.Atoi(choice)
+	if err != nil || index < 1 || index > len(matchErr.Candidates) {
+		return "", fmt.Errorf("invalid selection: %s", choice)
+	}
+	return matchErr.Candidates[index-1].ID, nil
 }
 
-// findTaskByID はタスクIDからタスクを検索する
-func (e *taskExecutor) findTaskByID(ctx context.Context, taskID string) (*api.Item, error) {
+// findTaskByID はタスク参照からタスクを検索する
+func (e *taskExecutor) findTaskByID(ctx context.Context, ref string) (*api.Item, error) {
+	taskID, err := e.resolveTaskID(ctx, ref)
+	if err != nil {
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			return nil, nil // タスクが見つからない場合
+		}
+		return nil, err
+	}
+
 	repo := e.repository
 	tasks, err := repo.GetTasks(ctx)
 	if err != nil {
@@ -819,17 +1271,50 @@ func (e *taskExecutor) executeTaskUpdate(ctx context.Context, params *taskUpdate
 		return nil, err
 	}
 
+	// タスクIDを解決
+	taskID, err := e.resolveTaskID(ctx, params.taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 担当者はタスクのプロジェクトの共同作業者から解決する
+	if params.assign != "" {
+		task, err := e.findTaskByID(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if task == nil {
+			return nil, fmt.Errorf("%w: %s", repository.ErrTaskNotFound, params.taskID)
+		}
+		if req.AssigneeID, err = e.findTaskAssignee(ctx, params.assign, task); err != nil {
+			return nil, err
+		}
+	}
+
 	// タスクを更新
 	repo := e.repository
-	return repo.UpdateTask(ctx, params.taskID, req)
+	return repo.UpdateTask(ctx, taskID, req)
+}
+
+// findTaskAssignee はタスクのプロジェクトに参加している共同作業者から担当者を解決する
+func (e *taskExecutor) findTaskAssignee(ctx context.Context, ref string, task *api.Item) (string, error) {
+	assignee, err := e.repository.FindCollaborator(ctx, ref, task.ProjectID)
+	if err != nil {
+		return "", err
+	}
+	return assignee.ID, nil
 }
 
 // buildUpdateTaskRequest はタスク更新リクエストを構築する
+// 担当者はタスクごとにプロジェクトが異なるため、ここでは解決しない（findTaskAssigneeで解決する）
 func (e *taskExecutor) buildUpdateTaskRequest(params *taskUpdateParams) (*api.UpdateTaskRequest, error) {
 	// 何も更新内容がない場合はエラー
 	if params.content == "" && params.priority == "" && params.dueDate == "" &&
-		params.description == "" && params.labels == "" {
-		return nil, fmt.Errorf("at least one update field must be specified (--content, --priority, --due, --description, --labels)")
+		params.description == "" && params.labels == "" && params.assign == "" && params.schedule.isEmpty() {
+		return nil, fmt.Errorf("at least one update field must be specified (--content, --priority, --due, --due-datetime, --duration, --deadline, --description, --labels, --assign)")
+	}
+	if params.dueDate != "" && params.schedule.dueDatetime != "" {
+		return nil, fmt.Errorf("--due and --due-datetime cannot be used together")
 	}
 
 	// リクエストを構築
@@ -858,6 +1343,14 @@ func (e *taskExecutor) buildUpdateTaskRequest(params *taskUpdateParams) (*api.Up
 		req.DueString = params.dueDate
 	}
 
+	schedule, err := parseTaskSchedule(&params.schedule)
+	if err != nil {
+		return nil, err
+	}
+	req.DueDatetime = schedule.dueDatetime
+	req.Duration = schedule.duration
+	req.DeadlineDate = schedule.deadlineDate
+
 	if params.labels != "" {
 		labels := strings.Split(params.labels, ",")
 		for i, label := range labels {
@@ -882,8 +1375,7 @@ func (e *taskExecutor) promptTaskDeletionConfirmation(task *api.Item) bool {
 	}
 	e.output.PlainNoNewlinef("Enter your choice: ")
 
-	var confirmation string
-	_, err := fmt.Scanln(&confirmation)
+	confirmation, err := readPromptLine()
 	if err != nil {
 		e.output.Errorf("Task deletion canceled")
 		return false
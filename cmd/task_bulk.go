@@ -0,0 +1,412 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	taskCmd.AddCommand(taskMoveCmd)
+
+	// 一括操作用のフラグ
+	for _, cmd := range []*cobra.Command{taskCompleteCmd, taskDeleteCmd, taskUpdateCmd, taskMoveCmd} {
+		cmd.Flags().String("filter", "", "operate on all active tasks matching the filter expression")
+	}
+	for _, cmd := range []*cobra.Command{taskCompleteCmd, taskUpdateCmd, taskMoveCmd} {
+		cmd.Flags().BoolP("yes", "y", false, "skip confirmation prompt for bulk operations")
+	}
+
+	// task move用のフラグ
+	taskMoveCmd.Flags().StringP("project", "p", "", "destination project name, path or ID")
+	taskMoveCmd.Flags().StringP("section", "s", "", "destination section name or ID")
+	taskMoveCmd.Flags().String("parent", "", "destination parent task")
+}
+
+// taskMoveCmd はタスク移動コマンド
+var taskMoveCmd = &cobra.Command{
+	Use:   "move [task ID...]",
+	Short: "Move tasks to another project, section or parent",
+	Long: `Move one or more tasks to another project, section or parent task.
+
+Tasks can be specified by ID, a unique ID prefix, their numbers in the last 'task list' output,
+their content, or all at once with --filter:
+
+  gotodoist task move 3 5 --project "Work/Backend"
+  gotodoist task move --filter "@sprint-12" --project Archive --section Done`,
+	Args: taskRefsOrFilterArgs,
+	RunE: runTaskMove,
+}
+
+// taskRefsOrFilterArgs はタスク参照か--filterのどちらか一方が指定されていることを検証する
+func taskRefsOrFilterArgs(cmd *cobra.Command, args []string) error {
+	filter, _ := cmd.Flags().GetString("filter")
+	if len(args) == 0 && filter == "" {
+		return fmt.Errorf("requires at least one task ID or --filter")
+	}
+	if len(args) > 0 && filter != "" {
+		return fmt.Errorf("specify either task IDs or --filter, not both")
+	}
+	return nil
+}
+
+// taskBulkParams はタスク一括操作のパラメータ
+type taskBulkParams struct {
+	refs             []string
+	filterExpression string
+	skipConfirm      bool
+}
+
+// taskBulkAction はタスク一括操作の内容
+type taskBulkAction struct {
+	pastTense    string // 表示用（例: "completed"）
+	buildCommand func(task *api.Item) (api.Command, error)
+}
+
+// taskBulkResult はタスクごとの一括操作結果
+type taskBulkResult struct {
+	task *api.Item
+	err  error
+}
+
+// getTaskBulkParams は一括操作のパラメータを取得する
+// confirmFlag は確認をスキップするフラグ名（deleteは"force"、それ以外は"yes"）
+func getTaskBulkParams(cmd *cobra.Command, args []string, confirmFlag string) *taskBulkParams {
+	filterExpression, _ := cmd.Flags().GetString("filter")
+	skipConfirm, _ := cmd.Flags().GetBool(confirmFlag)
+	return &taskBulkParams{
+		refs:             args,
+		filterExpression: filterExpression,
+		skipConfirm:      skipConfirm,
+	}
+}
+
+// isBulk は一括操作として扱う（プレビューと確認を行う）かどうかを返す
+func (p *taskBulkParams) isBulk() bool {
+	return p.filterExpression != "" || len(p.refs) > 1
+}
+
+// newCompleteBulkAction はタスク完了の一括操作を作成する
+// foreverの場合は繰り返しタスクも再スケジュールせずに完了にする
+func newCompleteBulkAction(forever bool) *taskBulkAction {
+	return &taskBulkAction{
+		pastTense: "completed",
+		buildCommand: func(task *api.Item) (api.Command, error) {
+			if forever {
+				return api.NewCompleteTaskCommand(task.ID), nil
+			}
+			return api.NewCloseTaskCommand(task.ID), nil
+		},
+	}
+}
+
+// newDeleteBulkAction はタスク削除の一括操作を作成する
+func newDeleteBulkAction() *taskBulkAction {
+	return &taskBulkAction{
+		pastTense: "deleted",
+		buildCommand: func(task *api.Item) (api.Command, error) {
+			return api.NewDeleteTaskCommand(task.ID), nil
+		},
+	}
+}
+
+// newUpdateBulkAction はタスク更新の一括操作を作成する
+// 担当者（assign）はタスクごとに、そのプロジェクトの共同作業者から解決する
+func (e *taskExecutor) newUpdateBulkAction(ctx context.Context, req *api.UpdateTaskRequest, assign string) *taskBulkAction {
+	return &taskBulkAction{
+		pastTense: "updated",
+		buildCommand: func(task *api.Item) (api.Command, error) {
+			if assign == "" {
+				return api.NewUpdateTaskCommand(task.ID, req)
+			}
+			assigneeID, err := e.findTaskAssignee(ctx, assign, task)
+			if err != nil {
+				return api.Command{}, err
+			}
+			taskReq := *req
+			taskReq.AssigneeID = assigneeID
+			return api.NewUpdateTaskCommand(task.ID, &taskReq)
+		},
+	}
+}
+
+// newMoveBulkAction はタスク移動の一括操作を作成する
+func newMoveBulkAction(req *api.MoveTaskRequest) *taskBulkAction {
+	return &taskBulkAction{
+		pastTense: "moved",
+		buildCommand: func(task *api.Item) (api.Command, error) {
+			if task.ID == req.ParentID {
+				return api.Command{}, fmt.Errorf("cannot move a task under itself")
+			}
+			return api.NewMoveTaskCommand(task.ID, req)
+		},
+	}
+}
+
+// executeTaskBulkWithOutput はタスク一括操作と結果表示を実行する（テスト可能）
+func (e *taskExecutor) executeTaskBulkWithOutput(ctx context.Context, params *taskBulkParams, action *taskBulkAction) error {
+	// 1. 対象タスクを収集
+	targets, err := e.collectBulkTargets(ctx, params)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		e.output.Infof("📭 No tasks matched")
+		return nil
+	}
+
+	// 2. プレビューと確認（一括操作の場合のみ）
+	if params.isBulk() {
+		e.displayBulkPreview(targets, action)
+		if !params.skipConfirm && !e.promptBulkConfirmation() {
+			e.output.Errorf("Operation canceled")
+			return nil
+		}
+	}
+
+	// 3. コマンドを構築してまとめて実行
+	results := e.executeBulkCommands(ctx, targets, action)
+
+	// 4. 結果表示
+	return e.displayBulkResults(results, action)
+}
+
+// collectBulkTargets はタスク参照または--filterから対象タスクを収集する
+func (e *taskExecutor) collectBulkTargets(ctx context.Context, params *taskBulkParams) ([]api.Item, error) {
+	tasks, err := e.repository.GetTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	// フィルタ式の場合はアクティブなタスクから絞り込む
+	if params.filterExpression != "" {
+		return filterTasks(filterActiveTasks(tasks, false), params.filterExpression), nil
+	}
+
+	tasksByID := make(map[string]*api.Item, len(tasks))
+	for i := range tasks {
+		tasksByID[tasks[i].ID] = &tasks[i]
+	}
+
+	// 参照を解決（重複は除外）
+	targets := make([]api.Item, 0, len(params.refs))
+	seen := make(map[string]bool, len(params.refs))
+	for _, ref := range params.refs {
+		taskID, err := e.resolveTaskID(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve task %q: %w", ref, err)
+		}
+		task, exists := tasksByID[taskID]
+		if !exists {
+			return nil, fmt.Errorf("%w: %s", repository.ErrTaskNotFound, ref)
+		}
+		if seen[taskID] {
+			continue
+		}
+		seen[taskID] = true
+		targets = append(targets, *task)
+	}
+
+	return targets, nil
+}
+
+// executeBulkCommands は対象タスクのコマンドを構築し、まとめて送信する
+func (e *taskExecutor) executeBulkCommands(ctx context.Context, targets []api.Item, action *taskBulkAction) []taskBulkResult {
+	results := make([]taskBulkResult, len(targets))
+	commands := make([]api.Command, 0, len(targets))
+	commandIndex := make(map[string]int, len(targets))
+
+	for i := range targets {
+		results[i].task = &targets[i]
+		cmd, err := action.buildCommand(&targets[i])
+		if err != nil {
+			results[i].err = err
+			continue
+		}
+		commands = append(commands, cmd)
+		commandIndex[cmd.UUID] = i
+	}
+	if len(commands) == 0 {
+		return results
+	}
+
+	resp, err := e.repository.ExecuteTaskCommands(ctx, commands)
+	for _, cmd := range commands {
+		i := commandIndex[cmd.UUID]
+		if resp == nil {
+			results[i].err = err
+			continue
+		}
+		if _, exists := resp.SyncStatus[cmd.UUID]; !exists && err != nil {
+			// リクエストの失敗により結果が得られなかったコマンド
+			results[i].err = fmt.Errorf("not sent: %w", err)
+			continue
+		}
+		results[i].err = resp.CommandError(cmd.UUID)
+	}
+
+	return results
+}
+
+// displayBulkPreview は一括操作の対象タスクを表示する
+func (e *taskExecutor) displayBulkPreview(targets []api.Item, action *taskBulkAction) {
+	e.output.Listf("%d task(s) will be %s:", len(targets), action.pastTense)
+	for i := range targets {
+		e.output.Plainf("  %d. %s %s (%s)", i+1, getPriorityIcon(targets[i].Priority), targets[i].Content, targets[i].ID)
+	}
+}
+
+// promptBulkConfirmation は一括操作の確認プロンプトを表示する
+func (e *taskExecutor) promptBulkConfirmation() bool {
+	e.output.PlainNoNewlinef("Proceed? (y/N): ")
+
+	confirmation, err := readPromptLine()
+	if err != nil {
+		return false
+	}
+	return confirmation == "y" || confirmation == "Y"
+}
+
+// displayBulkResults はタスクごとの結果と集計を表示する
+func (e *taskExecutor) displayBulkResults(results []taskBulkResult, action *taskBulkAction) error {
+	failed := 0
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+			e.output.Errorf("%s (%s): %v", result.task.Content, result.task.ID, result.err)
+			continue
+		}
+		e.output.Successf("%s (%s): %s", result.task.Content, result.task.ID, action.pastTense)
+	}
+
+	e.output.Infof("%d task(s) %s, %d failed", len(results)-failed, action.pastTense, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d task(s) could not be %s", failed, len(results), action.pastTense)
+	}
+	return nil
+}
+
+// taskMoveParams はタスク移動のパラメータ
+type taskMoveParams struct {
+	bulk    *taskBulkParams
+	project string
+	section string
+	parent  string
+}
+
+// getTaskMoveParams はタスク移動のパラメータを取得する
+func getTaskMoveParams(cmd *cobra.Command, args []string) *taskMoveParams {
+	project, _ := cmd.Flags().GetString("project")
+	section, _ := cmd.Flags().GetString("section")
+	parent, _ := cmd.Flags().GetString("parent")
+	return &taskMoveParams{
+		bulk:    getTaskBulkParams(cmd, args, "yes"),
+		project: project,
+		section: section,
+		parent:  parent,
+	}
+}
+
+// runTaskMove はタスク移動の実際の処理
+func runTaskMove(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getTaskMoveParams(cmd, args)
+	return executor.executeTaskMoveWithOutput(ctx, params)
+}
+
+// executeTaskMoveWithOutput はタスク移動と結果表示を実行する（テスト可能）
+func (e *taskExecutor) executeTaskMoveWithOutput(ctx context.Context, params *taskMoveParams) error {
+	// 1. 移動先を解決
+	req, err := e.resolveMoveDestination(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// 2. 一括実行
+	return e.executeTaskBulkWithOutput(ctx, params.bulk, newMoveBulkAction(req))
+}
+
+// resolveMoveDestination は移動先（プロジェクト・セクション・親タスク）を解決する
+func (e *taskExecutor) resolveMoveDestination(ctx context.Context, params *taskMoveParams) (*api.MoveTaskRequest, error) {
+	switch {
+	case params.parent != "":
+		if params.project != "" || params.section != "" {
+			return nil, fmt.Errorf("--parent cannot be combined with --project or --section")
+		}
+		parentID, err := e.resolveTaskID(ctx, params.parent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find parent task: %w", err)
+		}
+		return &api.MoveTaskRequest{ParentID: parentID}, nil
+
+	case params.section != "":
+		projectID := ""
+		if params.project != "" {
+			var err error
+			projectID, err = e.findProjectIDByName(ctx, params.project)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find project: %w", err)
+			}
+		}
+		sectionID, err := e.findSectionID(ctx, projectID, params.section)
+		if err != nil {
+			return nil, err
+		}
+		return &api.MoveTaskRequest{SectionID: sectionID}, nil
+
+	case params.project != "":
+		projectID, err := e.findProjectIDByName(ctx, params.project)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find project: %w", err)
+		}
+		return &api.MoveTaskRequest{ProjectID: projectID}, nil
+
+	default:
+		return nil, fmt.Errorf("destination is required (--project, --section or --parent)")
+	}
+}
+
+// findSectionID はセクション名またはIDからセクションIDを検索する
+// projectIDが指定された場合はそのプロジェクト内のセクションに限定する
+func (e *taskExecutor) findSectionID(ctx context.Context, projectID, nameOrID string) (string, error) {
+	sections, err := e.repository.GetAllSections(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get sections: %w", err)
+	}
+
+	var matches []repository.Candidate
+	for _, section := range sections {
+		if projectID != "" && section.ProjectID != projectID {
+			continue
+		}
+		if section.ID == nameOrID {
+			return section.ID, nil
+		}
+		if strings.EqualFold(section.Name, nameOrID) {
+			matches = append(matches, repository.Candidate{ID: section.ID, Label: section.Name})
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("section not found: %s", nameOrID)
+	case 1:
+		return matches[0].ID, nil
+	default:
+		return "", &repository.AmbiguousReferenceError{Kind: "section", Reference: nameOrID, Candidates: matches}
+	}
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBulkTest は一括操作テスト用のデータを投入したexecutorを準備する
+func setupBulkTest(t *testing.T) *testTaskExecutorSetup {
+	t.Helper()
+
+	setup := setupTestTaskExecutor(t)
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-work", Name: "Work"},
+		{ID: "project-archive", Name: "Archive"},
+	})
+	insertTestSectionsIntoDB(t, setup.dbPath, []api.Section{
+		{ID: "section-done", Name: "Done", ProjectID: "project-archive"},
+	})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-a", Content: "Write report", ProjectID: "project-work", Labels: []string{"sprint"}, ChildOrder: 1},
+		{ID: "task-b", Content: "Review PR", ProjectID: "project-work", Labels: []string{"sprint"}, ChildOrder: 2},
+		{ID: "task-c", Content: "Plan next sprint", ProjectID: "project-work", ChildOrder: 3},
+	})
+	return setup
+}
+
+func TestExecuteTaskBulkWithOutput_CompleteByFilter(t *testing.T) {
+	setup := setupBulkTest(t)
+	defer setup.cleanup()
+
+	var calls [][]api.Command
+	setup.mockClient.ExecuteCommandsFunc = func(_ context.Context, commands []api.Command) (*api.SyncResponse, error) {
+		calls = append(calls, commands)
+		status := make(map[string]interface{}, len(commands))
+		for _, cmd := range commands {
+			status[cmd.UUID] = "ok"
+		}
+		return &api.SyncResponse{SyncToken: "bulk-token", SyncStatus: status}, nil
+	}
+
+	params := &taskBulkParams{filterExpression: "@sprint", skipConfirm: true}
+
+	// Act: テスト対象を実行
+	err := setup.executor.executeTaskBulkWithOutput(context.Background(), params, newCompleteBulkAction(false))
+
+	// Assert: 結果を検証
+	require.NoError(t, err)
+	require.Len(t, calls, 1, "コマンドが1回のリクエストにまとめられていません")
+	require.Len(t, calls[0], 2)
+	for _, cmd := range calls[0] {
+		assert.Equal(t, api.CommandItemClose, cmd.Type)
+	}
+	assert.Equal(t, "task-a", calls[0][0].Args["id"])
+	assert.Equal(t, "task-b", calls[0][1].Args["id"])
+
+	outputStr := setup.stdout.String()
+	assert.Contains(t, outputStr, "2 task(s) will be completed:", "プレビューが表示されていません")
+	assert.Contains(t, outputStr, "2 task(s) completed, 0 failed", "集計が表示されていません")
+}
+
+func TestExecuteTaskBulkWithOutput_PartialFailure(t *testing.T) {
+	setup := setupBulkTest(t)
+	defer setup.cleanup()
+
+	setup.mockClient.ExecuteCommandsFunc = func(_ context.Context, commands []api.Command) (*api.SyncResponse, error) {
+		status := map[string]interface{}{
+			commands[0].UUID: "ok",
+			commands[1].UUID: map[string]interface{}{"error_code": float64(22), "error": "Item not found"},
+		}
+		return &api.SyncResponse{SyncToken: "bulk-token", SyncStatus: status}, nil
+	}
+
+	params := &taskBulkParams{refs: []string{"task-a", "task-b"}, skipConfirm: true}
+
+	// Act: テスト対象を実行
+	err := setup.executor.executeTaskBulkWithOutput(context.Background(), params, newDeleteBulkAction())
+
+	// Assert: 結果を検証
+	require.Error(t, err, "失敗したタスクがある場合はエラーを返すべきです")
+	assert.Contains(t, setup.stdout.String(), "Write report (task-a): deleted")
+	assert.Contains(t, setup.stderr.String(), "Review PR (task-b): command failed (code 22): Item not found")
+	assert.Contains(t, setup.stdout.String(), "1 task(s) deleted, 1 failed")
+}
+
+func TestExecuteTaskBulkWithOutput_Canceled(t *testing.T) {
+	setup := setupBulkTest(t)
+	defer setup.cleanup()
+
+	originalReader := promptReader
+	promptReader = bufio.NewReader(strings.NewReader("n\n"))
+	defer func() { promptReader = originalReader }()
+
+	called := false
+	setup.mockClient.ExecuteCommandsFunc = func(_ context.Context, _ []api.Command) (*api.SyncResponse, error) {
+		called = true
+		return &api.SyncResponse{}, nil
+	}
+
+	params := &taskBulkParams{filterExpression: "@sprint"}
+
+	// Act: テスト対象を実行
+	err := setup.executor.executeTaskBulkWithOutput(context.Background(), params, newCompleteBulkAction(false))
+
+	// Assert: 結果を検証
+	require.NoError(t, err)
+	assert.False(t, called, "キャンセル後にAPIが呼ばれています")
+	assert.Contains(t, setup.stderr.String(), "Operation canceled")
+}
+
+func TestExecuteTaskMoveWithOutput(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       *taskMoveParams
+		expectedArgs map[string]interface{}
+		expectErr    bool
+	}{
+		{
+			name: "プロジェクトへ移動",
+			params: &taskMoveParams{
+				bulk:    &taskBulkParams{refs: []string{"task-a"}},
+				project: "Archive",
+			},
+			expectedArgs: map[string]interface{}{"id": "task-a", "project_id": "project-archive"},
+		},
+		{
+			name: "プロジェクト内のセクションへ移動",
+			params: &taskMoveParams{
+				bulk:    &taskBulkParams{refs: []string{"task-a"}},
+				project: "Archive",
+				section: "done",
+			},
+			expectedArgs: map[string]interface{}{"id": "task-a", "section_id": "section-done"},
+		},
+		{
+			name: "親タスクの下へ移動",
+			params: &taskMoveParams{
+				bulk:   &taskBulkParams{refs: []string{"task-b"}},
+				parent: "task-a",
+			},
+			expectedArgs: map[string]interface{}{"id": "task-b", "parent_id": "task-a"},
+		},
+		{
+			name: "移動先の指定なし",
+			params: &taskMoveParams{
+				bulk: &taskBulkParams{refs: []string{"task-a"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup := setupBulkTest(t)
+			defer setup.cleanup()
+
+			var sent []api.Command
+			setup.mockClient.ExecuteCommandsFunc = func(_ context.Context, commands []api.Command) (*api.SyncResponse, error) {
+				sent = append(sent, commands...)
+				status := make(map[string]interface{}, len(commands))
+				for _, cmd := range commands {
+					status[cmd.UUID] = "ok"
+				}
+				return &api.SyncResponse{SyncStatus: status}, nil
+			}
+
+			// Act: テスト対象を実行
+			err := setup.executor.executeTaskMoveWithOutput(context.Background(), tt.params)
+
+			// Assert: 結果を検証
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Empty(t, sent)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, sent, 1)
+			assert.Equal(t, api.CommandItemMove, sent[0].Type)
+			assert.Equal(t, tt.expectedArgs, sent[0].Args)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	taskCmd.AddCommand(taskCopyCmd)
+
+	// task copy用のフラグ
+	taskCopyCmd.Flags().StringP("project", "p", "", "destination project name, path or ID")
+	taskCopyCmd.Flags().StringP("section", "s", "", "destination section name or ID")
+	taskCopyCmd.Flags().String("shift", "", "shift due dates and deadlines (e.g. 7d, -3d, 2w)")
+	taskCopyCmd.Flags().String("start", "", "shift due dates so that the earliest one falls on this date (YYYY-MM-DD)")
+}
+
+// taskCopyCmd はタスクコピーコマンド
+var taskCopyCmd = &cobra.Command{
+	Use:   "copy [task ID]",
+	Short: "Copy a task with its subtasks to another project",
+	Long: `Re-create a task and all of its open subtasks in another project or section.
+
+Order, labels, descriptions, priorities, durations, due dates and deadlines are kept.
+Recurring due dates are kept as they are; other due dates and deadlines can be
+shifted with --shift, or anchored with --start.
+
+The task can be specified by its full ID, any unique ID prefix, its
+number in the last 'task list' output, or its content.`,
+	Example: `  gotodoist task copy "QA checklist" --project "Feature X"
+  gotodoist task copy 3 --project "Feature X" --section Review --shift 1w`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTaskCopy,
+}
+
+// taskCopyParams はタスクコピーのパラメータ
+type taskCopyParams struct {
+	taskRef string
+	project string
+	section string
+	shift   string
+	start   string
+}
+
+// getTaskCopyParams はタスクコピーのパラメータを取得する
+func getTaskCopyParams(cmd *cobra.Command, args []string) *taskCopyParams {
+	project, _ := cmd.Flags().GetString("project")
+	section, _ := cmd.Flags().GetString("section")
+	shift, _ := cmd.Flags().GetString("shift")
+	start, _ := cmd.Flags().GetString("start")
+	return &taskCopyParams{
+		taskRef: args[0],
+		project: project,
+		section: section,
+		shift:   shift,
+		start:   start,
+	}
+}
+
+// runTaskCopy はタスクコピーの実際の処理
+func runTaskCopy(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getTaskCopyParams(cmd, args)
+	return executor.executeTaskCopyWithOutput(ctx, params)
+}
+
+// executeTaskCopyWithOutput はタスクコピーと結果表示を実行する（テスト可能）
+func (e *taskExecutor) executeTaskCopyWithOutput(ctx context.Context, params *taskCopyParams) error {
+	if params.project == "" {
+		return fmt.Errorf("destination project is required (--project)")
+	}
+	shift, err := parseDueShift(params.shift, params.start)
+	if err != nil {
+		return err
+	}
+
+	// 1. コピーするタスクとコピー先を解決
+	taskID, err := e.resolveTaskID(ctx, params.taskRef)
+	if err != nil {
+		return err
+	}
+	opts := repository.CopyTaskOptions{DueShift: shift}
+	opts.ProjectID, err = e.findProjectIDByName(ctx, params.project)
+	if err != nil {
+		return fmt.Errorf("failed to find project: %w", err)
+	}
+	if params.section != "" {
+		opts.SectionID, err = e.findSectionID(ctx, opts.ProjectID, params.section)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 2. コピー実行
+	result, err := e.repository.CopyTask(ctx, taskID, opts)
+	if err != nil {
+		if result != nil && result.TaskID != "" {
+			e.output.Warningf("The task was copied but some subtasks could not be created (run 'gotodoist undo' to remove the copy)")
+		}
+		return fmt.Errorf("failed to copy task: %w", err)
+	}
+
+	// 3. 結果表示
+	e.output.Successf("📋 Copied %d task(s) to %q", result.Tasks, params.project)
+	e.output.Plainf("   ID: %s", result.TaskID)
+	if IsVerbose() && result.Response.SyncToken != "" {
+		e.output.Plainf("   Sync token: %s", result.Response.SyncToken)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// setupTaskCopyTest はコピー元のタスクツリーを登録し、送信したコマンドを記録する
+func setupTaskCopyTest(t *testing.T) (*testTaskExecutorSetup, *[]api.Command) {
+	t.Helper()
+
+	setup := setupTestTaskExecutor(t)
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-templates", Name: "Templates"},
+		{ID: "project-feature", Name: "Feature X"},
+	})
+	insertTestSectionsIntoDB(t, setup.dbPath, []api.Section{
+		{ID: "section-review", Name: "Review", ProjectID: "project-feature"},
+	})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-qa", Content: "QA checklist", Description: "Run before release", ProjectID: "project-templates", ChildOrder: 5, Labels: []string{"qa"}, Due: &api.Due{Date: "2026-11-10"}},
+		{ID: "task-smoke", Content: "Smoke test", ProjectID: "project-templates", ParentID: "task-qa", ChildOrder: 2, Due: &api.Due{Date: "2026-11-11"}},
+		{ID: "task-unit", Content: "Unit tests", ProjectID: "project-templates", ParentID: "task-qa", ChildOrder: 1, Priority: 3},
+		{ID: "task-mobile", Content: "Mobile smoke", ProjectID: "project-templates", ParentID: "task-smoke", ChildOrder: 1, Labels: []string{"mobile"}},
+		{ID: "task-done", Content: "Old check", ProjectID: "project-templates", ParentID: "task-qa", DateCompleted: &api.TodoistTime{Time: time.Now()}},
+		{ID: "task-other", Content: "Unrelated", ProjectID: "project-templates"},
+	})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	var sent []api.Command
+	setup.mockClient.ExecuteCommandsFunc = func(_ context.Context, commands []api.Command) (*api.SyncResponse, error) {
+		sent = append(sent, commands...)
+		resp := &api.SyncResponse{
+			SyncToken:     "command-token",
+			SyncStatus:    make(map[string]interface{}),
+			TempIDMapping: make(map[string]string),
+		}
+		for i, cmd := range commands {
+			resp.SyncStatus[cmd.UUID] = "ok"
+			resp.TempIDMapping[cmd.TempID] = fmt.Sprintf("new-%d", i)
+		}
+		return resp, nil
+	}
+	return setup, &sent
+}
+
+func TestExecuteTaskCopyWithOutput(t *testing.T) {
+	setup, sent := setupTaskCopyTest(t)
+	defer setup.cleanup()
+
+	// Act
+	err := setup.executor.executeTaskCopyWithOutput(context.Background(), &taskCopyParams{
+		taskRef: "task-qa",
+		project: "Feature X",
+		section: "Review",
+	})
+
+	// Assert: 未完了のサブタスクを含むツリーが親から順にまとめて送信される
+	require.NoError(t, err)
+	commands := *sent
+	require.Len(t, commands, 4)
+	tasks := commandsByContent(commands)
+	require.Len(t, tasks, 4)
+	assert.NotContains(t, tasks, "Old check", "完了済みのサブタスクはコピーしない")
+
+	root := commands[0]
+	assert.Equal(t, "QA checklist", root.Args["content"])
+	assert.Equal(t, "project-feature", root.Args["project_id"])
+	assert.Equal(t, "section-review", root.Args["section_id"])
+	assert.Equal(t, "Run before release", root.Args["description"])
+	assert.Equal(t, []string{"qa"}, root.Args["labels"])
+	assert.NotContains(t, root.Args, "parent_id")
+	assert.NotContains(t, root.Args, "child_order", "コピーしたタスクはコピー先の末尾に追加する")
+
+	// サブタスクは親のtemp_idを参照し、元の並び順を保つ
+	smoke, unit, mobile := tasks["Smoke test"], tasks["Unit tests"], tasks["Mobile smoke"]
+	assert.Equal(t, root.TempID, smoke.Args["parent_id"])
+	assert.Equal(t, 2, smoke.Args["child_order"])
+	assert.Equal(t, root.TempID, unit.Args["parent_id"])
+	assert.Equal(t, 1, unit.Args["child_order"])
+	assert.Equal(t, 3, unit.Args["priority"])
+	assert.Equal(t, smoke.TempID, mobile.Args["parent_id"])
+	assert.Equal(t, []string{"mobile"}, mobile.Args["labels"])
+
+	// 期限はずらさない
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-10"}, root.Args["due"])
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, `Copied 4 task(s) to "Feature X"`)
+	assert.Contains(t, output, "ID: new-0")
+}
+
+func TestExecuteTaskCopyWithOutput_Shift(t *testing.T) {
+	setup, sent := setupTaskCopyTest(t)
+	defer setup.cleanup()
+
+	err := setup.executor.executeTaskCopyWithOutput(context.Background(), &taskCopyParams{
+		taskRef: "QA checklist",
+		project: "Feature X",
+		shift:   "-1w",
+	})
+
+	require.NoError(t, err)
+	tasks := commandsByContent(*sent)
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-03"}, tasks["QA checklist"].Args["due"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-04"}, tasks["Smoke test"].Args["due"])
+	assert.NotContains(t, tasks["QA checklist"].Args, "section_id")
+}
+
+func TestExecuteTaskCopyWithOutput_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      *taskCopyParams
+		expectError string
+	}{
+		{name: "コピー先の指定なし", params: &taskCopyParams{taskRef: "task-qa"}, expectError: "destination project is required"},
+		{name: "存在しないセクション", params: &taskCopyParams{taskRef: "task-qa", project: "Templates", section: "Review"}, expectError: "section not found"},
+		{name: "不正な開始日", params: &taskCopyParams{taskRef: "task-qa", project: "Feature X", start: "next week"}, expectError: "invalid --start value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup, sent := setupTaskCopyTest(t)
+			defer setup.cleanup()
+
+			err := setup.executor.executeTaskCopyWithOutput(context.Background(), tt.params)
+
+			assert.ErrorContains(t, err, tt.expectError)
+			assert.Empty(t, *sent, "APIが呼ばれてはいけません")
+		})
+	}
+}
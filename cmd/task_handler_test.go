@@ -1,10 +1,18 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -315,3 +323,628 @@ func TestExecuteTaskUpdateWithOutput_Success(t *testing.T) {
 	outputStr := setup.stdout.String()
 	assert.Contains(t, outputStr, "Task updated successfully!", "期待される出力が含まれていません")
 }
+
+func TestExecuteTaskCompleteWithOutput_ListIndexAndPrefix(t *testing.T) {
+	testProject := api.Project{
+		ID:   "test-project-6",
+		Name: "Test Project 6",
+	}
+	testTasks := []api.Item{
+		{ID: "6X7rM8997g3RQmvh", Content: "First Task", ProjectID: "test-project-6", ChildOrder: 1},
+		{ID: "6X7rfEVP8hvv25ZQ", Content: "Second Task", ProjectID: "test-project-6", ChildOrder: 2},
+		{ID: "7AbcDEfgh1234567", Content: "Third Task", ProjectID: "test-project-6", ChildOrder: 3},
+	}
+
+	tests := []struct {
+		name       string
+		refs       []string
+		expectedID []string
+		expectErr  bool
+	}{
+		{
+			name:       "一覧番号で指定",
+			refs:       []string{"1", "3"},
+			expectedID: []string{"6X7rM8997g3RQmvh", "7AbcDEfgh1234567"},
+		},
+		{
+			name:       "IDプレフィックスで指定",
+			refs:       []string{"7A"},
+			expectedID: []string{"7AbcDEfgh1234567"},
+		},
+		{
+			name:      "曖昧なIDプレフィックスはエラー",
+			refs:      []string{"6X7r"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: テスト環境を準備
+			setup := setupTestTaskExecutor(t)
+			defer setup.cleanup()
+
+			insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{testProject})
+			insertTestTasksIntoDB(t, setup.dbPath, testTasks)
+
+			var closedIDs []string
+			setup.mockClient.CloseTaskFunc = func(_ context.Context, taskID string) (*api.SyncResponse, error) {
+				closedIDs = append(closedIDs, taskID)
+				return &api.SyncResponse{SyncToken: "completed-token"}, nil
+			}
+
+			// 一覧を表示して番号を保存
+			err := setup.executor.executeTaskListWithOutput(context.Background(), &taskListParams{})
+			require.NoError(t, err)
+			assert.Contains(t, setup.stdout.String(), "1. ", "一覧番号が出力に含まれていません")
+
+			// Act: テスト対象を実行
+			for _, ref := range tt.refs {
+				err = setup.executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: ref})
+				if err != nil {
+					break
+				}
+			}
+
+			// Assert: 結果を検証
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "6X7rM8997g3RQmvh", "候補が表示されていません")
+				assert.Contains(t, err.Error(), "6X7rfEVP8hvv25ZQ", "候補が表示されていません")
+				assert.Empty(t, closedIDs, "曖昧な参照でAPIが呼ばれています")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedID, closedIDs)
+		})
+	}
+}
+
+func TestExecuteTaskCompleteWithOutput_ListIndexOutOfRange(t *testing.T) {
+	// Arrange: 数字で始まるIDのタスクを含む3件の一覧を表示しておく
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "test-project-6", Name: "Test Project 6"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "6X7rM8997g3RQmvh", Content: "First Task", ProjectID: "test-project-6", ChildOrder: 1},
+		{ID: "6X7rfEVP8hvv25ZQ", Content: "Second Task", ProjectID: "test-project-6", ChildOrder: 2},
+		{ID: "7AbcDEfgh1234567", Content: "Third Task", ProjectID: "test-project-6", ChildOrder: 3},
+	})
+	require.NoError(t, setup.executor.executeTaskListWithOutput(context.Background(), &taskListParams{}))
+
+	var closedIDs []string
+	setup.mockClient.CloseTaskFunc = func(_ context.Context, taskID string) (*api.SyncResponse, error) {
+		closedIDs = append(closedIDs, taskID)
+		return &api.SyncResponse{SyncToken: "completed-token"}, nil
+	}
+
+	// Act: 一覧に無い番号を指定
+	err := setup.executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "7"})
+
+	// Assert: "7"で始まるIDのタスクを完了しない
+	require.ErrorIs(t, err, repository.ErrTaskNotFound)
+	assert.Contains(t, err.Error(), "no task #7 in the last listing")
+	assert.Empty(t, closedIDs, "一覧に無い番号がIDプレフィックスとして解釈されています")
+}
+
+func TestExecuteTaskCompleteWithOutput_ContentQuery(t *testing.T) {
+	testProject := api.Project{
+		ID:   "test-project-7",
+		Name: "Test Project 7",
+	}
+	testTasks := []api.Item{
+		{ID: "task-passport", Content: "Renew passport", ProjectID: "test-project-7"},
+		{ID: "task-flight", Content: "Book flight to Osaka", ProjectID: "test-project-7"},
+		{ID: "task-hotel", Content: "Book hotel in Osaka", ProjectID: "test-project-7"},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		input      string
+		expectedID string
+		expectErr  bool
+	}{
+		{
+			name:       "内容完全一致は確認なし",
+			query:      "renew passport",
+			input:      "",
+			expectedID: "task-passport",
+		},
+		{
+			name:       "部分一致は確認後に採用",
+			query:      "passport",
+			input:      "y\n",
+			expectedID: "task-passport",
+		},
+		{
+			name:      "部分一致の確認を拒否",
+			query:     "passport",
+			input:     "n\n",
+			expectErr: true,
+		},
+		{
+			name:       "複数一致は番号で選択",
+			query:      "osaka",
+			input:      "2\n",
+			expectedID: "task-hotel",
+		},
+		{
+			name:      "複数一致で不正な番号",
+			query:     "osaka",
+			input:     "5\n",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: テスト環境を準備
+			setup := setupTestTaskExecutor(t)
+			defer setup.cleanup()
+
+			insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{testProject})
+			insertTestTasksIntoDB(t, setup.dbPath, testTasks)
+
+			originalReader := promptReader
+			promptReader = bufio.NewReader(strings.NewReader(tt.input))
+			defer func() { promptReader = originalReader }()
+
+			var closedIDs []string
+			setup.mockClient.CloseTaskFunc = func(_ context.Context, taskID string) (*api.SyncResponse, error) {
+				closedIDs = append(closedIDs, taskID)
+				return &api.SyncResponse{SyncToken: "completed-token"}, nil
+			}
+
+			// Act: テスト対象を実行
+			err := setup.executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: tt.query})
+
+			// Assert: 結果を検証
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Empty(t, closedIDs, "選択されていないタスクが完了されています")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.expectedID}, closedIDs)
+		})
+	}
+}
+
+func TestExecuteTaskCompleteWithOutput_DryRunKeepsLocalStorage(t *testing.T) {
+	setup := setupTaskTestWithMock(t, "test-project-8", "task-dry-run", func(_ *api.MockClient) {})
+	defer setup.cleanup()
+	setup.repository.SetDryRun(true)
+
+	// Act: テスト対象を実行
+	err := setup.executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-dry-run"})
+
+	// Assert: 結果を検証
+	require.NoError(t, err)
+
+	tasks, err := setup.repository.GetTasks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Nil(t, tasks[0].DateCompleted, "ドライランでローカルストレージが変更されています")
+}
+
+func TestExecuteTaskCompleteWithOutput_DryRunWritesOnlyJSONToStdout(t *testing.T) {
+	// Arrange: ドライランのAPIクライアントと出力をコマンドの実行時と同じように組み立てる
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("ドライランでリクエストが送信されています")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	client, err := api.NewClient("test-token")
+	require.NoError(t, err)
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	base := setupTestExecutorBaseWithClient(t, client, false)
+	defer base.cleanup()
+	insertTestProjectsIntoDB(t, base.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, base.dbPath, []api.Item{{ID: "task-1", ProjectID: "project-1", Content: "Buy milk"}})
+
+	client.SetDryRun(base.stdout)
+	base.repository.SetDryRun(true)
+	executor := &taskExecutor{cfg: base.cfg, repository: base.repository, output: newOutput(true, base.stdout, base.stderr)}
+
+	// Act
+	err = executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-1"})
+
+	// Assert: 標準出力はコマンドのJSONのみで、メッセージは標準エラー出力に出る
+	require.NoError(t, err)
+	var printed struct {
+		Commands []api.Command `json:"commands"`
+	}
+	require.NoError(t, json.Unmarshal(base.stdout.Bytes(), &printed), "標準出力がJSONではありません: %s", base.stdout.String())
+	require.Len(t, printed.Commands, 1)
+	assert.Equal(t, api.CommandItemClose, printed.Commands[0].Type)
+	assert.Contains(t, base.stderr.String(), "Task completed")
+}
+
+func TestExecuteTaskListWithOutput_Completed(t *testing.T) {
+	now := time.Now()
+	projects := []api.Project{
+		{ID: "project-work", Name: "Work"},
+		{ID: "project-home", Name: "Home"},
+	}
+	completedTasks := []api.Item{
+		{ID: "done-old", Content: "Old report", ProjectID: "project-work", DateCompleted: &api.TodoistTime{Time: now.Add(-48 * time.Hour)}},
+		{ID: "done-new", Content: "New report", ProjectID: "project-work", DateCompleted: &api.TodoistTime{Time: now.Add(-time.Hour)}},
+		{ID: "done-home", Content: "Laundry", ProjectID: "project-home", DateCompleted: &api.TodoistTime{Time: now.Add(-2 * time.Hour)}},
+		{ID: "done-archived", Content: "Archived", ProjectID: "project-gone", DateCompleted: &api.TodoistTime{Time: now.Add(-time.Hour)}},
+	}
+
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+	insertTestProjectsIntoDB(t, setup.dbPath, projects)
+
+	var requests []api.CompletedTasksRequest
+	setup.mockClient.GetCompletedTasksFunc = func(_ context.Context, req *api.CompletedTasksRequest) ([]api.Item, error) {
+		requests = append(requests, *req)
+		return completedTasks, nil
+	}
+
+	params := &taskListParams{projectFilter: "Work", completed: true, since: now.Add(-7 * 24 * time.Hour)}
+
+	// Act: 1回目は指定期間の全履歴を取得する
+	err := setup.executor.executeTaskListWithOutput(context.Background(), params)
+
+	// Assert
+	require.NoError(t, err)
+	output := setup.stdout.String()
+	assert.Contains(t, output, "Found 2 task(s) completed since")
+	assert.Less(t, strings.Index(output, "New report"), strings.Index(output, "Old report"), "完了日時の新しい順に並んでいません")
+	assert.NotContains(t, output, "Laundry", "他のプロジェクトのタスクが表示されています")
+	require.Len(t, requests, 1)
+	assert.True(t, params.since.Equal(requests[0].Since), "指定期間の開始から取得していません")
+	assert.Empty(t, requests[0].ProjectID, "履歴は全プロジェクト分を取得してローカルで絞り込む想定です")
+
+	// 完了済みタスクはローカルに保存され、task list --allでも表示される
+	all, err := setup.repository.GetTasks(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, all, 3, "ローカルにあるプロジェクトの完了済みタスクが保存されていません")
+
+	// 一覧番号で参照できる
+	taskID, err := setup.repository.ResolveTaskID(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "done-new", taskID)
+
+	// Act: 2回目は取得済みの期間を再取得しない
+	setup.stdout.Reset()
+	err = setup.executor.executeTaskListWithOutput(context.Background(), params)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.True(t, requests[1].Since.After(params.since), "取得済みの期間を再取得しています")
+}
+
+func TestExecuteTaskListWithOutput_CompletedRecurring(t *testing.T) {
+	now := time.Now()
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-home", Name: "Home"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-plants", Content: "Water plants", ProjectID: "project-home", Priority: 1, Due: &api.Due{Date: "2026-10-20", String: "every day", IsRecurring: true}},
+	})
+
+	// 繰り返しタスクの完了は未完了のタスクと同じIDで返される
+	setup.mockClient.GetCompletedTasksFunc = func(_ context.Context, _ *api.CompletedTasksRequest) ([]api.Item, error) {
+		return []api.Item{
+			{ID: "task-plants", Content: "Water plants", ProjectID: "project-home", DateCompleted: &api.TodoistTime{Time: now.Add(-26 * time.Hour)}},
+			{ID: "task-plants", Content: "Water plants", ProjectID: "project-home", DateCompleted: &api.TodoistTime{Time: now.Add(-2 * time.Hour)}},
+		}, nil
+	}
+
+	// Act
+	err := setup.executor.executeTaskListWithOutput(context.Background(), &taskListParams{completed: true, since: now.Add(-7 * 24 * time.Hour)})
+
+	// Assert: 完了はそれぞれ履歴として表示される
+	require.NoError(t, err)
+	output := setup.stdout.String()
+	assert.Contains(t, output, "Found 2 task(s) completed since")
+	assert.Equal(t, 2, strings.Count(output, "Water plants"))
+
+	// 未完了の繰り返しタスクは上書きされない
+	tasks, err := setup.repository.GetTasks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Nil(t, tasks[0].DateCompleted, "未完了の繰り返しタスクが完了済みで上書きされています")
+	require.NotNil(t, tasks[0].Due)
+	assert.Equal(t, "2026-10-20", tasks[0].Due.Date)
+	assert.True(t, tasks[0].Due.IsRecurring)
+}
+
+// setupRecurringTaskTest は繰り返しタスクを1件登録したテスト環境を作成する
+func setupRecurringTaskTest(t *testing.T) *testTaskExecutorSetup {
+	t.Helper()
+
+	setup := setupTestTaskExecutor(t)
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Home"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{{
+		ID:        "task-recurring",
+		Content:   "Water plants",
+		ProjectID: "project-1",
+		Due:       &api.Due{Date: time.Now().Format("2006-01-02"), String: "every day", IsRecurring: true},
+	}})
+	markTestInitialSyncDone(t, setup.dbPath, "token-before-close")
+	return setup
+}
+
+// findTestTask はローカルストレージからタスクを取得する
+func findTestTask(t *testing.T, setup *testTaskExecutorSetup, taskID string) *api.Item {
+	t.Helper()
+
+	tasks, err := setup.repository.GetTasks(context.Background())
+	require.NoError(t, err)
+	for i := range tasks {
+		if tasks[i].ID == taskID {
+			return &tasks[i]
+		}
+	}
+	t.Fatalf("task %s not found", taskID)
+	return nil
+}
+
+func TestExecuteTaskCompleteWithOutput_RecurringPullsRescheduledTask(t *testing.T) {
+	setup := setupRecurringTaskTest(t)
+	defer setup.cleanup()
+
+	nextDate := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	var closed []string
+	setup.mockClient.CloseTaskFunc = func(_ context.Context, taskID string) (*api.SyncResponse, error) {
+		closed = append(closed, taskID)
+		return &api.SyncResponse{SyncToken: "server-token-after-close"}, nil
+	}
+	var syncTokens []string
+	setup.mockClient.SyncFunc = func(_ context.Context, req *api.SyncRequest) (*api.SyncResponse, error) {
+		// サーバー側で再スケジュールされたタスクを返す
+		syncTokens = append(syncTokens, req.SyncToken)
+		return &api.SyncResponse{
+			SyncToken: "token-after-pull",
+			Items: []api.Item{{
+				ID:        "task-recurring",
+				Content:   "Water plants",
+				ProjectID: "project-1",
+				Due:       &api.Due{Date: nextDate, String: "every day", IsRecurring: true},
+			}},
+		}, nil
+	}
+
+	// Act
+	err := setup.executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-recurring"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"task-recurring"}, closed)
+	assert.Equal(t, []string{"token-before-close"}, syncTokens, "完了前のsync_tokenから再スケジュール結果を取得していません")
+
+	task := findTestTask(t, setup, "task-recurring")
+	assert.Nil(t, task.DateCompleted, "繰り返しタスクがローカルで完了扱いになっています")
+	assert.Equal(t, nextDate, task.Due.Date)
+	assert.Contains(t, setup.stdout.String(), "Recurring task rescheduled to "+nextDate)
+}
+
+func TestExecuteTaskCompleteWithOutput_RecurringComputesNextDateWhenSyncFails(t *testing.T) {
+	setup := setupRecurringTaskTest(t)
+	defer setup.cleanup()
+
+	setup.mockClient.SyncFunc = func(_ context.Context, _ *api.SyncRequest) (*api.SyncResponse, error) {
+		return nil, errors.New("network unreachable")
+	}
+
+	// Act
+	err := setup.executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-recurring"})
+
+	// Assert
+	require.NoError(t, err)
+	task := findTestTask(t, setup, "task-recurring")
+	assert.Nil(t, task.DateCompleted, "繰り返しタスクがローカルで完了扱いになっています")
+	assert.Equal(t, time.Now().AddDate(0, 0, 1).Format("2006-01-02"), task.Due.Date, "次回の期限が計算されていません")
+}
+
+func TestExecuteTaskCompleteWithOutput_Forever(t *testing.T) {
+	setup := setupRecurringTaskTest(t)
+	defer setup.cleanup()
+
+	var completed []string
+	setup.mockClient.CompleteTaskForeverFunc = func(_ context.Context, taskID string) (*api.SyncResponse, error) {
+		completed = append(completed, taskID)
+		return &api.SyncResponse{SyncToken: "forever-token"}, nil
+	}
+	setup.mockClient.CloseTaskFunc = func(_ context.Context, _ string) (*api.SyncResponse, error) {
+		t.Fatal("--forever should not reschedule the task")
+		return nil, nil
+	}
+
+	// Act
+	err := setup.executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-recurring", forever: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"task-recurring"}, completed)
+	task := findTestTask(t, setup, "task-recurring")
+	assert.NotNil(t, task.DateCompleted, "繰り返しを終了したタスクが完了になっていません")
+	assert.NotContains(t, setup.stdout.String(), "rescheduled")
+}
+
+func TestExecuteTaskCompleteWithOutput_ReconcilesFromStoredSyncToken(t *testing.T) {
+	setup := setupTaskTestWithMock(t, "project-1", "task-1", func(mockClient *api.MockClient) {
+		mockClient.CloseTaskFunc = func(_ context.Context, _ string) (*api.SyncResponse, error) {
+			return &api.SyncResponse{SyncToken: "command-token"}, nil
+		}
+	})
+	defer setup.cleanup()
+	markTestInitialSyncDone(t, setup.dbPath, "token-before")
+
+	var syncTokens []string
+	setup.mockClient.SyncFunc = func(_ context.Context, req *api.SyncRequest) (*api.SyncResponse, error) {
+		syncTokens = append(syncTokens, req.SyncToken)
+		if req.SyncToken != "token-before" {
+			return &api.SyncResponse{SyncToken: req.SyncToken}, nil
+		}
+		// 前回の同期後に他のクライアントで追加されたタスク
+		return &api.SyncResponse{
+			SyncToken: "token-after",
+			Items:     []api.Item{{ID: "remote-task", Content: "Added elsewhere", ProjectID: "project-1"}},
+		}, nil
+	}
+
+	// Act
+	err := setup.executor.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token-before"}, syncTokens, "保存済みのsync_tokenから増分同期していません")
+	assert.NotNil(t, findTestTask(t, setup, "task-1").DateCompleted, "完了がローカルに反映されていません")
+	assert.Equal(t, "Added elsewhere", findTestTask(t, setup, "remote-task").Content, "他のクライアントの変更を取りこぼしています")
+
+	// コマンドのレスポンスのsync_tokenではなく、増分同期のsync_tokenが保存される
+	require.NoError(t, setup.repository.Sync(context.Background()))
+	assert.Equal(t, []string{"token-before", "token-after"}, syncTokens)
+}
+
+// testCollaborators はテスト用の共同作業者（ログイン中のユーザーはTaro）
+func testCollaborators() *api.CollaboratorList {
+	return &api.CollaboratorList{
+		Collaborators: []api.Collaborator{
+			{ID: "user-me", Email: "taro@example.com", FullName: "Taro Yamada"},
+			{ID: "user-alice", Email: "alice@example.com", FullName: "Alice Smith"},
+		},
+		States: []api.CollaboratorState{
+			{ProjectID: "project-1", UserID: "user-me", State: api.CollaboratorStateActive},
+			{ProjectID: "project-1", UserID: "user-alice", State: api.CollaboratorStateActive},
+		},
+		CurrentUser: &api.User{ID: "user-me", Email: "taro@example.com", FullName: "Taro Yamada"},
+	}
+}
+
+func TestExecuteTaskAddWithOutput_Assign(t *testing.T) {
+	// Arrange: 共有プロジェクトと共同作業者
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Shared"}})
+	insertTestCollaboratorsIntoDB(t, setup.dbPath, testCollaborators())
+
+	var created *api.CreateTaskRequest
+	setup.mockClient.CreateTaskFunc = func(_ context.Context, req *api.CreateTaskRequest) (*api.SyncResponse, error) {
+		created = req
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+
+	// Act
+	err := setup.executor.executeTaskAddWithOutput(context.Background(), &taskAddParams{
+		content:   "Review design",
+		projectID: "Shared",
+		assign:    "alice@example.com",
+	})
+
+	// Assert: メールアドレスがUIDに解決される
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, "project-1", created.ProjectID)
+	assert.Equal(t, "user-alice", created.AssigneeID)
+}
+
+func TestExecuteTaskAddWithOutput_AssignUnknownCollaborator(t *testing.T) {
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Shared"}})
+	insertTestCollaboratorsIntoDB(t, setup.dbPath, testCollaborators())
+
+	err := setup.executor.executeTaskAddWithOutput(context.Background(), &taskAddParams{
+		content:   "Review design",
+		projectID: "Shared",
+		assign:    "carol@example.com",
+	})
+
+	assert.ErrorContains(t, err, "collaborator not found")
+}
+
+func TestExecuteTaskUpdateWithOutput_AssignScopedToTaskProject(t *testing.T) {
+	// Arrange: Carolは"Other"プロジェクトにのみ参加している
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "project-1", Name: "Shared"},
+		{ID: "project-2", Name: "Other"},
+	})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-1", Content: "Review design"},
+		{ID: "task-2", ProjectID: "project-2", Content: "Plan offsite"},
+	})
+	collaborators := testCollaborators()
+	collaborators.Collaborators = append(collaborators.Collaborators, api.Collaborator{ID: "user-carol", Email: "carol@example.com", FullName: "Carol White"})
+	collaborators.States = append(collaborators.States, api.CollaboratorState{ProjectID: "project-2", UserID: "user-carol", State: api.CollaboratorStateActive})
+	insertTestCollaboratorsIntoDB(t, setup.dbPath, collaborators)
+
+	var updated *api.UpdateTaskRequest
+	setup.mockClient.UpdateTaskFunc = func(_ context.Context, _ string, req *api.UpdateTaskRequest) (*api.SyncResponse, error) {
+		updated = req
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+
+	// Act & Assert: タスクのプロジェクトに参加していない共同作業者は担当にできない
+	err := setup.executor.executeTaskUpdateWithOutput(context.Background(), &taskUpdateParams{taskID: "task-1", assign: "carol@example.com"})
+	assert.ErrorContains(t, err, "collaborator not found")
+	assert.Nil(t, updated)
+
+	err = setup.executor.executeTaskUpdateWithOutput(context.Background(), &taskUpdateParams{taskID: "task-2", assign: "carol@example.com"})
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	assert.Equal(t, "user-carol", updated.AssigneeID)
+}
+
+func TestExecuteTaskListWithOutput_AssignedTo(t *testing.T) {
+	// Arrange: 担当者の異なるタスク
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Shared"}})
+	insertTestCollaboratorsIntoDB(t, setup.dbPath, testCollaborators())
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-1", Content: "My task", ResponsibleUID: "user-me"},
+		{ID: "task-2", ProjectID: "project-1", Content: "Alice task", ResponsibleUID: "user-alice"},
+		{ID: "task-3", ProjectID: "project-1", Content: "Unassigned task"},
+	})
+
+	t.Run("me", func(t *testing.T) {
+		setup.stdout.Reset()
+
+		err := setup.executor.executeTaskListWithOutput(context.Background(), &taskListParams{assignedTo: "me"})
+
+		require.NoError(t, err)
+		output := setup.stdout.String()
+		assert.Contains(t, output, "My task 👤 Taro Yamada")
+		assert.NotContains(t, output, "Alice task")
+		assert.NotContains(t, output, "Unassigned task")
+	})
+
+	t.Run("名前", func(t *testing.T) {
+		setup.stdout.Reset()
+
+		err := setup.executor.executeTaskListWithOutput(context.Background(), &taskListParams{assignedTo: "alice"})
+
+		require.NoError(t, err)
+		output := setup.stdout.String()
+		assert.Contains(t, output, "Alice task 👤 Alice Smith")
+		assert.NotContains(t, output, "My task")
+	})
+
+	t.Run("一覧には担当者名が表示される", func(t *testing.T) {
+		setup.stdout.Reset()
+
+		err := setup.executor.executeTaskListWithOutput(context.Background(), &taskListParams{})
+
+		require.NoError(t, err)
+		output := setup.stdout.String()
+		assert.Contains(t, output, "My task 👤 Taro Yamada")
+		assert.Contains(t, output, "Alice task 👤 Alice Smith")
+		assert.Contains(t, output, "Unassigned task\n")
+	})
+}
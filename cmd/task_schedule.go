@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+// taskScheduleParams は時刻付き期限・所要時間・締め切りの指定（task add/update共通）
+type taskScheduleParams struct {
+	dueDatetime string
+	duration    string
+	deadline    string
+}
+
+// getTaskScheduleParams は時刻付き期限・所要時間・締め切りのフラグを取得する
+func getTaskScheduleParams(cmd *cobra.Command) taskScheduleParams {
+	dueDatetime, _ := cmd.Flags().GetString("due-datetime")
+	duration, _ := cmd.Flags().GetString("duration")
+	deadline, _ := cmd.Flags().GetString("deadline")
+	return taskScheduleParams{
+		dueDatetime: dueDatetime,
+		duration:    duration,
+		deadline:    deadline,
+	}
+}
+
+// isEmpty は何も指定されていないかを返す
+func (p *taskScheduleParams) isEmpty() bool {
+	return p.dueDatetime == "" && p.duration == "" && p.deadline == ""
+}
+
+// taskSchedule は解析済みの時刻付き期限・所要時間・締め切り
+type taskSchedule struct {
+	dueDatetime  string
+	duration     *api.Duration
+	deadlineDate string
+}
+
+// dueDatetimeLayouts は --due-datetime で受け付けるローカル日時の形式
+var dueDatetimeLayouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+}
+
+// parseTaskSchedule は時刻付き期限・所要時間・締め切りの指定を解析する
+func parseTaskSchedule(params *taskScheduleParams) (*taskSchedule, error) {
+	schedule := &taskSchedule{}
+
+	if params.dueDatetime != "" {
+		dueDatetime, err := parseDueDatetime(params.dueDatetime)
+		if err != nil {
+			return nil, err
+		}
+		schedule.dueDatetime = dueDatetime
+	}
+
+	if params.duration != "" {
+		duration, err := parseTaskDuration(params.duration)
+		if err != nil {
+			return nil, err
+		}
+		schedule.duration = duration
+	}
+
+	if params.deadline != "" {
+		if _, err := time.Parse("2006-01-02", params.deadline); err != nil {
+			return nil, fmt.Errorf("invalid deadline %q (use YYYY-MM-DD)", params.deadline)
+		}
+		schedule.deadlineDate = params.deadline
+	}
+
+	return schedule, nil
+}
+
+// parseDueDatetime は時刻付き期限をSync APIの形式に変換する
+// タイムゾーンなしの日時はfloating（YYYY-MM-DDTHH:MM:SS）、RFC3339の日時はUTCに変換する
+func parseDueDatetime(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dueDatetimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format("2006-01-02T15:04:05"), nil
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC().Format("2006-01-02T15:04:05Z"), nil
+	}
+	return "", fmt.Errorf("invalid due datetime %q (use e.g. '2025-01-10 09:00' or RFC3339)", value)
+}
+
+// parseTaskDuration は所要時間（例: 45m, 1h30m, 2h, 1d）を解析する
+// 日単位の指定はday、それ以外は分単位のminuteとして扱う
+func parseTaskDuration(value string) (*api.Duration, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid duration %q (use e.g. 45m, 1h30m or 1d)", value)
+		}
+		return &api.Duration{Amount: n, Unit: api.DurationUnitDay}, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 || d%time.Minute != 0 {
+		return nil, fmt.Errorf("invalid duration %q (use e.g. 45m, 1h30m or 1d)", value)
+	}
+	return &api.Duration{Amount: int(d / time.Minute), Unit: api.DurationUnitMinute}, nil
+}
+
+// parseDueShift は複製・コピー時に期限をずらす指定（--shift 7d/-3d/2w、--start YYYY-MM-DD）を解析する
+func parseDueShift(shift, start string) (repository.DueShift, error) {
+	if shift != "" && start != "" {
+		return repository.DueShift{}, fmt.Errorf("--shift and --start cannot be used together")
+	}
+
+	if start != "" {
+		if _, err := time.Parse("2006-01-02", start); err != nil {
+			return repository.DueShift{}, fmt.Errorf("invalid --start value: %s (use YYYY-MM-DD)", start)
+		}
+		return repository.DueShift{Start: start}, nil
+	}
+
+	if shift == "" {
+		return repository.DueShift{}, nil
+	}
+	unitDays := map[byte]int{'d': 1, 'w': 7}
+	if len(shift) >= 2 {
+		if unit, ok := unitDays[shift[len(shift)-1]]; ok {
+			if n, err := strconv.Atoi(shift[:len(shift)-1]); err == nil {
+				return repository.DueShift{Days: n * unit}, nil
+			}
+		}
+	}
+	return repository.DueShift{}, fmt.Errorf("invalid --shift value: %s (use e.g. 7d, -3d or 2w)", shift)
+}
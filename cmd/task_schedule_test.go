@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func TestParseTaskDuration(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    *api.Duration
+		expectError bool
+	}{
+		{name: "分", value: "45m", expected: &api.Duration{Amount: 45, Unit: api.DurationUnitMinute}},
+		{name: "時間と分", value: "1h30m", expected: &api.Duration{Amount: 90, Unit: api.DurationUnitMinute}},
+		{name: "時間", value: "2H", expected: &api.Duration{Amount: 120, Unit: api.DurationUnitMinute}},
+		{name: "日", value: "1d", expected: &api.Duration{Amount: 1, Unit: api.DurationUnitDay}},
+		{name: "単位なし", value: "45", expectError: true},
+		{name: "秒単位", value: "90s", expectError: true},
+		{name: "0分", value: "0m", expectError: true},
+		{name: "日と時間の組み合わせ", value: "1d2h", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			duration, err := parseTaskDuration(tt.value)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, duration)
+		})
+	}
+}
+
+func TestParseDueDatetime(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    string
+		expectError bool
+	}{
+		{name: "空白区切り", value: "2026-11-01 09:30", expected: "2026-11-01T09:30:00"},
+		{name: "T区切り・秒あり", value: "2026-11-01T09:30:15", expected: "2026-11-01T09:30:15"},
+		{name: "RFC3339はUTCに変換", value: "2026-11-01T09:30:00+09:00", expected: "2026-11-01T00:30:00Z"},
+		{name: "日付のみ", value: "2026-11-01", expectError: true},
+		{name: "自然言語", value: "tomorrow 9am", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := parseDueDatetime(tt.value)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestParseDueShift(t *testing.T) {
+	tests := []struct {
+		name        string
+		shift       string
+		start       string
+		expected    repository.DueShift
+		expectError bool
+	}{
+		{name: "指定なし", expected: repository.DueShift{}},
+		{name: "日", shift: "7d", expected: repository.DueShift{Days: 7}},
+		{name: "負の日数", shift: "-3d", expected: repository.DueShift{Days: -3}},
+		{name: "週", shift: "2w", expected: repository.DueShift{Days: 14}},
+		{name: "開始日", start: "2026-11-02", expected: repository.DueShift{Start: "2026-11-02"}},
+		{name: "単位なし", shift: "7", expectError: true},
+		{name: "時間単位", shift: "24h", expectError: true},
+		{name: "不正な開始日", start: "11/02", expectError: true},
+		{name: "同時指定", shift: "7d", start: "2026-11-02", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shift, err := parseDueShift(tt.shift, tt.start)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, shift)
+		})
+	}
+}
+
+func TestExecuteTaskAddWithOutput_Schedule(t *testing.T) {
+	// Arrange: 作成後の同期で所要時間・締め切り付きのタスクが返る
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	markTestInitialSyncDone(t, setup.dbPath, "stored-token")
+
+	var created *api.CreateTaskRequest
+	setup.mockClient.CreateTaskFunc = func(_ context.Context, req *api.CreateTaskRequest) (*api.SyncResponse, error) {
+		created = req
+		return &api.SyncResponse{SyncToken: "command-token"}, nil
+	}
+	setup.mockClient.SyncFunc = func(_ context.Context, _ *api.SyncRequest) (*api.SyncResponse, error) {
+		return &api.SyncResponse{
+			SyncToken: "next-token",
+			Items: []api.Item{{
+				ID:        "task-1",
+				ProjectID: "project-1",
+				Content:   "Write report",
+				Due:       &api.Due{Date: "2026-11-01T09:30:00", String: "Nov 1 9:30"},
+				Duration:  &api.Duration{Amount: 45, Unit: api.DurationUnitMinute},
+				Deadline:  &api.Deadline{Date: "2026-11-03"},
+			}},
+		}, nil
+	}
+
+	// Act
+	err := setup.executor.executeTaskAddWithOutput(context.Background(), &taskAddParams{
+		content: "Write report",
+		schedule: taskScheduleParams{
+			dueDatetime: "2026-11-01 09:30",
+			duration:    "45m",
+			deadline:    "2026-11-03",
+		},
+	})
+
+	// Assert: リクエストに変換され、同期後のローカルDBにも保存される
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, "2026-11-01T09:30:00", created.DueDatetime)
+	assert.Empty(t, created.DueString)
+	assert.Equal(t, &api.Duration{Amount: 45, Unit: api.DurationUnitMinute}, created.Duration)
+	assert.Equal(t, "2026-11-03", created.DeadlineDate)
+
+	tasks, err := setup.executor.repository.GetTasks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, &api.Duration{Amount: 45, Unit: api.DurationUnitMinute}, tasks[0].Duration)
+	assert.Equal(t, &api.Deadline{Date: "2026-11-03"}, tasks[0].Deadline)
+}
+
+func TestBuildUpdateTaskRequest_Schedule(t *testing.T) {
+	setup := setupTestTaskExecutor(t)
+	defer setup.cleanup()
+
+	t.Run("所要時間と締め切りのみの更新", func(t *testing.T) {
+		req, err := setup.executor.buildUpdateTaskRequest(&taskUpdateParams{
+			schedule: taskScheduleParams{duration: "1d", deadline: "2026-11-01"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &api.Duration{Amount: 1, Unit: api.DurationUnitDay}, req.Duration)
+		assert.Equal(t, "2026-11-01", req.DeadlineDate)
+	})
+
+	t.Run("--dueと--due-datetimeの同時指定はエラー", func(t *testing.T) {
+		_, err := setup.executor.buildUpdateTaskRequest(&taskUpdateParams{
+			dueDate:  "tomorrow",
+			schedule: taskScheduleParams{dueDatetime: "2026-11-01 09:30"},
+		})
+
+		assert.ErrorContains(t, err, "--due and --due-datetime cannot be used together")
+	})
+
+	t.Run("不正な締め切り", func(t *testing.T) {
+		_, err := setup.executor.buildUpdateTaskRequest(&taskUpdateParams{
+			schedule: taskScheduleParams{deadline: "next friday"},
+		})
+
+		assert.ErrorContains(t, err, "invalid deadline")
+	})
+}
@@ -0,0 +1,333 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	taskCmd.AddCommand(taskShowCmd)
+
+	// task show用のフラグ
+	taskShowCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+// taskShowCmd はタスク詳細表示コマンド
+var taskShowCmd = &cobra.Command{
+	Use:   "show [task ID]",
+	Short: "Show task details",
+	Long: `Display everything about a single task from the local mirror:
+project/section path, parent and subtasks, recurrence, assignee,
+timestamps, comments and reminders.
+
+The task can be specified by its full ID, any unique ID prefix, its
+number in the last 'task list' output, or its content.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTaskShow,
+}
+
+// taskShowParams はタスク詳細表示のパラメータ
+type taskShowParams struct {
+	taskRef    string
+	jsonOutput bool
+}
+
+// taskSummary はタスク詳細に含める関連タスクの概要
+type taskSummary struct {
+	ID        string `json:"id"`
+	Content   string `json:"content"`
+	Completed bool   `json:"completed"`
+}
+
+// taskComment はタスク詳細に含めるコメント
+type taskComment struct {
+	ID        string     `json:"id"`
+	PostedUID string     `json:"posted_uid,omitempty"`
+	PostedAt  *time.Time `json:"posted_at,omitempty"`
+	Content   string     `json:"content"`
+}
+
+// taskReminder はタスク詳細に含めるリマインダー
+type taskReminder struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	MinuteOffset int    `json:"minute_offset,omitempty"`
+	DueDate      string `json:"due_date,omitempty"`
+	When         string `json:"when"`
+}
+
+// taskDetail はタスク詳細表示のデータ
+type taskDetail struct {
+	ID             string         `json:"id"`
+	Content        string         `json:"content"`
+	Description    string         `json:"description,omitempty"`
+	ProjectID      string         `json:"project_id"`
+	ProjectPath    string         `json:"project_path,omitempty"`
+	SectionID      string         `json:"section_id,omitempty"`
+	SectionName    string         `json:"section_name,omitempty"`
+	Priority       int            `json:"priority"`
+	Labels         []string       `json:"labels,omitempty"`
+	Due            *api.Due       `json:"due,omitempty"`
+	Duration       *api.Duration  `json:"duration,omitempty"`
+	Deadline       *api.Deadline  `json:"deadline,omitempty"`
+	Parent         *taskSummary   `json:"parent,omitempty"`
+	Subtasks       []taskSummary  `json:"subtasks,omitempty"`
+	ResponsibleUID string         `json:"responsible_uid,omitempty"`
+	AssigneeName   string         `json:"assignee_name,omitempty"`
+	AssignedByUID  string         `json:"assigned_by_uid,omitempty"`
+	AddedAt        *time.Time     `json:"added_at,omitempty"`
+	CompletedAt    *time.Time     `json:"completed_at,omitempty"`
+	Comments       []taskComment  `json:"comments,omitempty"`
+	Reminders      []taskReminder `json:"reminders,omitempty"`
+}
+
+// getTaskShowParams はタスク詳細表示のパラメータを取得する
+func getTaskShowParams(cmd *cobra.Command, args []string) *taskShowParams {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	return &taskShowParams{
+		taskRef:    args[0],
+		jsonOutput: jsonOutput,
+	}
+}
+
+// runTaskShow はタスク詳細表示の実際の処理
+func runTaskShow(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTaskExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := getTaskShowParams(cmd, args)
+	return executor.executeTaskShowWithOutput(ctx, params)
+}
+
+// executeTaskShowWithOutput はタスク詳細の取得と表示を実行する（テスト可能）
+func (e *taskExecutor) executeTaskShowWithOutput(ctx context.Context, params *taskShowParams) error {
+	// 1. タスクIDを解決
+	taskID, err := e.resolveTaskID(ctx, params.taskRef)
+	if err != nil {
+		return err
+	}
+
+	// 2. 詳細データを構築
+	detail, err := e.buildTaskDetail(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	// 3. 出力
+	if params.jsonOutput {
+		return e.output.JSON(detail)
+	}
+	e.displayTaskDetail(detail)
+
+	return nil
+}
+
+// buildTaskDetail はローカルデータからタスク詳細を構築する
+func (e *taskExecutor) buildTaskDetail(ctx context.Context, taskID string) (*taskDetail, error) {
+	tasks, err := e.repository.GetTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var task *api.Item
+	tasksByID := make(map[string]*api.Item, len(tasks))
+	for i := range tasks {
+		tasksByID[tasks[i].ID] = &tasks[i]
+		if tasks[i].ID == taskID {
+			task = &tasks[i]
+		}
+	}
+	if task == nil {
+		return nil, fmt.Errorf("task not found: %s", taskID)
+	}
+
+	detail := &taskDetail{
+		ID:             task.ID,
+		Content:        task.Content,
+		Description:    task.Description,
+		ProjectID:      task.ProjectID,
+		SectionID:      task.SectionID,
+		Priority:       task.Priority,
+		Labels:         task.Labels,
+		Due:            task.Due,
+		Duration:       task.Duration,
+		Deadline:       task.Deadline,
+		ResponsibleUID: task.ResponsibleUID,
+		AssignedByUID:  task.AssignedByUID,
+	}
+	if !task.DateAdded.IsZero() {
+		addedAt := task.DateAdded.Time
+		detail.AddedAt = &addedAt
+	}
+	if task.DateCompleted != nil && !task.DateCompleted.IsZero() {
+		completedAt := task.DateCompleted.Time
+		detail.CompletedAt = &completedAt
+	}
+
+	// 親タスクとサブタスク
+	if parent, exists := tasksByID[task.ParentID]; exists {
+		detail.Parent = &taskSummary{ID: parent.ID, Content: parent.Content, Completed: parent.DateCompleted != nil}
+	}
+	for i := range tasks {
+		if tasks[i].ParentID == task.ID {
+			detail.Subtasks = append(detail.Subtasks, taskSummary{
+				ID:        tasks[i].ID,
+				Content:   tasks[i].Content,
+				Completed: tasks[i].DateCompleted != nil,
+			})
+		}
+	}
+
+	// プロジェクトパスとセクション名（取得に失敗しても詳細表示は続行）
+	projects, err := e.repository.GetAllProjects(ctx)
+	if err != nil {
+		e.output.Warningf("Failed to load project names: %v", err)
+	} else {
+		detail.ProjectPath = repository.ProjectPath(projects, task.ProjectID)
+	}
+	if task.SectionID != "" {
+		sectionsMap := e.buildSectionsMap(ctx)
+		detail.SectionName = sectionsMap[task.SectionID]
+	}
+	if task.ResponsibleUID != "" {
+		assigneesMap := e.buildAssigneesMap(ctx)
+		detail.AssigneeName = assigneesMap[task.ResponsibleUID]
+	}
+
+	// コメント
+	notes, err := e.repository.GetNotesByTask(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	for i := range notes {
+		comment := taskComment{
+			ID:        notes[i].ID,
+			PostedUID: notes[i].PostedUID,
+			Content:   notes[i].Content,
+		}
+		if !notes[i].Posted.IsZero() {
+			postedAt := notes[i].Posted.Time
+			comment.PostedAt = &postedAt
+		}
+		detail.Comments = append(detail.Comments, comment)
+	}
+
+	// リマインダー
+	reminders, err := e.repository.GetReminders(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+	for i := range reminders {
+		reminder := taskReminder{
+			ID:           reminders[i].ID,
+			Type:         reminders[i].Type,
+			MinuteOffset: reminders[i].MinuteOffset,
+			When:         formatReminder(&reminders[i]),
+		}
+		if reminders[i].Due != nil {
+			reminder.DueDate = reminders[i].Due.Date
+		}
+		detail.Reminders = append(detail.Reminders, reminder)
+	}
+
+	return detail, nil
+}
+
+// displayTaskDetail はタスク詳細を表示する
+func (e *taskExecutor) displayTaskDetail(detail *taskDetail) {
+	e.output.Taskf("%s %s", getPriorityIcon(detail.Priority), detail.Content)
+	e.output.Plainf("   ID: %s", detail.ID)
+
+	if detail.ProjectPath != "" {
+		e.output.Plainf("   Project: %s (%s)", detail.ProjectPath, detail.ProjectID)
+	} else {
+		e.output.Plainf("   Project: %s", detail.ProjectID)
+	}
+	if detail.SectionName != "" {
+		e.output.Plainf("   Section: %s", detail.SectionName)
+	}
+	e.output.Plainf("   Priority: %s", api.Priority(detail.Priority).String())
+	if len(detail.Labels) > 0 {
+		e.output.Plainf("   Labels: %s", strings.Join(detail.Labels, ", "))
+	}
+	if detail.Due != nil {
+		// 繰り返しの指定はRecurrenceに表示するため、期限には次回の日付のみを表示する
+		if detail.Due.IsRecurring || detail.Due.String == "" {
+			e.output.Plainf("   Due: %s", detail.Due.Date)
+		} else {
+			e.output.Plainf("   Due: %s (%s)", detail.Due.String, detail.Due.Date)
+		}
+		if detail.Due.IsRecurring {
+			e.output.Plainf("   Recurrence: %s", detail.Due.String)
+		}
+	}
+	if detail.Duration != nil {
+		e.output.Plainf("   Duration: %s", detail.Duration.String())
+	}
+	if detail.Deadline != nil {
+		e.output.Plainf("   Deadline: %s", detail.Deadline.Date)
+	}
+	if detail.Parent != nil {
+		e.output.Plainf("   Parent: %s (%s)", detail.Parent.Content, detail.Parent.ID)
+	}
+	if detail.AssigneeName != "" {
+		e.output.Plainf("   Assignee: %s (%s)", detail.AssigneeName, detail.ResponsibleUID)
+	} else if detail.ResponsibleUID != "" {
+		e.output.Plainf("   Assignee: %s", detail.ResponsibleUID)
+	}
+	if detail.AddedAt != nil {
+		e.output.Plainf("   Added: %s", detail.AddedAt.Local().Format("2006-01-02 15:04"))
+	}
+	if detail.CompletedAt != nil {
+		e.output.Plainf("   Completed: %s", detail.CompletedAt.Local().Format("2006-01-02 15:04"))
+	}
+	if detail.Description != "" {
+		e.output.Plainf("   Description: %s", detail.Description)
+	}
+
+	if len(detail.Subtasks) > 0 {
+		e.output.Plainf("")
+		e.output.Plainf("   Subtasks (%d):", len(detail.Subtasks))
+		for _, subtask := range detail.Subtasks {
+			checkbox := "⬜"
+			if subtask.Completed {
+				checkbox = "✅"
+			}
+			e.output.Plainf("     %s %s (%s)", checkbox, subtask.Content, subtask.ID)
+		}
+	}
+
+	if len(detail.Comments) > 0 {
+		e.output.Plainf("")
+		e.output.Plainf("   Comments (%d):", len(detail.Comments))
+		for _, comment := range detail.Comments {
+			posted := "unknown"
+			if comment.PostedAt != nil {
+				posted = comment.PostedAt.Local().Format("2006-01-02 15:04")
+			}
+			e.output.Plainf("     [%s] %s", posted, comment.Content)
+		}
+	}
+
+	if len(detail.Reminders) > 0 {
+		e.output.Plainf("")
+		e.output.Plainf("   Reminders (%d):", len(detail.Reminders))
+		for _, reminder := range detail.Reminders {
+			e.output.Plainf("     ⏰ %s (%s)", reminder.When, reminder.ID)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+// setupTaskShowTest はtask showテスト用のデータを投入する
+func setupTaskShowTest(t *testing.T) *testTaskExecutorSetup {
+	t.Helper()
+
+	setup := setupTestTaskExecutor(t)
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{
+		{ID: "proj-work", Name: "Work"},
+		{ID: "proj-backend", Name: "Backend", ParentID: "proj-work"},
+	})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{
+			ID:          "6X7rM8997g3RQmvh",
+			Content:     "Deploy API",
+			Description: "Roll out v2",
+			ProjectID:   "proj-backend",
+			Priority:    4,
+			Labels:      []string{"release"},
+			Due:         &api.Due{Date: "2024-12-02", String: "every monday", IsRecurring: true},
+			DateAdded:   api.TodoistTime{Time: time.Date(2024, 11, 1, 9, 0, 0, 0, time.UTC)},
+		},
+		{ID: "6X7rM8997g3RQmvi", Content: "Write changelog", ProjectID: "proj-backend", ParentID: "6X7rM8997g3RQmvh"},
+		{ID: "7Abc", Content: "Other task", ProjectID: "proj-work"},
+	})
+	insertTestNotesIntoDB(t, setup.dbPath, []api.Note{
+		{ID: "note-1", ItemID: "6X7rM8997g3RQmvh", Content: "Waiting for review", Posted: api.TodoistTime{Time: time.Date(2024, 11, 2, 10, 0, 0, 0, time.UTC)}},
+	})
+	insertTestRemindersIntoDB(t, setup.dbPath, []api.Reminder{
+		{ID: "reminder-1", ItemID: "6X7rM8997g3RQmvh", Type: api.ReminderTypeRelative, MinuteOffset: 30},
+	})
+
+	return setup
+}
+
+func TestExecuteTaskShowWithOutput_Success(t *testing.T) {
+	setup := setupTaskShowTest(t)
+	defer setup.cleanup()
+
+	params := &taskShowParams{taskRef: "6X7rM8997g3RQmvh"}
+
+	// Act: テスト対象を実行
+	err := setup.executor.executeTaskShowWithOutput(context.Background(), params)
+
+	// Assert: 結果を検証
+	require.NoError(t, err)
+
+	outputStr := setup.stdout.String()
+	for _, expected := range []string{
+		"Deploy API",
+		"Project: Work/Backend (proj-backend)",
+		"Labels: release",
+		"Due: 2024-12-02\n",
+		"Recurrence: every monday",
+		"Description: Roll out v2",
+		"Subtasks (1):",
+		"Write changelog (6X7rM8997g3RQmvi)",
+		"Comments (1):",
+		"Waiting for review",
+		"Reminders (1):",
+		"30m before due (reminder-1)",
+	} {
+		assert.Contains(t, outputStr, expected, "期待される出力が含まれていません: %s", expected)
+	}
+	assert.Equal(t, 1, strings.Count(outputStr, "every monday"), "繰り返しの指定が重複して表示されています")
+}
+
+func TestExecuteTaskShowWithOutput_JSON(t *testing.T) {
+	setup := setupTaskShowTest(t)
+	defer setup.cleanup()
+
+	params := &taskShowParams{taskRef: "6X7rM8997g3RQmvi", jsonOutput: true}
+
+	// Act: テスト対象を実行
+	err := setup.executor.executeTaskShowWithOutput(context.Background(), params)
+
+	// Assert: 結果を検証
+	require.NoError(t, err)
+
+	var detail taskDetail
+	require.NoError(t, json.Unmarshal(setup.stdout.Bytes(), &detail), "JSON出力のパースに失敗しました")
+	assert.Equal(t, "Write changelog", detail.Content)
+	assert.Equal(t, "Work/Backend", detail.ProjectPath)
+	require.NotNil(t, detail.Parent, "親タスクが含まれていません")
+	assert.Equal(t, "6X7rM8997g3RQmvh", detail.Parent.ID)
+}
+
+func TestExecuteTaskShowWithOutput_IDPrefix(t *testing.T) {
+	tests := []struct {
+		name        string
+		taskRef     string
+		expected    string
+		expectError bool
+		ambiguous   bool
+	}{
+		{
+			name:     "一意なプレフィックス",
+			taskRef:  "7A",
+			expected: "Other task",
+		},
+		{
+			name:      "曖昧なプレフィックス",
+			taskRef:   "6X7r",
+			ambiguous: true,
+		},
+		{
+			name:        "見つからない",
+			taskRef:     "zzz",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup := setupTaskShowTest(t)
+			defer setup.cleanup()
+
+			// Act: テスト対象を実行
+			err := setup.executor.executeTaskShowWithOutput(context.Background(), &taskShowParams{taskRef: tt.taskRef})
+
+			// Assert: 結果を検証
+			if tt.ambiguous {
+				var ambiguousErr *repository.AmbiguousReferenceError
+				require.ErrorAs(t, err, &ambiguousErr)
+				assert.Len(t, ambiguousErr.Candidates, 2, "候補数が期待値と異なります")
+				assert.Contains(t, err.Error(), "Deploy API")
+				return
+			}
+			if tt.expectError {
+				assert.ErrorIs(t, err, repository.ErrTaskNotFound)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, setup.stdout.String(), tt.expected)
+		})
+	}
+}
@@ -237,3 +237,35 @@ func TestFilterActiveTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		value     string
+		want      time.Time
+		wantError bool
+	}{
+		{name: "hours", value: "24h", want: now.Add(-24 * time.Hour)},
+		{name: "days", value: "7d", want: now.Add(-7 * 24 * time.Hour)},
+		{name: "weeks", value: "2w", want: now.Add(-14 * 24 * time.Hour)},
+		{name: "date", value: "2025-01-01", want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local)},
+		{name: "zero period", value: "0d", wantError: true},
+		{name: "unknown unit", value: "3m", wantError: true},
+		{name: "empty", value: "", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSince(tt.value, now)
+
+			if tt.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "開始日時が期待値と異なります: got %v, want %v", got, tt.want)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kyokomi/gotodoist/internal/factory"
 	"github.com/kyokomi/gotodoist/internal/repository"
 	"github.com/kyokomi/gotodoist/internal/storage"
+	"github.com/kyokomi/gotodoist/internal/sync"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,16 +33,26 @@ type testExecutorSetup struct {
 func setupTestExecutorBase(t *testing.T) *testExecutorSetup {
 	t.Helper()
 
+	mockClient := api.NewMockClient()
+	setup := setupTestExecutorBaseWithClient(t, mockClient, false)
+	setup.mockClient = mockClient
+	return setup
+}
+
+// setupTestExecutorBaseWithClient は任意のAPIクライアントでexecutorをセットアップする
+// initialSyncを指定した場合はRepositoryの初期化時にクライアントから初期同期する
+func setupTestExecutorBaseWithClient(t *testing.T, client api.Interface, initialSync bool) *testExecutorSetup {
+	t.Helper()
+
 	tempDir := t.TempDir()
 	dbPath := filepath.Join(tempDir, "test.db")
 
-	mockClient := api.NewMockClient()
-
 	cfg := &config.Config{
 		APIToken: "test-token",
 		LocalStorage: &repository.Config{
-			Enabled:      true,
-			DatabasePath: dbPath,
+			Enabled:            true,
+			DatabasePath:       dbPath,
+			InitialSyncOnStart: initialSync,
 		},
 	}
 
@@ -49,7 +60,7 @@ func setupTestExecutorBase(t *testing.T) *testExecutorSetup {
 	stderr := &bytes.Buffer{}
 	output := cli.NewWithWriters(stdout, stderr, false)
 
-	repo, err := factory.NewRepositoryForTest(mockClient, cfg.LocalStorage, false)
+	repo, err := factory.NewRepositoryForTest(client, cfg.LocalStorage, false)
 	require.NoError(t, err)
 
 	err = repo.Initialize(context.Background())
@@ -65,7 +76,6 @@ func setupTestExecutorBase(t *testing.T) *testExecutorSetup {
 		stdout:     stdout,
 		stderr:     stderr,
 		cleanup:    cleanup,
-		mockClient: mockClient,
 		dbPath:     dbPath,
 		output:     output,
 		repository: repo,
@@ -122,3 +132,144 @@ func insertTestTasksIntoDB(t *testing.T, dbPath string, tasks []api.Item) {
 		require.NoError(t, err)
 	}
 }
+
+// insertTestNotesIntoDB はテスト用のノートを直接DBに挿入するヘルパー関数
+func insertTestNotesIntoDB(t *testing.T, dbPath string, notes []api.Note) {
+	t.Helper()
+
+	// SQLiteDBを直接開く
+	db, err := storage.NewSQLiteDB(dbPath)
+	require.NoError(t, err)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Logf("failed to close db: %v", err)
+		}
+	}()
+
+	// IDが空の場合は自動採番
+	for i, note := range notes {
+		if note.ID == "" {
+			note.ID = fmt.Sprintf("note-%d", i+1)
+		}
+
+		// 直接DBにノートを挿入
+		err := db.InsertNote(note)
+		require.NoError(t, err)
+	}
+}
+
+// insertTestRemindersIntoDB はテスト用のリマインダーを直接DBに挿入するヘルパー関数
+func insertTestRemindersIntoDB(t *testing.T, dbPath string, reminders []api.Reminder) {
+	t.Helper()
+
+	// SQLiteDBを直接開く
+	db, err := storage.NewSQLiteDB(dbPath)
+	require.NoError(t, err)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Logf("failed to close db: %v", err)
+		}
+	}()
+
+	for _, reminder := range reminders {
+		err := db.InsertReminder(reminder)
+		require.NoError(t, err)
+	}
+}
+
+// insertTestFiltersIntoDB はテスト用の保存済みフィルタを直接DBに挿入するヘルパー関数
+func insertTestFiltersIntoDB(t *testing.T, dbPath string, filters []api.Filter) {
+	t.Helper()
+
+	// SQLiteDBを直接開く
+	db, err := storage.NewSQLiteDB(dbPath)
+	require.NoError(t, err)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Logf("failed to close db: %v", err)
+		}
+	}()
+
+	for _, filter := range filters {
+		err := db.InsertFilter(filter)
+		require.NoError(t, err)
+	}
+}
+
+// insertTestLabelsIntoDB はテスト用のラベルを直接DBに挿入するヘルパー関数
+func insertTestLabelsIntoDB(t *testing.T, dbPath string, labels []api.Label) {
+	t.Helper()
+
+	// SQLiteDBを直接開く
+	db, err := storage.NewSQLiteDB(dbPath)
+	require.NoError(t, err)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Logf("failed to close db: %v", err)
+		}
+	}()
+
+	for _, label := range labels {
+		require.NoError(t, db.InsertLabel(label))
+	}
+}
+
+// insertTestCollaboratorsIntoDB はテスト用の共同作業者・参加状態・ログイン中のユーザーを直接DBに挿入するヘルパー関数
+func insertTestCollaboratorsIntoDB(t *testing.T, dbPath string, list *api.CollaboratorList) {
+	t.Helper()
+
+	// SQLiteDBを直接開く
+	db, err := storage.NewSQLiteDB(dbPath)
+	require.NoError(t, err)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Logf("failed to close db: %v", err)
+		}
+	}()
+
+	for _, collaborator := range list.Collaborators {
+		require.NoError(t, db.InsertCollaborator(collaborator))
+	}
+	for _, state := range list.States {
+		require.NoError(t, db.InsertCollaboratorState(state))
+	}
+	if list.CurrentUser != nil {
+		require.NoError(t, db.SetCurrentUser(*list.CurrentUser))
+	}
+}
+
+// insertTestSectionsIntoDB はテスト用のセクションを直接DBに挿入するヘルパー関数
+func insertTestSectionsIntoDB(t *testing.T, dbPath string, sections []api.Section) {
+	t.Helper()
+
+	// SQLiteDBを直接開く
+	db, err := storage.NewSQLiteDB(dbPath)
+	require.NoError(t, err)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Logf("failed to close db: %v", err)
+		}
+	}()
+
+	for _, section := range sections {
+		err := db.InsertSection(section)
+		require.NoError(t, err)
+	}
+}
+
+// markTestInitialSyncDone は初期同期済みの状態（保存済みのsync_token付き）にするヘルパー関数
+func markTestInitialSyncDone(t *testing.T, dbPath, syncToken string) {
+	t.Helper()
+
+	db, err := storage.NewSQLiteDB(dbPath)
+	require.NoError(t, err)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Logf("failed to close db: %v", err)
+		}
+	}()
+
+	require.NoError(t, db.SetSyncToken(syncToken))
+	require.NoError(t, db.SetInitialSyncDone(true))
+	require.NoError(t, db.SetSyncedResourceTypes(sync.ResourceTypes()))
+}
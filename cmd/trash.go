@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/cli"
+	"github.com/kyokomi/gotodoist/internal/config"
+	"github.com/kyokomi/gotodoist/internal/factory"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+func init() {
+	// サブコマンドを追加
+	trashCmd.AddCommand(trashListCmd)
+	trashCmd.AddCommand(trashRestoreCmd)
+	trashCmd.AddCommand(trashPurgeCmd)
+
+	// trashコマンドをルートコマンドに追加
+	rootCmd.AddCommand(trashCmd)
+
+	// trash purge用のフラグ
+	trashPurgeCmd.Flags().BoolP("force", "f", false, "skip confirmation prompt")
+}
+
+// trashCmd はゴミ箱関連のコマンド
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "Manage deleted tasks and projects",
+	Long: `Manage tasks and projects deleted through gotodoist or by syncing.
+
+Deleted items are kept in the local database for the number of days set by
+local_storage.trash_retention_days (default: 30) and can be restored until then.
+This command requires local storage to be enabled.`,
+}
+
+// trashListCmd はゴミ箱の一覧表示コマンド
+var trashListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List deleted tasks and projects",
+	RunE:    runTrashList,
+}
+
+// trashRestoreCmd はゴミ箱からの復元コマンド
+var trashRestoreCmd = &cobra.Command{
+	Use:   "restore <id>",
+	Short: "Restore a deleted task or project",
+	Long: `Restore a deleted task or project by recreating it in Todoist.
+
+Projects are restored with their sub-projects, sections and tasks, and tasks are
+restored with their subtasks and labels. Restored items get new IDs.`,
+	Example: `  gotodoist trash restore 6Jf8VQXxpwv56VQ7
+  gotodoist trash restore 6Jf8          # ID prefix`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTrashRestore,
+}
+
+// trashPurgeCmd はゴミ箱を空にするコマンド
+var trashPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Permanently remove all deleted items from the local trash",
+	RunE:  runTrashPurge,
+}
+
+// trashListParams はゴミ箱一覧のパラメータ
+type trashListParams struct {
+	// 現在はパラメータなし
+}
+
+// runTrashList はゴミ箱一覧の実際の処理
+func runTrashList(_ *cobra.Command, _ []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTrashExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// 実行
+	return executor.executeTrashListWithOutput(ctx, &trashListParams{})
+}
+
+// trashRestoreParams はゴミ箱からの復元のパラメータ
+type trashRestoreParams struct {
+	id string
+}
+
+// runTrashRestore はゴミ箱からの復元の実際の処理
+func runTrashRestore(_ *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTrashExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	params := &trashRestoreParams{id: args[0]}
+	return executor.executeTrashRestoreWithOutput(ctx, params)
+}
+
+// trashPurgeParams はゴミ箱を空にするパラメータ
+type trashPurgeParams struct {
+	force bool
+}
+
+// runTrashPurge はゴミ箱を空にする実際の処理
+func runTrashPurge(cmd *cobra.Command, _ []string) error {
+	ctx := createBaseContext()
+
+	// セットアップ
+	executor, err := setupTrashExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// パラメータ取得と実行
+	force, _ := cmd.Flags().GetBool("force")
+	return executor.executeTrashPurgeWithOutput(ctx, &trashPurgeParams{force: force})
+}
+
+// executeTrashListWithOutput はゴミ箱一覧の取得と表示を実行する（テスト可能）
+func (e *trashExecutor) executeTrashListWithOutput(ctx context.Context, _ *trashListParams) error {
+	// 1. ゴミ箱の中身を取得
+	items, err := e.repository.ListTrash(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get trash: %w", err)
+	}
+
+	// 2. 結果表示
+	if len(items) == 0 {
+		e.output.Infof("Trash is empty")
+		return nil
+	}
+
+	projects, err := e.repository.GetAllProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	e.output.Listf("Trash (%d items):", len(items))
+	for _, item := range items {
+		e.output.Plainf("%s", formatTrashItem(item, projects))
+	}
+	e.output.Plainf("")
+	e.output.Infof("💡 Use 'gotodoist trash restore <id>' to restore an item")
+
+	return nil
+}
+
+// formatTrashItem はゴミ箱内のアイテムを1行で表示する形式に整形する
+func formatTrashItem(item repository.TrashItem, projects []api.Project) string {
+	deletedAt := item.DeletedAt.Format("2006-01-02 15:04")
+
+	if item.Kind == repository.TrashKindProject {
+		return fmt.Sprintf("  📁 %s (ID: %s) - %d task(s), deleted %s", item.Name, item.ID, item.TaskCount, deletedAt)
+	}
+
+	line := fmt.Sprintf("  📝 %s (ID: %s)", item.Name, item.ID)
+	if path := repository.ProjectPath(projects, item.ProjectID); path != "" {
+		line += fmt.Sprintf(" in %s", path)
+	}
+	if item.TaskCount > 0 {
+		line += fmt.Sprintf(" - %d subtask(s)", item.TaskCount)
+	}
+	return line + fmt.Sprintf(", deleted %s", deletedAt)
+}
+
+// executeTrashRestoreWithOutput はゴミ箱からの復元と結果表示を実行する（テスト可能）
+func (e *trashExecutor) executeTrashRestoreWithOutput(ctx context.Context, params *trashRestoreParams) error {
+	// 1. 復元実行
+	item, newID, err := e.repository.RestoreFromTrash(ctx, params.id)
+	if err != nil {
+		return fmt.Errorf("failed to restore from trash: %w", err)
+	}
+
+	// 2. 結果表示
+	e.output.Successf("Restored %s %q", item.Kind, item.Name)
+	if newID != "" {
+		e.output.Infof("New ID: %s", newID)
+	}
+
+	return nil
+}
+
+// executeTrashPurgeWithOutput はゴミ箱を空にして結果表示を実行する（テスト可能）
+func (e *trashExecutor) executeTrashPurgeWithOutput(ctx context.Context, params *trashPurgeParams) error {
+	// 1. 確認プロンプト（forceフラグが無い場合）
+	if !params.force {
+		e.output.PlainNoNewlinef("Permanently remove all items from the local trash? (y/N): ")
+		confirmation, err := readPromptLine()
+		if err != nil || (confirmation != "y" && confirmation != "Y") {
+			e.output.Errorf("Purge canceled")
+			return nil
+		}
+	}
+
+	// 2. 削除実行
+	purged, err := e.repository.PurgeTrash(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge trash: %w", err)
+	}
+
+	// 3. 結果表示
+	e.output.Successf("Purged %d item(s) from the trash", purged)
+
+	return nil
+}
+
+// trashExecutor はゴミ箱操作に必要な情報をまとめた構造体
+type trashExecutor struct {
+	cfg        *config.Config
+	repository *repository.Repository
+	output     *cli.Output
+}
+
+// setupTrashExecution はゴミ箱操作の実行環境をセットアップする
+func setupTrashExecution(ctx context.Context) (*trashExecutor, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	cfg.DryRun = IsDryRun()
+
+	output := newOutput(cfg.DryRun, os.Stdout, os.Stderr)
+
+	repo, err := factory.NewRepository(cfg, IsVerbose())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	// ローカルストレージが有効な場合のみ初期化
+	if cfg.LocalStorage.Enabled {
+		if err := repo.Initialize(ctx); err != nil {
+			if closeErr := repo.Close(); closeErr != nil {
+				output.Warningf("failed to close repository after initialization error: %v", closeErr)
+			}
+			return nil, fmt.Errorf("failed to initialize repository: %w", err)
+		}
+	}
+
+	return &trashExecutor{
+		cfg:        cfg,
+		repository: repo,
+		output:     output,
+	}, nil
+}
+
+// cleanup はRepositoryのリソースクリーンアップを行う
+func (e *trashExecutor) cleanup() {
+	if err := e.repository.Close(); err != nil {
+		e.output.Warningf("failed to close repository: %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTestTrashExecutors はテスト用のtaskExecutorとtrashExecutorをセットアップするヘルパー関数
+func setupTestTrashExecutors(t *testing.T) (*testExecutorSetup, *taskExecutor, *trashExecutor) {
+	t.Helper()
+
+	base := setupTestExecutorBase(t)
+	taskExec := &taskExecutor{cfg: base.cfg, repository: base.repository, output: base.output}
+	trashExec := &trashExecutor{cfg: base.cfg, repository: base.repository, output: base.output}
+	return base, taskExec, trashExec
+}
+
+func TestExecuteTrashRestoreWithOutput_TaskWithSubtasks(t *testing.T) {
+	// Arrange: サブタスクを持つタスクを削除しておく
+	setup, taskExec, trashExec := setupTestTrashExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "parent-1", ProjectID: "project-1", Content: "Plan trip", Labels: []string{"travel"}},
+		{ID: "child-1", ProjectID: "project-1", ParentID: "parent-1", Content: "Book hotel"},
+		{ID: "other-1", ProjectID: "project-1", Content: "Keep me"},
+	})
+	require.NoError(t, taskExec.executeTaskDeleteWithOutput(context.Background(), &taskDeleteParams{taskID: "parent-1", force: true}))
+	// 同期で削除が届いたサブタスクもゴミ箱に入る
+	require.NoError(t, taskExec.executeTaskDeleteWithOutput(context.Background(), &taskDeleteParams{taskID: "child-1", force: true}))
+
+	setup.stdout.Reset()
+	require.NoError(t, trashExec.executeTrashListWithOutput(context.Background(), &trashListParams{}))
+	listOutput := setup.stdout.String()
+	assert.Contains(t, listOutput, "Plan trip (ID: parent-1) in Work - 1 subtask(s)")
+	assert.NotContains(t, listOutput, "Book hotel", "サブタスクは親タスクと一緒に表示するべきです")
+	assert.NotContains(t, listOutput, "Keep me")
+
+	executed := captureCommands(setup.mockClient)
+
+	// Act: IDプレフィックスで復元
+	err := trashExec.executeTrashRestoreWithOutput(context.Background(), &trashRestoreParams{id: "parent"})
+
+	// Assert: サブタスクごと再作成され、ゴミ箱から取り除かれる
+	require.NoError(t, err)
+	require.Len(t, *executed, 2)
+	assert.Equal(t, "Plan trip", (*executed)[0].Args["content"])
+	assert.Equal(t, []string{"travel"}, (*executed)[0].Args["labels"])
+	assert.Equal(t, (*executed)[0].TempID, (*executed)[1].Args["parent_id"])
+	assert.Contains(t, setup.stdout.String(), `Restored task "Plan trip"`)
+	assert.Contains(t, setup.stdout.String(), "New ID: new-"+(*executed)[0].TempID)
+
+	setup.stdout.Reset()
+	require.NoError(t, trashExec.executeTrashListWithOutput(context.Background(), &trashListParams{}))
+	assert.Contains(t, setup.stdout.String(), "Trash is empty")
+}
+
+func TestExecuteTrashRestoreWithOutput_ProjectWithSections(t *testing.T) {
+	// Arrange: セクションとタスクを持つプロジェクトを削除しておく
+	setup, _, trashExec := setupTestTrashExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work", Color: "red"}})
+	insertTestSectionsIntoDB(t, setup.dbPath, []api.Section{{ID: "section-1", Name: "Doing", ProjectID: "project-1"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-1", SectionID: "section-1", Content: "Write report"},
+	})
+	_, err := setup.repository.DeleteProject(context.Background(), "project-1")
+	require.NoError(t, err)
+
+	executed := captureCommands(setup.mockClient)
+
+	// Act
+	err = trashExec.executeTrashRestoreWithOutput(context.Background(), &trashRestoreParams{id: "project-1"})
+
+	// Assert: プロジェクト・セクション・タスクの順に再作成される
+	require.NoError(t, err)
+	require.Len(t, *executed, 3)
+	project, section, task := (*executed)[0], (*executed)[1], (*executed)[2]
+	assert.Equal(t, api.CommandProjectAdd, project.Type)
+	assert.Equal(t, "red", project.Args["color"])
+	assert.Equal(t, api.CommandSectionAdd, section.Type)
+	assert.Equal(t, project.TempID, section.Args["project_id"])
+	assert.Equal(t, api.CommandItemAdd, task.Type)
+	assert.Equal(t, project.TempID, task.Args["project_id"])
+	assert.Equal(t, section.TempID, task.Args["section_id"])
+	assert.Contains(t, setup.stdout.String(), `Restored project "Work"`)
+}
+
+func TestExecuteTrashPurgeWithOutput(t *testing.T) {
+	// Arrange
+	setup, taskExec, trashExec := setupTestTrashExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{{ID: "task-1", ProjectID: "project-1", Content: "Old task"}})
+	require.NoError(t, taskExec.executeTaskDeleteWithOutput(context.Background(), &taskDeleteParams{taskID: "task-1", force: true}))
+
+	// Act
+	err := trashExec.executeTrashPurgeWithOutput(context.Background(), &trashPurgeParams{force: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, setup.stdout.String(), "Purged 1 item(s) from the trash")
+
+	_, _, err = setup.repository.RestoreFromTrash(context.Background(), "task-1")
+	assert.Error(t, err, "完全に削除したタスクは復元できないはずです")
+}
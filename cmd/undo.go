@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kyokomi/gotodoist/internal/cli"
+	"github.com/kyokomi/gotodoist/internal/config"
+	"github.com/kyokomi/gotodoist/internal/factory"
+	"github.com/kyokomi/gotodoist/internal/repository"
+)
+
+// defaultUndoListLimit は--list時に表示する操作数の既定値
+const defaultUndoListLimit = 10
+
+func init() {
+	// undoコマンドをルートコマンドに追加
+	rootCmd.AddCommand(undoCmd)
+
+	// undo用のフラグ
+	undoCmd.Flags().BoolP("list", "l", false, "list recent operations that can be undone")
+}
+
+// undoCmd は変更操作の取り消しコマンド
+var undoCmd = &cobra.Command{
+	Use:   "undo [n]",
+	Short: "Undo the last changes made with gotodoist",
+	Long: `Undo the last n changes (default: 1) made through gotodoist, newest first.
+
+Every change is recorded in a local journal together with the previous state:
+- Completed tasks are reopened (and reopened tasks are completed again)
+- Updated or moved tasks and projects are restored to their previous values
+- Deleted tasks and projects are recreated with their sections, subtasks and labels
+- Created tasks and projects are deleted
+- Added reminders are deleted and deleted reminders are recreated
+- Created saved filters are deleted, and updated or deleted ones are restored
+- Shared projects are unshared, and removed members are invited again
+
+Use --list to show the operations that can be undone.
+This command requires local storage to be enabled.`,
+	Example: `  gotodoist undo            # Undo the last change
+  gotodoist undo 3          # Undo the last 3 changes
+  gotodoist undo --list     # Show recent changes`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runUndo,
+}
+
+// undoParams はundoのパラメータ
+type undoParams struct {
+	count int
+	list  bool
+}
+
+// getUndoParams はundoのパラメータを取得する
+func getUndoParams(cmd *cobra.Command, args []string) (*undoParams, error) {
+	list, _ := cmd.Flags().GetBool("list")
+
+	params := &undoParams{count: 1, list: list}
+	if list {
+		params.count = defaultUndoListLimit
+	}
+	if len(args) > 0 {
+		count, err := strconv.Atoi(args[0])
+		if err != nil || count < 1 {
+			return nil, fmt.Errorf("invalid number of operations: %s", args[0])
+		}
+		params.count = count
+	}
+
+	return params, nil
+}
+
+// runUndo はundoの実際の処理
+func runUndo(cmd *cobra.Command, args []string) error {
+	ctx := createBaseContext()
+
+	// パラメータ取得
+	params, err := getUndoParams(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	// セットアップ
+	executor, err := setupUndoExecution(ctx)
+	if err != nil {
+		return err
+	}
+	defer executor.cleanup()
+
+	// 実行
+	if params.list {
+		return executor.executeUndoListWithOutput(ctx, params)
+	}
+	return executor.executeUndoWithOutput(ctx, params)
+}
+
+// executeUndoWithOutput は変更操作の取り消しと結果表示を実行する（テスト可能）
+func (e *undoExecutor) executeUndoWithOutput(ctx context.Context, params *undoParams) error {
+	// 1. 取り消し実行
+	undone, err := e.repository.Undo(ctx, params.count)
+
+	// 2. 結果表示（途中で失敗した場合も取り消せた操作は表示する）
+	for _, entry := range undone {
+		e.output.Successf("Undone: %s", entry.Description)
+	}
+
+	if errors.Is(err, repository.ErrNothingToUndo) {
+		e.output.Infof("Nothing to undo")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(undone) < params.count {
+		e.output.Infof("Only %d operation(s) could be undone", len(undone))
+	}
+	return nil
+}
+
+// executeUndoListWithOutput は取り消し可能な操作の一覧表示を実行する（テスト可能）
+func (e *undoExecutor) executeUndoListWithOutput(_ context.Context, params *undoParams) error {
+	// 1. 取り消し可能な操作を取得
+	entries, err := e.repository.ListUndoEntries(params.count)
+	if err != nil {
+		return fmt.Errorf("failed to get undo history: %w", err)
+	}
+
+	// 2. 結果表示
+	if len(entries) == 0 {
+		e.output.Infof("Nothing to undo")
+		return nil
+	}
+
+	e.output.Listf("Recent operations (newest first):")
+	for i, entry := range entries {
+		e.output.Plainf("%d. %s  (%s)", i+1, entry.Description, entry.CreatedAt.Format("2006-01-02 15:04"))
+	}
+	e.output.Plainf("")
+	e.output.Infof("💡 Use 'gotodoist undo [n]' to undo the last n operations")
+
+	return nil
+}
+
+// undoExecutor はundo実行に必要な情報をまとめた構造体
+type undoExecutor struct {
+	cfg        *config.Config
+	repository *repository.Repository
+	output     *cli.Output
+}
+
+// setupUndoExecution はundo実行環境をセットアップする
+func setupUndoExecution(ctx context.Context) (*undoExecutor, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	cfg.DryRun = IsDryRun()
+
+	output := newOutput(cfg.DryRun, os.Stdout, os.Stderr)
+
+	repo, err := factory.NewRepository(cfg, IsVerbose())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	// ローカルストレージが有効な場合のみ初期化
+	if cfg.LocalStorage.Enabled {
+		if err := repo.Initialize(ctx); err != nil {
+			if closeErr := repo.Close(); closeErr != nil {
+				output.Warningf("failed to close repository after initialization error: %v", closeErr)
+			}
+			return nil, fmt.Errorf("failed to initialize repository: %w", err)
+		}
+	}
+
+	return &undoExecutor{
+		cfg:        cfg,
+		repository: repo,
+		output:     output,
+	}, nil
+}
+
+// cleanup はRepositoryのリソースクリーンアップを行う
+func (e *undoExecutor) cleanup() {
+	if err := e.repository.Close(); err != nil {
+		e.output.Warningf("failed to close repository: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTestUndoExecutors はテスト用のtaskExecutorとundoExecutorをセットアップするヘルパー関数
+// 同じRepositoryを共有し、タスク操作の取り消しを検証できるようにする
+func setupTestUndoExecutors(t *testing.T) (*testExecutorSetup, *taskExecutor, *undoExecutor) {
+	t.Helper()
+
+	base := setupTestExecutorBase(t)
+	taskExec := &taskExecutor{cfg: base.cfg, repository: base.repository, output: base.output}
+	undoExec := &undoExecutor{cfg: base.cfg, repository: base.repository, output: base.output}
+	return base, taskExec, undoExec
+}
+
+// captureCommands は実行されたコマンドを記録し、全て成功扱いにするモックを設定する
+func captureCommands(mockClient *api.MockClient) *[]api.Command {
+	var executed []api.Command
+	mockClient.ExecuteCommandsFunc = func(_ context.Context, commands []api.Command) (*api.SyncResponse, error) {
+		executed = append(executed, commands...)
+		resp := &api.SyncResponse{
+			SyncToken:     "undo-token",
+			SyncStatus:    make(map[string]interface{}),
+			TempIDMapping: make(map[string]string),
+		}
+		for _, cmd := range commands {
+			resp.SyncStatus[cmd.UUID] = "ok"
+			if cmd.TempID != "" {
+				resp.TempIDMapping[cmd.TempID] = "new-" + cmd.TempID
+			}
+		}
+		return resp, nil
+	}
+	return &executed
+}
+
+func TestExecuteUndoWithOutput_CompletedTask(t *testing.T) {
+	// Arrange: タスクを完了しておく
+	setup, taskExec, undoExec := setupTestUndoExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{{ID: "task-1", ProjectID: "project-1", Content: "Buy milk"}})
+
+	require.NoError(t, taskExec.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-1"}))
+	executed := captureCommands(setup.mockClient)
+
+	// Act: 取り消しを実行
+	err := undoExec.executeUndoWithOutput(context.Background(), &undoParams{count: 1})
+
+	// Assert: タスクが未完了に戻される
+	require.NoError(t, err)
+	require.Len(t, *executed, 1)
+	assert.Equal(t, api.CommandItemUncomplete, (*executed)[0].Type)
+	assert.Equal(t, "task-1", (*executed)[0].Args["id"])
+	assert.Contains(t, setup.stdout.String(), `Undone: complete task "Buy milk"`)
+
+	// 取り消し済みの操作は再度取り消されない
+	setup.stdout.Reset()
+	require.NoError(t, undoExec.executeUndoWithOutput(context.Background(), &undoParams{count: 1}))
+	assert.Len(t, *executed, 1, "取り消し済みの操作が再実行されています")
+	assert.Contains(t, setup.stdout.String(), "Nothing to undo")
+}
+
+func TestExecuteUndoWithOutput_DeletedTaskWithSubtasks(t *testing.T) {
+	// Arrange: サブタスクとラベルを持つタスクを削除しておく
+	setup, taskExec, undoExec := setupTestUndoExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "parent-1", ProjectID: "project-1", Content: "Plan trip", Labels: []string{"travel"}, Priority: 4},
+		{ID: "child-1", ProjectID: "project-1", ParentID: "parent-1", Content: "Book hotel"},
+	})
+
+	require.NoError(t, taskExec.executeTaskDeleteWithOutput(context.Background(), &taskDeleteParams{taskID: "parent-1", force: true}))
+	executed := captureCommands(setup.mockClient)
+
+	// Act: 取り消しを実行
+	err := undoExec.executeUndoWithOutput(context.Background(), &undoParams{count: 1})
+
+	// Assert: 親タスクとサブタスクが再作成される
+	require.NoError(t, err)
+	require.Len(t, *executed, 2)
+
+	parent, child := (*executed)[0], (*executed)[1]
+	assert.Equal(t, api.CommandItemAdd, parent.Type)
+	assert.Equal(t, "Plan trip", parent.Args["content"])
+	assert.Equal(t, "project-1", parent.Args["project_id"])
+	assert.Equal(t, []string{"travel"}, parent.Args["labels"])
+	assert.Equal(t, 4, parent.Args["priority"])
+
+	assert.Equal(t, api.CommandItemAdd, child.Type)
+	assert.Equal(t, "Book hotel", child.Args["content"])
+	assert.Equal(t, parent.TempID, child.Args["parent_id"], "サブタスクが再作成した親タスクに紐付いていません")
+	assert.Contains(t, setup.stdout.String(), `Undone: delete task "Plan trip"`)
+}
+
+func TestExecuteUndoWithOutput_RemapsOnlyIDFields(t *testing.T) {
+	// Arrange: 削除するタスクのIDと同じ名前のタスクを変更してから、タスクを削除しておく
+	setup, taskExec, undoExec := setupTestUndoExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-1", Content: "Buy milk", Priority: 1},
+		{ID: "task-2", ProjectID: "project-1", Content: "task-1"},
+	})
+	setup.mockClient.UpdateTaskFunc = func(_ context.Context, _ string, _ *api.UpdateTaskRequest) (*api.SyncResponse, error) {
+		return &api.SyncResponse{SyncToken: "updated-token"}, nil
+	}
+
+	require.NoError(t, taskExec.executeTaskUpdateWithOutput(context.Background(), &taskUpdateParams{taskID: "task-1", priority: "4"}))
+	require.NoError(t, taskExec.executeTaskUpdateWithOutput(context.Background(), &taskUpdateParams{taskID: "task-2", content: "Renamed"}))
+	require.NoError(t, taskExec.executeTaskDeleteWithOutput(context.Background(), &taskDeleteParams{taskID: "task-1", force: true}))
+	executed := captureCommands(setup.mockClient)
+
+	// Act: 削除、名前の変更、優先度の変更の順に取り消す
+	err := undoExec.executeUndoWithOutput(context.Background(), &undoParams{count: 3})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, *executed, 3)
+
+	recreated, renamed, reprioritized := (*executed)[0], (*executed)[1], (*executed)[2]
+	assert.Equal(t, api.CommandItemAdd, recreated.Type)
+	newID := "new-" + recreated.TempID
+
+	// タスク名は削除したタスクのIDと同じでも書き換えられない
+	assert.Equal(t, api.CommandItemUpdate, renamed.Type)
+	assert.Equal(t, "task-2", renamed.Args["id"])
+	assert.Equal(t, "task-1", renamed.Args["content"], "タスク名がIDとして置き換えられています")
+
+	// ID項目は再作成後のIDに置き換えられる
+	assert.Equal(t, api.CommandItemUpdate, reprioritized.Type)
+	assert.Equal(t, newID, reprioritized.Args["id"], "再作成したタスクのIDに置き換えられていません")
+	assert.Equal(t, "Buy milk", reprioritized.Args["content"])
+}
+
+func TestExecuteUndoListWithOutput(t *testing.T) {
+	// Arrange: 2つの操作を記録しておく
+	setup, taskExec, undoExec := setupTestUndoExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-1", Content: "First"},
+		{ID: "task-2", ProjectID: "project-1", Content: "Second"},
+	})
+	require.NoError(t, taskExec.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-1"}))
+	require.NoError(t, taskExec.executeTaskCompleteWithOutput(context.Background(), &taskCompleteParams{taskID: "task-2"}))
+	setup.stdout.Reset()
+
+	// Act
+	err := undoExec.executeUndoListWithOutput(context.Background(), &undoParams{count: defaultUndoListLimit, list: true})
+
+	// Assert: 新しい順に表示される
+	require.NoError(t, err)
+	output := setup.stdout.String()
+	assert.Contains(t, output, `1. complete task "Second"`)
+	assert.Contains(t, output, `2. complete task "First"`)
+}
+
+func TestExecuteUndoWithOutput_Reminders(t *testing.T) {
+	// Arrange: リマインダーを追加し、既存のリマインダーを削除しておく
+	setup, taskExec, undoExec := setupTestUndoExecutors(t)
+	defer setup.cleanup()
+
+	insertTestProjectsIntoDB(t, setup.dbPath, []api.Project{{ID: "project-1", Name: "Work"}})
+	insertTestTasksIntoDB(t, setup.dbPath, []api.Item{
+		{ID: "task-1", ProjectID: "project-1", Content: "Team meeting", Due: &api.Due{Date: "2025-01-10T10:00:00"}},
+	})
+	insertTestRemindersIntoDB(t, setup.dbPath, []api.Reminder{
+		{ID: "reminder-1", ItemID: "task-1", Type: api.ReminderTypeAbsolute, Due: &api.Due{Date: "2025-01-10T09:00:00"}},
+	})
+	setup.mockClient.CreateReminderFunc = func(_ context.Context, _ *api.CreateReminderRequest) (*api.SyncResponse, error) {
+		return &api.SyncResponse{SyncToken: "command-token", TempIDMapping: map[string]string{"temp-1": "reminder-2"}}, nil
+	}
+
+	require.NoError(t, taskExec.executeReminderAddWithOutput(context.Background(), &reminderAddParams{taskRef: "task-1", when: "30m before due"}))
+	require.NoError(t, taskExec.executeReminderDeleteWithOutput(context.Background(), &reminderDeleteParams{reminderID: "reminder-1"}))
+	executed := captureCommands(setup.mockClient)
+	setup.stdout.Reset()
+
+	// Act
+	err := undoExec.executeUndoWithOutput(context.Background(), &undoParams{count: 2})
+
+	// Assert: 削除したリマインダーは再作成され、追加したリマインダーは削除される
+	require.NoError(t, err)
+	require.Len(t, *executed, 2)
+	recreated := (*executed)[0]
+	assert.Equal(t, api.CommandReminderAdd, recreated.Type)
+	assert.Equal(t, "task-1", recreated.Args["item_id"])
+	assert.Equal(t, api.ReminderTypeAbsolute, recreated.Args["type"])
+	assert.Equal(t, map[string]interface{}{"date": "2025-01-10T09:00:00"}, recreated.Args["due"])
+	assert.Equal(t, api.CommandReminderDelete, (*executed)[1].Type)
+	assert.Equal(t, "reminder-2", (*executed)[1].Args["id"])
+
+	output := setup.stdout.String()
+	assert.Contains(t, output, `Undone: delete reminder "Team meeting"`)
+	assert.Contains(t, output, `Undone: create reminder "Team meeting"`)
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaxCommandsPerSync は1回のSyncリクエストで送信するコマンド数の上限
+const MaxCommandsPerSync = 100
+
+// syncStatusOK はコマンドが成功した場合のsync_statusの値
+const syncStatusOK = "ok"
+
+// CommandError はSync APIのコマンド単位のエラーを表す
+type CommandError struct {
+	Code    int    `json:"error_code"`
+	Message string `json:"error"`
+}
+
+// Error はerrorインターフェースを実装する
+func (e *CommandError) Error() string {
+	return fmt.Sprintf("command failed (code %d): %s", e.Code, e.Message)
+}
+
+// ExecuteCommands は複数のコマンドをできるだけ少ないSyncリクエストで送信する
+// MaxCommandsPerSync件ごとに分割して送信し、sync_statusとtemp_id_mappingをまとめたレスポンスを返す
+// 前のリクエストで確定したtemp_idは実IDに置き換えて送信するため、分割をまたいだ参照もできる
+// 途中のリクエストが失敗した場合は、それまでの結果とエラーを返す（以降のコマンドは送信しない）
+func (c *Client) ExecuteCommands(ctx context.Context, commands []Command) (*SyncResponse, error) {
+	merged := &SyncResponse{
+		TempIDMapping: make(map[string]string),
+		SyncStatus:    make(map[string]interface{}),
+	}
+
+	for start := 0; start < len(commands); start += MaxCommandsPerSync {
+		end := min(start+MaxCommandsPerSync, len(commands))
+
+		resp, err := c.Sync(ctx, &SyncRequest{
+			SyncToken: "*",
+			Commands:  resolveTempIDs(commands[start:end], merged.TempIDMapping),
+		})
+		if err != nil {
+			return merged, err
+		}
+		mergeSyncResponse(merged, resp)
+	}
+
+	return merged, nil
+}
+
+// resolveTempIDs は確定済みのtemp_idを参照している引数を実IDに置き換えたコマンドを返す
+func resolveTempIDs(commands []Command, mapping map[string]string) []Command {
+	if len(mapping) == 0 {
+		return commands
+	}
+
+	resolved := make([]Command, len(commands))
+	for i, cmd := range commands {
+		args := make(map[string]interface{}, len(cmd.Args))
+		for key, value := range cmd.Args {
+			if ref, ok := value.(string); ok && key != "temp_id" {
+				if id, exists := mapping[ref]; exists {
+					value = id
+				}
+			}
+			args[key] = value
+		}
+		cmd.Args = args
+		resolved[i] = cmd
+	}
+	return resolved
+}
+
+// mergeSyncResponse はコマンド実行結果を集約する
+func mergeSyncResponse(merged, resp *SyncResponse) {
+	merged.SyncToken = resp.SyncToken
+	for tempID, id := range resp.TempIDMapping {
+		merged.TempIDMapping[tempID] = id
+	}
+	for uuid, status := range resp.SyncStatus {
+		merged.SyncStatus[uuid] = status
+	}
+}
+
+// CommandError は指定したコマンドUUIDの実行結果をエラーとして返す（成功時はnil）
+func (r *SyncResponse) CommandError(commandUUID string) error {
+	status, exists := r.SyncStatus[commandUUID]
+	if !exists {
+		return fmt.Errorf("no sync status returned for command %s", commandUUID)
+	}
+
+	switch v := status.(type) {
+	case string:
+		if v == syncStatusOK {
+			return nil
+		}
+		return &CommandError{Message: v}
+	case map[string]interface{}:
+		cmdErr := &CommandError{}
+		if code, ok := v["error_code"].(float64); ok {
+			cmdErr.Code = int(code)
+		}
+		if message, ok := v["error"].(string); ok {
+			cmdErr.Message = message
+		}
+		return cmdErr
+	default:
+		return fmt.Errorf("unexpected sync status for command %s: %v", commandUUID, status)
+	}
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ExecuteCommands_Batching(t *testing.T) {
+	var requestSizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SyncRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requestSizes = append(requestSizes, len(req.Commands))
+
+		status := make(map[string]interface{}, len(req.Commands))
+		for _, cmd := range req.Commands {
+			status[cmd.UUID] = "ok"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(SyncResponse{
+			SyncToken:  fmt.Sprintf("token-%d", len(requestSizes)),
+			SyncStatus: status,
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-token")
+	require.NoError(t, err)
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	commands := make([]Command, 0, 250)
+	for i := 0; i < 250; i++ {
+		commands = append(commands, NewCloseTaskCommand(fmt.Sprintf("task-%d", i)))
+	}
+
+	resp, err := client.ExecuteCommands(context.Background(), commands)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{100, 100, 50}, requestSizes, "コマンドが上限ごとに分割されていません")
+	assert.Equal(t, "token-3", resp.SyncToken, "最後のリクエストのsync_tokenが返されていません")
+	for _, cmd := range commands {
+		assert.NoError(t, resp.CommandError(cmd.UUID))
+	}
+}
+
+func TestClient_ExecuteCommands_ResolvesTempIDsAcrossRequests(t *testing.T) {
+	var parentArgs []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SyncRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		status := make(map[string]interface{}, len(req.Commands))
+		mapping := make(map[string]string)
+		for _, cmd := range req.Commands {
+			status[cmd.UUID] = "ok"
+			if cmd.TempID != "" {
+				mapping[cmd.TempID] = "real-" + cmd.TempID
+			}
+			if parentID, ok := cmd.Args["parent_id"]; ok {
+				parentArgs = append(parentArgs, parentID)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(SyncResponse{
+			SyncToken:     "token",
+			SyncStatus:    status,
+			TempIDMapping: mapping,
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-token")
+	require.NoError(t, err)
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	// 親タスクを1つ目のリクエスト、子タスクを2つ目のリクエストで送信させる
+	commands := make([]Command, 0, MaxCommandsPerSync+1)
+	parent, err := NewCreateTaskCommand(&CreateTaskRequest{Content: "parent"})
+	require.NoError(t, err)
+	commands = append(commands, parent)
+	for i := 0; i < MaxCommandsPerSync-1; i++ {
+		commands = append(commands, NewCloseTaskCommand(fmt.Sprintf("task-%d", i)))
+	}
+	child, err := NewCreateTaskCommand(&CreateTaskRequest{Content: "child", ParentID: parent.TempID})
+	require.NoError(t, err)
+	commands = append(commands, child)
+
+	_, err = client.ExecuteCommands(context.Background(), commands)
+
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"real-" + parent.TempID}, parentArgs, "別リクエストのtemp_idが実IDに置き換えられていません")
+	assert.Equal(t, parent.TempID, child.Args["parent_id"], "呼び出し元のコマンドが書き換えられています")
+}
+
+func TestSyncResponse_CommandError(t *testing.T) {
+	resp := &SyncResponse{
+		SyncStatus: map[string]interface{}{
+			"ok-uuid":    "ok",
+			"error-uuid": map[string]interface{}{"error_code": float64(22), "error": "Item not found"},
+		},
+	}
+
+	assert.NoError(t, resp.CommandError("ok-uuid"))
+
+	err := resp.CommandError("error-uuid")
+	var cmdErr *CommandError
+	require.ErrorAs(t, err, &cmdErr)
+	assert.Equal(t, 22, cmdErr.Code)
+	assert.Equal(t, "Item not found", cmdErr.Message)
+
+	assert.Error(t, resp.CommandError("missing-uuid"), "sync_statusが無いコマンドはエラーにするべきです")
+}
@@ -28,6 +28,9 @@ type Client struct {
 	httpClient *http.Client
 	token      string
 	userAgent  string
+
+	// dryRunWriter が設定されている場合、コマンドを含むSyncリクエストは送信せずJSONで出力する
+	dryRunWriter io.Writer
 }
 
 // NewClient は新しいAPIクライアントを作成する
@@ -66,6 +69,13 @@ func (c *Client) SetTimeout(timeout time.Duration) {
 	c.httpClient.Timeout = timeout
 }
 
+// SetDryRun はドライランモードを設定する
+// 有効な間は、コマンドを含むSyncリクエスト（変更操作）をHTTP送信せずにwへJSONで出力する
+// wにnilを指定すると無効になる
+func (c *Client) SetDryRun(w io.Writer) {
+	c.dryRunWriter = w
+}
+
 // newRequest は新しいHTTPリクエストを作成する
 func (c *Client) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	u := *c.baseURL
@@ -183,6 +193,11 @@ func (c *Client) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, err
 		}
 	}
 
+	// ドライラン時は変更操作を送信せず、送信予定のコマンドを出力する
+	if c.dryRunWriter != nil && len(req.Commands) > 0 {
+		return c.dryRunSync(req)
+	}
+
 	httpReq, err := c.newRequest(ctx, http.MethodPost, "/sync", req)
 	if err != nil {
 		return nil, err
@@ -195,3 +210,26 @@ func (c *Client) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, err
 
 	return &resp, nil
 }
+
+// dryRunSync は送信予定のコマンドをJSONで出力し、全コマンドが成功した場合のレスポンスを返す
+func (c *Client) dryRunSync(req *SyncRequest) (*SyncResponse, error) {
+	encoder := json.NewEncoder(c.dryRunWriter)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(map[string]interface{}{"commands": req.Commands}); err != nil {
+		return nil, fmt.Errorf("failed to write dry-run commands: %w", err)
+	}
+
+	resp := &SyncResponse{
+		SyncToken:     req.SyncToken,
+		TempIDMapping: make(map[string]string),
+		SyncStatus:    make(map[string]interface{}, len(req.Commands)),
+	}
+	for _, cmd := range req.Commands {
+		resp.SyncStatus[cmd.UUID] = syncStatusOK
+		if cmd.TempID != "" {
+			// 実IDは存在しないため、temp_idをそのままIDとして扱う
+			resp.TempIDMapping[cmd.TempID] = cmd.TempID
+		}
+	}
+	return resp, nil
+}
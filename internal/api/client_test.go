@@ -1,7 +1,9 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -260,3 +262,31 @@ func TestError_Methods(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_DryRun(t *testing.T) {
+	// ドライラン時は変更操作のHTTPリクエストを送信しない
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("dry-run should not send any mutating request")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-token")
+	require.NoError(t, err)
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	var out bytes.Buffer
+	client.SetDryRun(&out)
+
+	resp, err := client.CreateTask(context.Background(), &CreateTaskRequest{Content: "Dry run task"})
+
+	require.NoError(t, err)
+	var printed struct {
+		Commands []Command `json:"commands"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &printed), "出力がJSONではありません: %s", out.String())
+	require.Len(t, printed.Commands, 1)
+	assert.Equal(t, CommandItemAdd, printed.Commands[0].Type)
+	assert.Equal(t, "Dry run task", printed.Commands[0].Args["content"])
+	assert.NoError(t, resp.CommandError(printed.Commands[0].UUID), "ドライランのコマンドは成功扱いであるべきです")
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CollaboratorList は共同作業者・参加状態・ログイン中のユーザーをまとめたもの
+type CollaboratorList struct {
+	Collaborators []Collaborator
+	States        []CollaboratorState
+	CurrentUser   *User
+}
+
+// GetCollaborators は共同作業者とログイン中のユーザーのみを取得する
+func (c *Client) GetCollaborators(ctx context.Context, syncToken string) (*SyncResponse, error) {
+	req := &SyncRequest{
+		SyncToken:     syncToken,
+		ResourceTypes: []string{ResourceCollaborators, ResourceUser},
+	}
+	return c.Sync(ctx, req)
+}
+
+// GetAllCollaborators は全ての共同作業者と参加状態を取得する
+func (c *Client) GetAllCollaborators(ctx context.Context) (*CollaboratorList, error) {
+	resp, err := c.GetCollaborators(ctx, "*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collaborators: %w", err)
+	}
+
+	// 削除されていない参加状態のみを返す
+	list := &CollaboratorList{
+		Collaborators: resp.Collaborators,
+		CurrentUser:   resp.User,
+	}
+	for i := range resp.CollaboratorStates {
+		if !resp.CollaboratorStates[i].IsDeleted {
+			list.States = append(list.States, resp.CollaboratorStates[i])
+		}
+	}
+
+	return list, nil
+}
+
+// ShareProject はプロジェクトをメールアドレスで指定したユーザーに共有する
+// 相手が承諾するまでは招待中（invited）の参加状態になる
+func (c *Client) ShareProject(ctx context.Context, projectID, email string) (*SyncResponse, error) {
+	if err := validateShareProjectArgs(projectID, email); err != nil {
+		return nil, err
+	}
+
+	return c.executeCollaboratorCommand(ctx, NewShareProjectCommand(projectID, email))
+}
+
+// UnshareProject はプロジェクトからメールアドレスで指定した共同作業者を外す
+// 招待中のユーザーの場合は招待を取り消す
+func (c *Client) UnshareProject(ctx context.Context, projectID, email string) (*SyncResponse, error) {
+	if err := validateShareProjectArgs(projectID, email); err != nil {
+		return nil, err
+	}
+
+	return c.executeCollaboratorCommand(ctx, NewDeleteCollaboratorCommand(projectID, email))
+}
+
+// executeCollaboratorCommand は共有関連のコマンドを送信し、コマンド単位のエラーを返す
+// 招待できないメールアドレスや権限不足はsync_statusでのみ通知されるため確認する
+func (c *Client) executeCollaboratorCommand(ctx context.Context, command Command) (*SyncResponse, error) {
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{command},
+	}
+
+	resp, err := c.Sync(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.CommandError(command.UUID); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// NewShareProjectCommand はプロジェクト共有（share_project）コマンドを構築する
+func NewShareProjectCommand(projectID, email string) Command {
+	return Command{
+		Type: CommandShareProject,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"project_id": projectID,
+			"email":      email,
+		},
+	}
+}
+
+// NewDeleteCollaboratorCommand は共同作業者削除（delete_collaborator）コマンドを構築する
+func NewDeleteCollaboratorCommand(projectID, email string) Command {
+	return Command{
+		Type: CommandDeleteCollaborator,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"project_id": projectID,
+			"email":      email,
+		},
+	}
+}
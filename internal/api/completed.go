@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// 完了済みタスク取得APIの制約
+const (
+	// CompletedTasksPageLimit は1リクエストで取得する完了済みタスクの最大件数
+	CompletedTasksPageLimit = 200
+	// completedTasksMaxRange は1リクエストで指定できる期間の上限（APIの上限は約3か月）
+	completedTasksMaxRange = 89 * 24 * time.Hour
+)
+
+// CompletedTasksRequest は完了済みタスク取得のリクエスト
+type CompletedTasksRequest struct {
+	Since     time.Time // この日時以降に完了したタスクを取得する
+	Until     time.Time // この日時までに完了したタスクを取得する（ゼロ値の場合は現在時刻）
+	ProjectID string    // 指定した場合はプロジェクトで絞り込む
+}
+
+// completedTasksPage は完了済みタスク取得APIのレスポンス（1ページ分）
+type completedTasksPage struct {
+	Items      []Item  `json:"items"`
+	NextCursor *string `json:"next_cursor"`
+}
+
+// GetCompletedTasks は完了日時で範囲指定して完了済みタスクを取得する
+// Sync APIはアクティブなタスクしか返さないため、完了済みタスク専用のエンドポイントを使用する
+// 期間が上限を超える場合は分割し、各期間はカーソルで全ページを取得する
+func (c *Client) GetCompletedTasks(ctx context.Context, req *CompletedTasksRequest) ([]Item, error) {
+	if req == nil || req.Since.IsZero() {
+		return nil, fmt.Errorf("since is required")
+	}
+
+	until := req.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+
+	var items []Item
+	for start := req.Since; start.Before(until); start = start.Add(completedTasksMaxRange) {
+		end := start.Add(completedTasksMaxRange)
+		if end.After(until) {
+			end = until
+		}
+
+		pageItems, err := c.getCompletedTasksInRange(ctx, start, end, req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+	}
+
+	return items, nil
+}
+
+// getCompletedTasksInRange は指定期間内の完了済みタスクを全ページ取得する
+func (c *Client) getCompletedTasksInRange(ctx context.Context, since, until time.Time, projectID string) ([]Item, error) {
+	var items []Item
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("since", since.UTC().Format(time.RFC3339))
+		query.Set("until", until.UTC().Format(time.RFC3339))
+		query.Set("limit", strconv.Itoa(CompletedTasksPageLimit))
+		if projectID != "" {
+			query.Set("project_id", projectID)
+		}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		httpReq, err := c.newRequest(ctx, http.MethodGet, "/tasks/completed/by_completion_date", nil)
+		if err != nil {
+			return nil, err
+		}
+		httpReq.URL.RawQuery = query.Encode()
+
+		var page completedTasksPage
+		if err := c.do(httpReq, &page); err != nil {
+			return nil, fmt.Errorf("failed to get completed tasks: %w", err)
+		}
+		items = append(items, page.Items...)
+
+		if page.NextCursor == nil || *page.NextCursor == "" {
+			return items, nil
+		}
+		cursor = *page.NextCursor
+	}
+}
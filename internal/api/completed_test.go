@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetCompletedTasks_Pagination(t *testing.T) {
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tasks/completed/by_completion_date", r.URL.Path)
+		assert.Equal(t, "project-1", r.URL.Query().Get("project_id"))
+		assert.Equal(t, "2025-01-01T00:00:00Z", r.URL.Query().Get("since"))
+
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		page := map[string]interface{}{
+			"items":       []map[string]interface{}{{"id": "task-1", "content": "First", "completed_at": "2025-01-02T10:00:00Z"}},
+			"next_cursor": "page-2",
+		}
+		if cursor == "page-2" {
+			page = map[string]interface{}{
+				"items":       []map[string]interface{}{{"id": "task-2", "content": "Second", "completed_at": "2025-01-03T10:00:00Z"}},
+				"next_cursor": nil,
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-token")
+	require.NoError(t, err)
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	items, err := client.GetCompletedTasks(context.Background(), &CompletedTasksRequest{
+		Since:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until:     time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+		ProjectID: "project-1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "page-2"}, cursors, "次ページのカーソルが送信されていません")
+	require.Len(t, items, 2)
+	assert.Equal(t, "task-1", items[0].ID)
+	assert.Equal(t, "task-2", items[1].ID)
+	require.NotNil(t, items[1].DateCompleted)
+	assert.Equal(t, 3, items[1].DateCompleted.Day())
+}
+
+func TestClient_GetCompletedTasks_SplitsLongRange(t *testing.T) {
+	var ranges [][2]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, [2]string{r.URL.Query().Get("since"), r.URL.Query().Get("until")})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items": [], "next_cursor": null}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-token")
+	require.NoError(t, err)
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(120 * 24 * time.Hour)
+	_, err = client.GetCompletedTasks(context.Background(), &CompletedTasksRequest{Since: since, Until: until})
+
+	require.NoError(t, err)
+	require.Len(t, ranges, 2, "上限を超える期間が分割されていません")
+	assert.Equal(t, "2025-01-01T00:00:00Z", ranges[0][0])
+	assert.Equal(t, ranges[0][1], ranges[1][0], "分割した期間が連続していません")
+	assert.Equal(t, until.Format(time.RFC3339), ranges[1][1])
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CreateFilterRequest はフィルタ作成用のリクエスト構造体
+type CreateFilterRequest struct {
+	Name       string `json:"name"`
+	Query      string `json:"query"`
+	Color      string `json:"color,omitempty"`
+	IsFavorite bool   `json:"is_favorite,omitempty"`
+}
+
+// UpdateFilterRequest はフィルタ更新用のリクエスト構造体
+type UpdateFilterRequest struct {
+	Name  string `json:"name,omitempty"`
+	Query string `json:"query,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+// GetFilters はフィルタのみを取得する
+func (c *Client) GetFilters(ctx context.Context, syncToken string) (*SyncResponse, error) {
+	req := &SyncRequest{
+		SyncToken:     syncToken,
+		ResourceTypes: []string{ResourceFilters},
+	}
+	return c.Sync(ctx, req)
+}
+
+// GetAllFilters は全フィルタを取得する
+func (c *Client) GetAllFilters(ctx context.Context) ([]Filter, error) {
+	resp, err := c.GetFilters(ctx, "*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filters: %w", err)
+	}
+
+	// 削除されていないフィルタのみを返す
+	var activeFilters []Filter
+	for i := range resp.Filters {
+		if !resp.Filters[i].IsDeleted {
+			activeFilters = append(activeFilters, resp.Filters[i])
+		}
+	}
+
+	return activeFilters, nil
+}
+
+// CreateFilter はフィルタを作成する
+func (c *Client) CreateFilter(ctx context.Context, req *CreateFilterRequest) (*SyncResponse, error) {
+	cmd, err := NewCreateFilterCommand(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{cmd},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// UpdateFilter は既存のフィルタを更新する
+func (c *Client) UpdateFilter(ctx context.Context, filterID string, req *UpdateFilterRequest) (*SyncResponse, error) {
+	cmd, err := NewUpdateFilterCommand(filterID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{cmd},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// DeleteFilter はフィルタを削除する
+func (c *Client) DeleteFilter(ctx context.Context, filterID string) (*SyncResponse, error) {
+	if err := validateFilterID(filterID); err != nil {
+		return nil, err
+	}
+
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{NewDeleteFilterCommand(filterID)},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// NewCreateFilterCommand はフィルタ作成（filter_add）コマンドを構築する
+func NewCreateFilterCommand(req *CreateFilterRequest) (Command, error) {
+	if err := validateCreateFilterRequest(req); err != nil {
+		return Command{}, err
+	}
+
+	args := map[string]interface{}{
+		"name":  req.Name,
+		"query": req.Query,
+	}
+	if req.Color != "" {
+		args["color"] = req.Color
+	}
+	if req.IsFavorite {
+		args["is_favorite"] = req.IsFavorite
+	}
+
+	tempID := uuid.New().String()
+	args["temp_id"] = tempID
+
+	return Command{
+		Type:   CommandFilterAdd,
+		UUID:   uuid.New().String(),
+		TempID: tempID,
+		Args:   args,
+	}, nil
+}
+
+// NewUpdateFilterCommand はフィルタ更新（filter_update）コマンドを構築する
+func NewUpdateFilterCommand(filterID string, req *UpdateFilterRequest) (Command, error) {
+	if err := validateFilterID(filterID); err != nil {
+		return Command{}, err
+	}
+	if err := validateUpdateFilterRequest(req); err != nil {
+		return Command{}, err
+	}
+
+	args := map[string]interface{}{
+		"id": filterID,
+	}
+	if req.Name != "" {
+		args["name"] = req.Name
+	}
+	if req.Query != "" {
+		args["query"] = req.Query
+	}
+	if req.Color != "" {
+		args["color"] = req.Color
+	}
+
+	return Command{
+		Type: CommandFilterUpdate,
+		UUID: uuid.New().String(),
+		Args: args,
+	}, nil
+}
+
+// NewDeleteFilterCommand はフィルタ削除（filter_delete）コマンドを構築する
+func NewDeleteFilterCommand(filterID string) Command {
+	return Command{
+		Type: CommandFilterDelete,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"id": filterID,
+		},
+	}
+}
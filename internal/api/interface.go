@@ -10,6 +10,7 @@ import (
 type Interface interface {
 	// Sync API
 	Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error)
+	ExecuteCommands(ctx context.Context, commands []Command) (*SyncResponse, error)
 
 	// Project operations
 	CreateProject(ctx context.Context, req *CreateProjectRequest) (*SyncResponse, error)
@@ -17,6 +18,8 @@ type Interface interface {
 	DeleteProject(ctx context.Context, projectID string) (*SyncResponse, error)
 	ArchiveProject(ctx context.Context, projectID string) (*SyncResponse, error)
 	UnarchiveProject(ctx context.Context, projectID string) (*SyncResponse, error)
+	MoveProject(ctx context.Context, projectID, parentID string) (*SyncResponse, error)
+	ReorderProjects(ctx context.Context, orders []ProjectOrder) (*SyncResponse, error)
 	GetAllProjects(ctx context.Context) ([]Project, error)
 	GetProjects(ctx context.Context, syncToken string) (*SyncResponse, error)
 	GetFavoriteProjects(ctx context.Context) ([]Project, error)
@@ -27,6 +30,7 @@ type Interface interface {
 	UpdateTask(ctx context.Context, taskID string, req *UpdateTaskRequest) (*SyncResponse, error)
 	DeleteTask(ctx context.Context, taskID string) (*SyncResponse, error)
 	CloseTask(ctx context.Context, taskID string) (*SyncResponse, error)
+	CompleteTaskForever(ctx context.Context, taskID string) (*SyncResponse, error)
 	ReopenTask(ctx context.Context, taskID string) (*SyncResponse, error)
 	GetTasks(ctx context.Context) ([]Item, error)
 	GetTasksByProject(ctx context.Context, projectID string) ([]Item, error)
@@ -34,11 +38,32 @@ type Interface interface {
 	GetItems(ctx context.Context, syncToken string) (*SyncResponse, error)
 	CompleteItem(ctx context.Context, itemID string) (*SyncResponse, error)
 	DeleteItem(ctx context.Context, itemID string) (*SyncResponse, error)
+	GetCompletedTasks(ctx context.Context, req *CompletedTasksRequest) ([]Item, error)
 
 	// Section operations
 	GetSections(ctx context.Context, syncToken string) (*SyncResponse, error)
 	GetAllSections(ctx context.Context) ([]Section, error)
 
+	// Note operations
+	GetNotes(ctx context.Context, syncToken string) (*SyncResponse, error)
+	GetAllNotes(ctx context.Context) ([]Note, error)
+
+	// Reminder operations
+	GetAllReminders(ctx context.Context) ([]Reminder, error)
+	CreateReminder(ctx context.Context, req *CreateReminderRequest) (*SyncResponse, error)
+	DeleteReminder(ctx context.Context, reminderID string) (*SyncResponse, error)
+
+	// Filter operations
+	GetAllFilters(ctx context.Context) ([]Filter, error)
+	CreateFilter(ctx context.Context, req *CreateFilterRequest) (*SyncResponse, error)
+	UpdateFilter(ctx context.Context, filterID string, req *UpdateFilterRequest) (*SyncResponse, error)
+	DeleteFilter(ctx context.Context, filterID string) (*SyncResponse, error)
+
+	// Collaborator operations
+	GetAllCollaborators(ctx context.Context) (*CollaboratorList, error)
+	ShareProject(ctx context.Context, projectID, email string) (*SyncResponse, error)
+	UnshareProject(ctx context.Context, projectID, email string) (*SyncResponse, error)
+
 	// Utility methods
 	SetBaseURL(baseURL string) error
 	SetTimeout(timeout time.Duration)
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"github.com/google/uuid"
+)
+
+// CreateLabelRequest はパーソナルラベル作成用のリクエスト構造体
+type CreateLabelRequest struct {
+	Name       string `json:"name"`
+	Color      string `json:"color,omitempty"`
+	ItemOrder  int    `json:"item_order,omitempty"`
+	IsFavorite bool   `json:"is_favorite,omitempty"`
+}
+
+// NewCreateLabelCommand はパーソナルラベル作成（label_add）コマンドを構築する
+func NewCreateLabelCommand(req *CreateLabelRequest) (Command, error) {
+	if err := validateCreateLabelRequest(req); err != nil {
+		return Command{}, err
+	}
+
+	args := map[string]interface{}{
+		"name": req.Name,
+	}
+	if req.Color != "" {
+		args["color"] = req.Color
+	}
+	if req.ItemOrder > 0 {
+		args["item_order"] = req.ItemOrder
+	}
+	if req.IsFavorite {
+		args["is_favorite"] = req.IsFavorite
+	}
+
+	tempID := uuid.New().String()
+	args["temp_id"] = tempID
+
+	return Command{
+		Type:   CommandLabelAdd,
+		UUID:   uuid.New().String(),
+		TempID: tempID,
+		Args:   args,
+	}, nil
+}
@@ -10,39 +10,64 @@ import (
 type MockClient struct {
 	// 各メソッドに対応するカスタム関数
 	SyncFunc                func(ctx context.Context, req *SyncRequest) (*SyncResponse, error)
+	ExecuteCommandsFunc     func(ctx context.Context, commands []Command) (*SyncResponse, error)
 	CreateProjectFunc       func(ctx context.Context, req *CreateProjectRequest) (*SyncResponse, error)
 	UpdateProjectFunc       func(ctx context.Context, projectID string, req *UpdateProjectRequest) (*SyncResponse, error)
 	DeleteProjectFunc       func(ctx context.Context, projectID string) (*SyncResponse, error)
 	ArchiveProjectFunc      func(ctx context.Context, projectID string) (*SyncResponse, error)
 	UnarchiveProjectFunc    func(ctx context.Context, projectID string) (*SyncResponse, error)
+	MoveProjectFunc         func(ctx context.Context, projectID, parentID string) (*SyncResponse, error)
+	ReorderProjectsFunc     func(ctx context.Context, orders []ProjectOrder) (*SyncResponse, error)
 	GetAllProjectsFunc      func(ctx context.Context) ([]Project, error)
 	GetProjectsFunc         func(ctx context.Context, syncToken string) (*SyncResponse, error)
 	GetFavoriteProjectsFunc func(ctx context.Context) ([]Project, error)
 	GetSharedProjectsFunc   func(ctx context.Context) ([]Project, error)
 
-	CreateTaskFunc         func(ctx context.Context, req *CreateTaskRequest) (*SyncResponse, error)
-	UpdateTaskFunc         func(ctx context.Context, taskID string, req *UpdateTaskRequest) (*SyncResponse, error)
-	DeleteTaskFunc         func(ctx context.Context, taskID string) (*SyncResponse, error)
-	CloseTaskFunc          func(ctx context.Context, taskID string) (*SyncResponse, error)
-	ReopenTaskFunc         func(ctx context.Context, taskID string) (*SyncResponse, error)
-	GetTasksFunc           func(ctx context.Context) ([]Item, error)
-	GetTasksByProjectFunc  func(ctx context.Context, projectID string) ([]Item, error)
-	GetTasksByPriorityFunc func(ctx context.Context, priority Priority) ([]Item, error)
-	GetItemsFunc           func(ctx context.Context, syncToken string) (*SyncResponse, error)
-	CompleteItemFunc       func(ctx context.Context, itemID string) (*SyncResponse, error)
-	DeleteItemFunc         func(ctx context.Context, itemID string) (*SyncResponse, error)
+	CreateTaskFunc          func(ctx context.Context, req *CreateTaskRequest) (*SyncResponse, error)
+	UpdateTaskFunc          func(ctx context.Context, taskID string, req *UpdateTaskRequest) (*SyncResponse, error)
+	DeleteTaskFunc          func(ctx context.Context, taskID string) (*SyncResponse, error)
+	CloseTaskFunc           func(ctx context.Context, taskID string) (*SyncResponse, error)
+	CompleteTaskForeverFunc func(ctx context.Context, taskID string) (*SyncResponse, error)
+	ReopenTaskFunc          func(ctx context.Context, taskID string) (*SyncResponse, error)
+	GetTasksFunc            func(ctx context.Context) ([]Item, error)
+	GetTasksByProjectFunc   func(ctx context.Context, projectID string) ([]Item, error)
+	GetTasksByPriorityFunc  func(ctx context.Context, priority Priority) ([]Item, error)
+	GetItemsFunc            func(ctx context.Context, syncToken string) (*SyncResponse, error)
+	CompleteItemFunc        func(ctx context.Context, itemID string) (*SyncResponse, error)
+	DeleteItemFunc          func(ctx context.Context, itemID string) (*SyncResponse, error)
+	GetCompletedTasksFunc   func(ctx context.Context, req *CompletedTasksRequest) ([]Item, error)
 
 	GetSectionsFunc    func(ctx context.Context, syncToken string) (*SyncResponse, error)
 	GetAllSectionsFunc func(ctx context.Context) ([]Section, error)
 
+	GetNotesFunc    func(ctx context.Context, syncToken string) (*SyncResponse, error)
+	GetAllNotesFunc func(ctx context.Context) ([]Note, error)
+
+	GetAllRemindersFunc func(ctx context.Context) ([]Reminder, error)
+	CreateReminderFunc  func(ctx context.Context, req *CreateReminderRequest) (*SyncResponse, error)
+	DeleteReminderFunc  func(ctx context.Context, reminderID string) (*SyncResponse, error)
+
+	GetAllFiltersFunc func(ctx context.Context) ([]Filter, error)
+	CreateFilterFunc  func(ctx context.Context, req *CreateFilterRequest) (*SyncResponse, error)
+	UpdateFilterFunc  func(ctx context.Context, filterID string, req *UpdateFilterRequest) (*SyncResponse, error)
+	DeleteFilterFunc  func(ctx context.Context, filterID string) (*SyncResponse, error)
+
+	GetAllCollaboratorsFunc func(ctx context.Context) (*CollaboratorList, error)
+	ShareProjectFunc        func(ctx context.Context, projectID, email string) (*SyncResponse, error)
+	UnshareProjectFunc      func(ctx context.Context, projectID, email string) (*SyncResponse, error)
+
 	SetBaseURLFunc func(baseURL string) error
 	SetTimeoutFunc func(timeout time.Duration)
 
 	// デフォルトレスポンス (Funcが未設定の場合に使用)
-	DefaultSyncResponse *SyncResponse
-	DefaultProjects     []Project
-	DefaultSections     []Section
-	DefaultItems        []Item
+	DefaultSyncResponse  *SyncResponse
+	DefaultProjects      []Project
+	DefaultSections      []Section
+	DefaultItems         []Item
+	DefaultNotes         []Note
+	DefaultReminders     []Reminder
+	DefaultFilters       []Filter
+	DefaultCollaborators *CollaboratorList
 }
 
 // NewMockClient は新しいMockClientを作成する
@@ -51,9 +76,13 @@ func NewMockClient() *MockClient {
 		DefaultSyncResponse: &SyncResponse{
 			SyncToken: "mock-sync-token",
 		},
-		DefaultProjects: []Project{},
-		DefaultSections: []Section{},
-		DefaultItems:    []Item{},
+		DefaultProjects:      []Project{},
+		DefaultSections:      []Section{},
+		DefaultItems:         []Item{},
+		DefaultNotes:         []Note{},
+		DefaultReminders:     []Reminder{},
+		DefaultFilters:       []Filter{},
+		DefaultCollaborators: &CollaboratorList{},
 	}
 }
 
@@ -67,6 +96,21 @@ func (m *MockClient) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse,
 	return m.DefaultSyncResponse, nil
 }
 
+// ExecuteCommands は複数のコマンドを実行する
+func (m *MockClient) ExecuteCommands(ctx context.Context, commands []Command) (*SyncResponse, error) {
+	if m.ExecuteCommandsFunc != nil {
+		return m.ExecuteCommandsFunc(ctx, commands)
+	}
+
+	// デフォルトは全てのコマンドを成功扱いにする
+	resp := *m.DefaultSyncResponse
+	resp.SyncStatus = make(map[string]interface{}, len(commands))
+	for _, cmd := range commands {
+		resp.SyncStatus[cmd.UUID] = syncStatusOK
+	}
+	return &resp, nil
+}
+
 // CreateProject は新しいプロジェクトを作成する
 func (m *MockClient) CreateProject(ctx context.Context, req *CreateProjectRequest) (*SyncResponse, error) {
 	if m.CreateProjectFunc != nil {
@@ -104,6 +148,20 @@ func (m *MockClient) UnarchiveProject(ctx context.Context, projectID string) (*S
 	return m.DefaultSyncResponse, nil
 }
 
+func (m *MockClient) MoveProject(ctx context.Context, projectID, parentID string) (*SyncResponse, error) {
+	if m.MoveProjectFunc != nil {
+		return m.MoveProjectFunc(ctx, projectID, parentID)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
+func (m *MockClient) ReorderProjects(ctx context.Context, orders []ProjectOrder) (*SyncResponse, error) {
+	if m.ReorderProjectsFunc != nil {
+		return m.ReorderProjectsFunc(ctx, orders)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
 func (m *MockClient) GetAllProjects(ctx context.Context) ([]Project, error) {
 	if m.GetAllProjectsFunc != nil {
 		return m.GetAllProjectsFunc(ctx)
@@ -179,6 +237,13 @@ func (m *MockClient) CloseTask(ctx context.Context, taskID string) (*SyncRespons
 	return m.DefaultSyncResponse, nil
 }
 
+func (m *MockClient) CompleteTaskForever(ctx context.Context, taskID string) (*SyncResponse, error) {
+	if m.CompleteTaskForeverFunc != nil {
+		return m.CompleteTaskForeverFunc(ctx, taskID)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
 func (m *MockClient) ReopenTask(ctx context.Context, taskID string) (*SyncResponse, error) {
 	if m.ReopenTaskFunc != nil {
 		return m.ReopenTaskFunc(ctx, taskID)
@@ -246,6 +311,13 @@ func (m *MockClient) DeleteItem(ctx context.Context, itemID string) (*SyncRespon
 	return m.DefaultSyncResponse, nil
 }
 
+func (m *MockClient) GetCompletedTasks(ctx context.Context, req *CompletedTasksRequest) ([]Item, error) {
+	if m.GetCompletedTasksFunc != nil {
+		return m.GetCompletedTasksFunc(ctx, req)
+	}
+	return []Item{}, nil // デフォルトでは完了済みタスクなし
+}
+
 // Section operations
 func (m *MockClient) GetSections(ctx context.Context, syncToken string) (*SyncResponse, error) {
 	if m.GetSectionsFunc != nil {
@@ -263,6 +335,96 @@ func (m *MockClient) GetAllSections(ctx context.Context) ([]Section, error) {
 	return m.DefaultSections, nil
 }
 
+// Note operations
+func (m *MockClient) GetNotes(ctx context.Context, syncToken string) (*SyncResponse, error) {
+	if m.GetNotesFunc != nil {
+		return m.GetNotesFunc(ctx, syncToken)
+	}
+	resp := *m.DefaultSyncResponse
+	resp.Notes = m.DefaultNotes
+	return &resp, nil
+}
+
+func (m *MockClient) GetAllNotes(ctx context.Context) ([]Note, error) {
+	if m.GetAllNotesFunc != nil {
+		return m.GetAllNotesFunc(ctx)
+	}
+	return m.DefaultNotes, nil
+}
+
+// Reminder operations
+func (m *MockClient) GetAllReminders(ctx context.Context) ([]Reminder, error) {
+	if m.GetAllRemindersFunc != nil {
+		return m.GetAllRemindersFunc(ctx)
+	}
+	return m.DefaultReminders, nil
+}
+
+func (m *MockClient) CreateReminder(ctx context.Context, req *CreateReminderRequest) (*SyncResponse, error) {
+	if m.CreateReminderFunc != nil {
+		return m.CreateReminderFunc(ctx, req)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
+func (m *MockClient) DeleteReminder(ctx context.Context, reminderID string) (*SyncResponse, error) {
+	if m.DeleteReminderFunc != nil {
+		return m.DeleteReminderFunc(ctx, reminderID)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
+// Filter operations
+func (m *MockClient) GetAllFilters(ctx context.Context) ([]Filter, error) {
+	if m.GetAllFiltersFunc != nil {
+		return m.GetAllFiltersFunc(ctx)
+	}
+	return m.DefaultFilters, nil
+}
+
+func (m *MockClient) CreateFilter(ctx context.Context, req *CreateFilterRequest) (*SyncResponse, error) {
+	if m.CreateFilterFunc != nil {
+		return m.CreateFilterFunc(ctx, req)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
+func (m *MockClient) UpdateFilter(ctx context.Context, filterID string, req *UpdateFilterRequest) (*SyncResponse, error) {
+	if m.UpdateFilterFunc != nil {
+		return m.UpdateFilterFunc(ctx, filterID, req)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
+func (m *MockClient) DeleteFilter(ctx context.Context, filterID string) (*SyncResponse, error) {
+	if m.DeleteFilterFunc != nil {
+		return m.DeleteFilterFunc(ctx, filterID)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
+// Collaborator operations
+func (m *MockClient) GetAllCollaborators(ctx context.Context) (*CollaboratorList, error) {
+	if m.GetAllCollaboratorsFunc != nil {
+		return m.GetAllCollaboratorsFunc(ctx)
+	}
+	return m.DefaultCollaborators, nil
+}
+
+func (m *MockClient) ShareProject(ctx context.Context, projectID, email string) (*SyncResponse, error) {
+	if m.ShareProjectFunc != nil {
+		return m.ShareProjectFunc(ctx, projectID, email)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
+func (m *MockClient) UnshareProject(ctx context.Context, projectID, email string) (*SyncResponse, error) {
+	if m.UnshareProjectFunc != nil {
+		return m.UnshareProjectFunc(ctx, projectID, email)
+	}
+	return m.DefaultSyncResponse, nil
+}
+
 // Utility methods
 func (m *MockClient) SetBaseURL(baseURL string) error {
 	if m.SetBaseURLFunc != nil {
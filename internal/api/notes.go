@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CreateNoteRequest はノート（コメント）作成用のリクエスト構造体
+type CreateNoteRequest struct {
+	ItemID  string `json:"item_id"`
+	Content string `json:"content"`
+}
+
+// GetNotes はノート（コメント）のみを取得する
+func (c *Client) GetNotes(ctx context.Context, syncToken string) (*SyncResponse, error) {
+	req := &SyncRequest{
+		SyncToken:     syncToken,
+		ResourceTypes: []string{ResourceNotes},
+	}
+	return c.Sync(ctx, req)
+}
+
+// GetAllNotes は全ノート（コメント）を取得する
+func (c *Client) GetAllNotes(ctx context.Context) ([]Note, error) {
+	resp, err := c.GetNotes(ctx, "*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notes: %w", err)
+	}
+
+	// 削除されていないノートのみを返す
+	var activeNotes []Note
+	for i := range resp.Notes {
+		if !resp.Notes[i].IsDeleted {
+			activeNotes = append(activeNotes, resp.Notes[i])
+		}
+	}
+
+	return activeNotes, nil
+}
+
+// NewCreateNoteCommand はタスクへのノート作成（note_add）コマンドを構築する
+func NewCreateNoteCommand(req *CreateNoteRequest) (Command, error) {
+	if err := validateCreateNoteRequest(req); err != nil {
+		return Command{}, err
+	}
+
+	tempID := uuid.New().String()
+	return Command{
+		Type:   CommandNoteAdd,
+		UUID:   uuid.New().String(),
+		TempID: tempID,
+		Args: map[string]interface{}{
+			"item_id": req.ItemID,
+			"content": req.Content,
+			"temp_id": tempID,
+		},
+	}, nil
+}
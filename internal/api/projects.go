@@ -24,10 +24,25 @@ type UpdateProjectRequest struct {
 
 // CreateProject は新しいプロジェクトを作成する
 func (c *Client) CreateProject(ctx context.Context, req *CreateProjectRequest) (*SyncResponse, error) {
-	if err := validateCreateProjectRequest(req); err != nil {
+	cmd, err := NewCreateProjectCommand(req)
+	if err != nil {
 		return nil, err
 	}
 
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{cmd},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// NewCreateProjectCommand はプロジェクト作成（project_add）コマンドを構築する
+func NewCreateProjectCommand(req *CreateProjectRequest) (Command, error) {
+	if err := validateCreateProjectRequest(req); err != nil {
+		return Command{}, err
+	}
+
 	args := map[string]interface{}{
 		"name": req.Name,
 	}
@@ -44,11 +59,20 @@ func (c *Client) CreateProject(ctx context.Context, req *CreateProjectRequest) (
 
 	tempID := uuid.New().String()
 	args["temp_id"] = tempID
-	cmd := Command{
+
+	return Command{
 		Type:   CommandProjectAdd,
 		UUID:   uuid.New().String(),
 		TempID: tempID,
 		Args:   args,
+	}, nil
+}
+
+// UpdateProject は既存のプロジェクトを更新する
+func (c *Client) UpdateProject(ctx context.Context, projectID string, req *UpdateProjectRequest) (*SyncResponse, error) {
+	cmd, err := NewUpdateProjectCommand(projectID, req)
+	if err != nil {
+		return nil, err
 	}
 
 	request := &SyncRequest{
@@ -59,13 +83,13 @@ func (c *Client) CreateProject(ctx context.Context, req *CreateProjectRequest) (
 	return c.Sync(ctx, request)
 }
 
-// UpdateProject は既存のプロジェクトを更新する
-func (c *Client) UpdateProject(ctx context.Context, projectID string, req *UpdateProjectRequest) (*SyncResponse, error) {
+// NewUpdateProjectCommand はプロジェクト更新（project_update）コマンドを構築する
+func NewUpdateProjectCommand(projectID string, req *UpdateProjectRequest) (Command, error) {
 	if err := validateProjectID(projectID); err != nil {
-		return nil, err
+		return Command{}, err
 	}
 	if err := validateUpdateProjectRequest(req); err != nil {
-		return nil, err
+		return Command{}, err
 	}
 
 	args := map[string]interface{}{
@@ -80,18 +104,11 @@ func (c *Client) UpdateProject(ctx context.Context, projectID string, req *Updat
 	}
 	args["is_favorite"] = req.IsFavorite
 
-	cmd := Command{
+	return Command{
 		Type: CommandProjectUpdate,
 		UUID: uuid.New().String(),
 		Args: args,
-	}
-
-	request := &SyncRequest{
-		SyncToken: "*",
-		Commands:  []Command{cmd},
-	}
-
-	return c.Sync(ctx, request)
+	}, nil
 }
 
 // GetProjects はプロジェクトのみを取得する
@@ -123,17 +140,9 @@ func (c *Client) GetAllProjects(ctx context.Context) ([]Project, error) {
 
 // DeleteProjectSync はプロジェクトを削除する（低レベルAPI）
 func (c *Client) DeleteProjectSync(ctx context.Context, projectID string) (*SyncResponse, error) {
-	cmd := Command{
-		Type: CommandProjectDelete,
-		UUID: uuid.New().String(),
-		Args: map[string]interface{}{
-			"id": projectID,
-		},
-	}
-
 	req := &SyncRequest{
 		SyncToken: "*",
-		Commands:  []Command{cmd},
+		Commands:  []Command{NewDeleteProjectCommand(projectID)},
 	}
 
 	return c.Sync(ctx, req)
@@ -150,17 +159,9 @@ func (c *Client) ArchiveProject(ctx context.Context, projectID string) (*SyncRes
 		return nil, err
 	}
 
-	cmd := Command{
-		Type: CommandProjectArchive,
-		UUID: uuid.New().String(),
-		Args: map[string]interface{}{
-			"id": projectID,
-		},
-	}
-
 	request := &SyncRequest{
 		SyncToken: "*",
-		Commands:  []Command{cmd},
+		Commands:  []Command{NewArchiveProjectCommand(projectID)},
 	}
 
 	return c.Sync(ctx, request)
@@ -172,13 +173,60 @@ func (c *Client) UnarchiveProject(ctx context.Context, projectID string) (*SyncR
 		return nil, err
 	}
 
-	cmd := Command{
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{NewUnarchiveProjectCommand(projectID)},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// NewDeleteProjectCommand はプロジェクト削除（project_delete）コマンドを構築する
+func NewDeleteProjectCommand(projectID string) Command {
+	return Command{
+		Type: CommandProjectDelete,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"id": projectID,
+		},
+	}
+}
+
+// NewArchiveProjectCommand はプロジェクトアーカイブ（project_archive）コマンドを構築する
+func NewArchiveProjectCommand(projectID string) Command {
+	return Command{
+		Type: CommandProjectArchive,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"id": projectID,
+		},
+	}
+}
+
+// NewUnarchiveProjectCommand はプロジェクトアーカイブ解除（project_unarchive）コマンドを構築する
+func NewUnarchiveProjectCommand(projectID string) Command {
+	return Command{
 		Type: CommandProjectUnarchive,
 		UUID: uuid.New().String(),
 		Args: map[string]interface{}{
 			"id": projectID,
 		},
 	}
+}
+
+// ProjectOrder はプロジェクトの並び順（project_reorder）の指定
+type ProjectOrder struct {
+	ID         string `json:"id"`
+	ChildOrder int    `json:"child_order"`
+}
+
+// MoveProject はプロジェクトを別の親プロジェクトの下に移動する
+// parentIDが空の場合はルートに移動する
+func (c *Client) MoveProject(ctx context.Context, projectID, parentID string) (*SyncResponse, error) {
+	cmd, err := NewMoveProjectCommand(projectID, parentID)
+	if err != nil {
+		return nil, err
+	}
 
 	request := &SyncRequest{
 		SyncToken: "*",
@@ -188,6 +236,69 @@ func (c *Client) UnarchiveProject(ctx context.Context, projectID string) (*SyncR
 	return c.Sync(ctx, request)
 }
 
+// ReorderProjects は同じ親を持つプロジェクトの並び順を変更する
+func (c *Client) ReorderProjects(ctx context.Context, orders []ProjectOrder) (*SyncResponse, error) {
+	cmd, err := NewReorderProjectsCommand(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{cmd},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// NewMoveProjectCommand はプロジェクト移動（project_move）コマンドを構築する
+// parentIDが空の場合はparent_idにnullを指定してルートに移動する
+func NewMoveProjectCommand(projectID, parentID string) (Command, error) {
+	if err := validateProjectID(projectID); err != nil {
+		return Command{}, err
+	}
+	if parentID == projectID {
+		return Command{}, fmt.Errorf("cannot move a project under itself")
+	}
+
+	var parent interface{}
+	if parentID != "" {
+		parent = parentID
+	}
+
+	return Command{
+		Type: CommandProjectMove,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"id":        projectID,
+			"parent_id": parent,
+		},
+	}, nil
+}
+
+// NewReorderProjectsCommand はプロジェクト並び替え（project_reorder）コマンドを構築する
+func NewReorderProjectsCommand(orders []ProjectOrder) (Command, error) {
+	if err := validateProjectOrders(orders); err != nil {
+		return Command{}, err
+	}
+
+	projects := make([]map[string]interface{}, 0, len(orders))
+	for _, order := range orders {
+		projects = append(projects, map[string]interface{}{
+			"id":          order.ID,
+			"child_order": order.ChildOrder,
+		})
+	}
+
+	return Command{
+		Type: CommandProjectReorder,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"projects": projects,
+		},
+	}, nil
+}
+
 // GetFavoriteProjects はお気に入りプロジェクトを取得する
 // NOTE: 現在のCLIでは未使用ですが、将来的にお気に入りプロジェクトの
 // フィルタリング機能を実装する際に使用するため残しています。
@@ -208,8 +319,6 @@ func (c *Client) GetFavoriteProjects(ctx context.Context) ([]Project, error) {
 }
 
 // GetSharedProjects は共有プロジェクトを取得する
-// NOTE: 現在のCLIでは未使用ですが、将来的に共有プロジェクトの
-// 管理機能を実装する際に使用するため残しています。
 func (c *Client) GetSharedProjects(ctx context.Context) ([]Project, error) {
 	projects, err := c.GetAllProjects(ctx)
 	if err != nil {
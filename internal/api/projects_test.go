@@ -0,0 +1,51 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMoveProjectCommand(t *testing.T) {
+	t.Run("親プロジェクトの下に移動", func(t *testing.T) {
+		cmd, err := NewMoveProjectCommand("p2", "p1")
+
+		require.NoError(t, err)
+		assert.Equal(t, CommandProjectMove, cmd.Type)
+		assert.Equal(t, "p2", cmd.Args["id"])
+		assert.Equal(t, "p1", cmd.Args["parent_id"])
+	})
+
+	t.Run("ルートへの移動はparent_idにnullを指定", func(t *testing.T) {
+		cmd, err := NewMoveProjectCommand("p2", "")
+
+		require.NoError(t, err)
+		value, exists := cmd.Args["parent_id"]
+		assert.True(t, exists, "parent_idを省略するとルートに移動しません")
+		assert.Nil(t, value)
+	})
+
+	t.Run("自分自身の下には移動できない", func(t *testing.T) {
+		_, err := NewMoveProjectCommand("p1", "p1")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestNewReorderProjectsCommand(t *testing.T) {
+	cmd, err := NewReorderProjectsCommand([]ProjectOrder{{ID: "p2", ChildOrder: 1}, {ID: "p1", ChildOrder: 2}})
+
+	require.NoError(t, err)
+	assert.Equal(t, CommandProjectReorder, cmd.Type)
+	assert.Equal(t, []map[string]interface{}{
+		{"id": "p2", "child_order": 1},
+		{"id": "p1", "child_order": 2},
+	}, cmd.Args["projects"])
+
+	_, err = NewReorderProjectsCommand(nil)
+	assert.Error(t, err)
+
+	_, err = NewReorderProjectsCommand([]ProjectOrder{{ID: "", ChildOrder: 1}})
+	assert.Error(t, err)
+}
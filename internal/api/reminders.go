@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CreateReminderRequest はリマインダー作成用のリクエスト構造体
+type CreateReminderRequest struct {
+	ItemID       string `json:"item_id"`
+	Type         string `json:"type"`                    // ReminderTypeRelative または ReminderTypeAbsolute
+	MinuteOffset int    `json:"minute_offset,omitempty"` // 相対指定: 期限の何分前に通知するか
+	DueDate      string `json:"-"`                       // 絶対指定: 通知日時（例: 2025-01-10T09:00:00）
+}
+
+// GetReminders はリマインダーのみを取得する
+func (c *Client) GetReminders(ctx context.Context, syncToken string) (*SyncResponse, error) {
+	req := &SyncRequest{
+		SyncToken:     syncToken,
+		ResourceTypes: []string{ResourceReminders},
+	}
+	return c.Sync(ctx, req)
+}
+
+// GetAllReminders は全リマインダーを取得する
+func (c *Client) GetAllReminders(ctx context.Context) ([]Reminder, error) {
+	resp, err := c.GetReminders(ctx, "*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+
+	// 削除されていないリマインダーのみを返す
+	var activeReminders []Reminder
+	for i := range resp.Reminders {
+		if !resp.Reminders[i].IsDeleted {
+			activeReminders = append(activeReminders, resp.Reminders[i])
+		}
+	}
+
+	return activeReminders, nil
+}
+
+// CreateReminder はリマインダーを作成する
+func (c *Client) CreateReminder(ctx context.Context, req *CreateReminderRequest) (*SyncResponse, error) {
+	cmd, err := NewCreateReminderCommand(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{cmd},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// DeleteReminder はリマインダーを削除する
+func (c *Client) DeleteReminder(ctx context.Context, reminderID string) (*SyncResponse, error) {
+	if err := validateReminderID(reminderID); err != nil {
+		return nil, err
+	}
+
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{NewDeleteReminderCommand(reminderID)},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// NewCreateReminderCommand はリマインダー作成（reminder_add）コマンドを構築する
+func NewCreateReminderCommand(req *CreateReminderRequest) (Command, error) {
+	if err := validateCreateReminderRequest(req); err != nil {
+		return Command{}, err
+	}
+
+	args := map[string]interface{}{
+		"item_id": req.ItemID,
+		"type":    req.Type,
+	}
+	if req.Type == ReminderTypeRelative {
+		args["minute_offset"] = req.MinuteOffset
+	} else {
+		args["due"] = map[string]interface{}{"date": req.DueDate}
+	}
+
+	tempID := uuid.New().String()
+	args["temp_id"] = tempID
+
+	return Command{
+		Type:   CommandReminderAdd,
+		UUID:   uuid.New().String(),
+		TempID: tempID,
+		Args:   args,
+	}, nil
+}
+
+// NewDeleteReminderCommand はリマインダー削除（reminder_delete）コマンドを構築する
+func NewDeleteReminderCommand(reminderID string) Command {
+	return Command{
+		Type: CommandReminderDelete,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"id": reminderID,
+		},
+	}
+}
@@ -3,8 +3,17 @@ package api
 import (
 	"context"
 	"fmt"
+
+	"github.com/google/uuid"
 )
 
+// CreateSectionRequest はセクション作成用のリクエスト構造体
+type CreateSectionRequest struct {
+	Name      string `json:"name"`
+	ProjectID string `json:"project_id"`
+	Order     int    `json:"section_order,omitempty"`
+}
+
 // GetSections はセクションのみを取得する
 func (c *Client) GetSections(ctx context.Context, syncToken string) (*SyncResponse, error) {
 	req := &SyncRequest{
@@ -22,3 +31,28 @@ func (c *Client) GetAllSections(ctx context.Context) ([]Section, error) {
 	}
 	return resp.Sections, nil
 }
+
+// NewCreateSectionCommand はセクション作成（section_add）コマンドを構築する
+func NewCreateSectionCommand(req *CreateSectionRequest) (Command, error) {
+	if err := validateCreateSectionRequest(req); err != nil {
+		return Command{}, err
+	}
+
+	args := map[string]interface{}{
+		"name":       req.Name,
+		"project_id": req.ProjectID,
+	}
+	if req.Order > 0 {
+		args["section_order"] = req.Order
+	}
+
+	tempID := uuid.New().String()
+	args["temp_id"] = tempID
+
+	return Command{
+		Type:   CommandSectionAdd,
+		UUID:   uuid.New().String(),
+		TempID: tempID,
+		Args:   args,
+	}, nil
+}
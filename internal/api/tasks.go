@@ -9,40 +9,59 @@ import (
 
 // CreateTaskRequest はタスク作成用のリクエスト構造体
 type CreateTaskRequest struct {
-	Content     string   `json:"content"`
-	Description string   `json:"description,omitempty"`
-	ProjectID   string   `json:"project_id,omitempty"`
-	SectionID   string   `json:"section_id,omitempty"`
-	ParentID    string   `json:"parent_id,omitempty"`
-	Order       int      `json:"order,omitempty"`
-	Labels      []string `json:"labels,omitempty"`
-	Priority    int      `json:"priority,omitempty"`
-	DueString   string   `json:"due_string,omitempty"`
-	DueDate     string   `json:"due_date,omitempty"`
-	DueDatetime string   `json:"due_datetime,omitempty"`
-	DueLang     string   `json:"due_lang,omitempty"`
-	AssigneeID  string   `json:"assignee_id,omitempty"`
+	Content      string    `json:"content"`
+	Description  string    `json:"description,omitempty"`
+	ProjectID    string    `json:"project_id,omitempty"`
+	SectionID    string    `json:"section_id,omitempty"`
+	ParentID     string    `json:"parent_id,omitempty"`
+	Order        int       `json:"order,omitempty"`
+	Labels       []string  `json:"labels,omitempty"`
+	Priority     int       `json:"priority,omitempty"`
+	DueString    string    `json:"due_string,omitempty"`
+	DueDate      string    `json:"due_date,omitempty"`
+	DueDatetime  string    `json:"due_datetime,omitempty"`
+	DueLang      string    `json:"due_lang,omitempty"`
+	Duration     *Duration `json:"duration,omitempty"`
+	DeadlineDate string    `json:"deadline_date,omitempty"`
+	AssigneeID   string    `json:"assignee_id,omitempty"`
 }
 
 // UpdateTaskRequest はタスク更新用のリクエスト構造体
 type UpdateTaskRequest struct {
-	Content     string   `json:"content,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Labels      []string `json:"labels,omitempty"`
-	Priority    int      `json:"priority,omitempty"`
-	DueString   string   `json:"due_string,omitempty"`
-	DueDate     string   `json:"due_date,omitempty"`
-	DueDatetime string   `json:"due_datetime,omitempty"`
-	DueLang     string   `json:"due_lang,omitempty"`
-	AssigneeID  string   `json:"assignee_id,omitempty"`
+	Content      string    `json:"content,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Labels       []string  `json:"labels,omitempty"`
+	Priority     int       `json:"priority,omitempty"`
+	DueString    string    `json:"due_string,omitempty"`
+	DueDate      string    `json:"due_date,omitempty"`
+	DueDatetime  string    `json:"due_datetime,omitempty"`
+	DueLang      string    `json:"due_lang,omitempty"`
+	Duration     *Duration `json:"duration,omitempty"`
+	DeadlineDate string    `json:"deadline_date,omitempty"`
+	AssigneeID   string    `json:"assignee_id,omitempty"`
 }
 
 // CreateTask は新しいタスクを作成する
 func (c *Client) CreateTask(ctx context.Context, req *CreateTaskRequest) (*SyncResponse, error) {
-	if err := validateCreateTaskRequest(req); err != nil {
+	cmd, err := NewCreateTaskCommand(req)
+	if err != nil {
 		return nil, err
 	}
 
+	request := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{cmd},
+	}
+
+	return c.Sync(ctx, request)
+}
+
+// NewCreateTaskCommand はタスク作成（item_add）コマンドを構築する
+func NewCreateTaskCommand(req *CreateTaskRequest) (Command, error) {
+	if err := validateCreateTaskRequest(req); err != nil {
+		return Command{}, err
+	}
+
 	args := map[string]interface{}{
 		"content": req.Content,
 	}
@@ -81,8 +100,20 @@ func (c *Client) CreateTask(ctx context.Context, req *CreateTaskRequest) (*SyncR
 			"date": req.DueDate,
 		}
 	case req.DueDatetime != "":
+		// Sync APIでは時刻付きの期限もdateに指定する（floating・UTCどちらも可）
 		args["due"] = map[string]interface{}{
-			"datetime": req.DueDatetime,
+			"date": req.DueDatetime,
+		}
+	}
+	if req.Duration != nil {
+		args["duration"] = map[string]interface{}{
+			"amount": req.Duration.Amount,
+			"unit":   req.Duration.Unit,
+		}
+	}
+	if req.DeadlineDate != "" {
+		args["deadline"] = map[string]interface{}{
+			"date": req.DeadlineDate,
 		}
 	}
 	if req.AssigneeID != "" {
@@ -99,6 +130,16 @@ func (c *Client) CreateTask(ctx context.Context, req *CreateTaskRequest) (*SyncR
 		Args:   args,
 	}
 
+	return cmd, nil
+}
+
+// UpdateTask は既存のタスクを更新する
+func (c *Client) UpdateTask(ctx context.Context, taskID string, req *UpdateTaskRequest) (*SyncResponse, error) {
+	cmd, err := NewUpdateTaskCommand(taskID, req)
+	if err != nil {
+		return nil, err
+	}
+
 	request := &SyncRequest{
 		SyncToken: "*",
 		Commands:  []Command{cmd},
@@ -107,13 +148,13 @@ func (c *Client) CreateTask(ctx context.Context, req *CreateTaskRequest) (*SyncR
 	return c.Sync(ctx, request)
 }
 
-// UpdateTask は既存のタスクを更新する
-func (c *Client) UpdateTask(ctx context.Context, taskID string, req *UpdateTaskRequest) (*SyncResponse, error) {
+// NewUpdateTaskCommand はタスク更新（item_update）コマンドを構築する
+func NewUpdateTaskCommand(taskID string, req *UpdateTaskRequest) (Command, error) {
 	if err := validateTaskID(taskID); err != nil {
-		return nil, err
+		return Command{}, err
 	}
 	if err := validateUpdateTaskRequest(req); err != nil {
-		return nil, err
+		return Command{}, err
 	}
 
 	args := map[string]interface{}{
@@ -145,8 +186,20 @@ func (c *Client) UpdateTask(ctx context.Context, taskID string, req *UpdateTaskR
 			"date": req.DueDate,
 		}
 	case req.DueDatetime != "":
+		// Sync APIでは時刻付きの期限もdateに指定する（floating・UTCどちらも可）
 		args["due"] = map[string]interface{}{
-			"datetime": req.DueDatetime,
+			"date": req.DueDatetime,
+		}
+	}
+	if req.Duration != nil {
+		args["duration"] = map[string]interface{}{
+			"amount": req.Duration.Amount,
+			"unit":   req.Duration.Unit,
+		}
+	}
+	if req.DeadlineDate != "" {
+		args["deadline"] = map[string]interface{}{
+			"date": req.DeadlineDate,
 		}
 	}
 	if req.AssigneeID != "" {
@@ -159,12 +212,7 @@ func (c *Client) UpdateTask(ctx context.Context, taskID string, req *UpdateTaskR
 		Args: args,
 	}
 
-	request := &SyncRequest{
-		SyncToken: "*",
-		Commands:  []Command{cmd},
-	}
-
-	return c.Sync(ctx, request)
+	return cmd, nil
 }
 
 // GetTasks は全てのタスクを取得する
@@ -207,19 +255,25 @@ func (c *Client) GetTasksByProject(ctx context.Context, projectID string) ([]Ite
 }
 
 // CloseTask はタスクを完了にする
+// 繰り返しタスクは完了にならず、次回の期限に再スケジュールされる
 func (c *Client) CloseTask(ctx context.Context, taskID string) (*SyncResponse, error) {
 	return c.CompleteItem(ctx, taskID)
 }
 
+// CompleteTaskForever はタスクを完了にする
+// 繰り返しタスクも再スケジュールせずに完了にする（繰り返しを終了する）
+func (c *Client) CompleteTaskForever(ctx context.Context, taskID string) (*SyncResponse, error) {
+	req := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{NewCompleteTaskCommand(taskID)},
+	}
+
+	return c.Sync(ctx, req)
+}
+
 // ReopenTask はタスクを未完了に戻す
 func (c *Client) ReopenTask(ctx context.Context, taskID string) (*SyncResponse, error) {
-	cmd := Command{
-		Type: CommandItemUncomplete,
-		UUID: uuid.New().String(),
-		Args: map[string]interface{}{
-			"id": taskID,
-		},
-	}
+	cmd := NewReopenTaskCommand(taskID)
 
 	req := &SyncRequest{
 		SyncToken: "*",
@@ -300,13 +354,7 @@ func (c *Client) GetItems(ctx context.Context, syncToken string) (*SyncResponse,
 
 // CompleteItem はタスクを完了にする
 func (c *Client) CompleteItem(ctx context.Context, itemID string) (*SyncResponse, error) {
-	cmd := Command{
-		Type: CommandItemComplete,
-		UUID: uuid.New().String(),
-		Args: map[string]interface{}{
-			"id": itemID,
-		},
-	}
+	cmd := NewCloseTaskCommand(itemID)
 
 	req := &SyncRequest{
 		SyncToken: "*",
@@ -318,18 +366,94 @@ func (c *Client) CompleteItem(ctx context.Context, itemID string) (*SyncResponse
 
 // DeleteItem はタスクを削除する
 func (c *Client) DeleteItem(ctx context.Context, itemID string) (*SyncResponse, error) {
-	cmd := Command{
+	cmd := NewDeleteTaskCommand(itemID)
+
+	req := &SyncRequest{
+		SyncToken: "*",
+		Commands:  []Command{cmd},
+	}
+
+	return c.Sync(ctx, req)
+}
+
+// MoveTaskRequest はタスク移動用のリクエスト構造体
+// ProjectID・SectionID・ParentIDのいずれか1つを指定する
+type MoveTaskRequest struct {
+	ProjectID string `json:"project_id,omitempty"`
+	SectionID string `json:"section_id,omitempty"`
+	ParentID  string `json:"parent_id,omitempty"`
+}
+
+// NewCloseTaskCommand はタスク完了（item_close）コマンドを構築する
+// 公式クライアントでの完了と同じく、繰り返しタスクは次回の期限に再スケジュールされる
+func NewCloseTaskCommand(taskID string) Command {
+	return Command{
+		Type: CommandItemClose,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"id": taskID,
+		},
+	}
+}
+
+// NewCompleteTaskCommand はタスク完了（item_complete）コマンドを構築する
+// 繰り返しタスクも再スケジュールせずに完了にする
+func NewCompleteTaskCommand(taskID string) Command {
+	return Command{
+		Type: CommandItemComplete,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"id": taskID,
+		},
+	}
+}
+
+// NewReopenTaskCommand はタスク未完了（item_uncomplete）コマンドを構築する
+func NewReopenTaskCommand(taskID string) Command {
+	return Command{
+		Type: CommandItemUncomplete,
+		UUID: uuid.New().String(),
+		Args: map[string]interface{}{
+			"id": taskID,
+		},
+	}
+}
+
+// NewDeleteTaskCommand はタスク削除（item_delete）コマンドを構築する
+func NewDeleteTaskCommand(taskID string) Command {
+	return Command{
 		Type: CommandItemDelete,
 		UUID: uuid.New().String(),
 		Args: map[string]interface{}{
-			"id": itemID,
+			"id": taskID,
 		},
 	}
+}
 
-	req := &SyncRequest{
-		SyncToken: "*",
-		Commands:  []Command{cmd},
+// NewMoveTaskCommand はタスク移動（item_move）コマンドを構築する
+func NewMoveTaskCommand(taskID string, req *MoveTaskRequest) (Command, error) {
+	if err := validateTaskID(taskID); err != nil {
+		return Command{}, err
+	}
+	if err := validateMoveTaskRequest(req); err != nil {
+		return Command{}, err
 	}
 
-	return c.Sync(ctx, req)
+	args := map[string]interface{}{
+		"id": taskID,
+	}
+	switch {
+	case req.SectionID != "":
+		args["section_id"] = req.SectionID
+	case req.ParentID != "":
+		args["parent_id"] = req.ParentID
+	default:
+		args["project_id"] = req.ProjectID
+	}
+
+	return Command{
+		Type: CommandItemMove,
+		UUID: uuid.New().String(),
+		Args: args,
+	}, nil
 }
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCreateTaskCommand_ScheduleArgs(t *testing.T) {
+	cmd, err := NewCreateTaskCommand(&CreateTaskRequest{
+		Content:      "Write report",
+		DueDatetime:  "2026-11-01T09:30:00",
+		Duration:     &Duration{Amount: 45, Unit: DurationUnitMinute},
+		DeadlineDate: "2026-11-03",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-01T09:30:00"}, cmd.Args["due"])
+	assert.Equal(t, map[string]interface{}{"amount": 45, "unit": DurationUnitMinute}, cmd.Args["duration"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-03"}, cmd.Args["deadline"])
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -14,15 +15,21 @@ type SyncRequest struct {
 
 // SyncResponse はSync APIのレスポンス構造体
 type SyncResponse struct {
-	SyncToken     string                 `json:"sync_token"`
-	FullSync      bool                   `json:"full_sync"`
-	Items         []Item                 `json:"items,omitempty"`
-	Projects      []Project              `json:"projects,omitempty"`
-	Sections      []Section              `json:"sections,omitempty"`
-	Labels        []Label                `json:"labels,omitempty"`
-	Notes         []Note                 `json:"notes,omitempty"`
-	TempIDMapping map[string]string      `json:"temp_id_mapping,omitempty"`
-	SyncStatus    map[string]interface{} `json:"sync_status,omitempty"`
+	SyncToken     string         `json:"sync_token"`
+	FullSync      bool           `json:"full_sync"`
+	Items         []Item         `json:"items,omitempty"`
+	Projects      []Project      `json:"projects,omitempty"`
+	Sections      []Section      `json:"sections,omitempty"`
+	Labels        []Label        `json:"labels,omitempty"`
+	Notes         []Note         `json:"notes,omitempty"`
+	Reminders     []Reminder     `json:"reminders,omitempty"`
+	Filters       []Filter       `json:"filters,omitempty"`
+	User          *User          `json:"user,omitempty"`
+	Collaborators []Collaborator `json:"collaborators,omitempty"`
+	// CollaboratorStates は共有プロジェクトごとの共同作業者の参加状態
+	CollaboratorStates []CollaboratorState    `json:"collaborator_states,omitempty"`
+	TempIDMapping      map[string]string      `json:"temp_id_mapping,omitempty"`
+	SyncStatus         map[string]interface{} `json:"sync_status,omitempty"`
 }
 
 // Command はSync APIのコマンド構造体
@@ -54,6 +61,8 @@ type Item struct {
 	IsDeleted      bool         `json:"is_deleted"`
 	SyncID         string       `json:"sync_id,omitempty"`
 	Due            *Due         `json:"due,omitempty"`
+	Duration       *Duration    `json:"duration,omitempty"`
+	Deadline       *Deadline    `json:"deadline,omitempty"`
 }
 
 // Due はタスクの期限を表す
@@ -65,6 +74,40 @@ type Due struct {
 	Timezone    string `json:"timezone,omitempty"`
 }
 
+// Duration はタスクの所要時間を表す
+type Duration struct {
+	Amount int    `json:"amount"`
+	Unit   string `json:"unit"` // DurationUnitMinute または DurationUnitDay
+}
+
+// 所要時間の単位
+const (
+	DurationUnitMinute = "minute"
+	DurationUnitDay    = "day"
+)
+
+// String は所要時間を短い表記（45m, 1h30m, 2d）で返す
+func (d *Duration) String() string {
+	if d.Unit == DurationUnitDay {
+		return fmt.Sprintf("%dd", d.Amount)
+	}
+	hours, minutes := d.Amount/60, d.Amount%60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	}
+}
+
+// Deadline はタスクの締め切りを表す（期限とは別に設定する日付）
+type Deadline struct {
+	Date string `json:"date"`
+	Lang string `json:"lang,omitempty"`
+}
+
 // Project はTodoistのプロジェクトを表す
 type Project struct {
 	ID           string `json:"id"`
@@ -115,10 +158,77 @@ type Note struct {
 	FileAttachment map[string]interface{} `json:"file_attachment,omitempty"`
 	UidsToNotify   []string               `json:"uids_to_notify,omitempty"`
 	IsDeleted      bool                   `json:"is_deleted"`
-	Posted         TodoistTime            `json:"posted"`
+	Posted         TodoistTime            `json:"posted_at"`
 	Reactions      map[string]interface{} `json:"reactions,omitempty"`
 }
 
+// Reminder はTodoistのリマインダーを表す
+type Reminder struct {
+	ID           string `json:"id"`
+	NotifyUID    string `json:"notify_uid,omitempty"`
+	ItemID       string `json:"item_id"`
+	Type         string `json:"type"`                    // ReminderTypeRelative または ReminderTypeAbsolute
+	Due          *Due   `json:"due,omitempty"`           // 絶対指定のリマインダーの日時
+	MinuteOffset int    `json:"minute_offset,omitempty"` // 相対指定のリマインダーの期限からの分数
+	IsDeleted    bool   `json:"is_deleted"`
+}
+
+// リマインダーの種類
+const (
+	ReminderTypeRelative = "relative" // タスクの期限の何分前か
+	ReminderTypeAbsolute = "absolute" // 日時指定
+)
+
+// Filter はTodoistの保存済みフィルタを表す
+type Filter struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Query      string `json:"query"` // フィルタクエリ（例: "today & #Work"）
+	Color      string `json:"color"`
+	ItemOrder  int    `json:"item_order"`
+	IsDeleted  bool   `json:"is_deleted"`
+	IsFavorite bool   `json:"is_favorite"`
+}
+
+// User はログイン中のユーザーを表す
+type User struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"full_name"`
+	Timezone string `json:"tz_info_timezone,omitempty"`
+}
+
+// Collaborator は共有プロジェクトの共同作業者を表す
+type Collaborator struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"full_name"`
+	Timezone string `json:"timezone,omitempty"`
+	ImageID  string `json:"image_id,omitempty"`
+}
+
+// DisplayName は共同作業者の表示名（名前が無い場合はメールアドレス）を返す
+func (c *Collaborator) DisplayName() string {
+	if c.FullName != "" {
+		return c.FullName
+	}
+	return c.Email
+}
+
+// CollaboratorState は共同作業者のプロジェクトへの参加状態を表す
+type CollaboratorState struct {
+	ProjectID string `json:"project_id"`
+	UserID    string `json:"user_id"`
+	State     string `json:"state"` // CollaboratorStateActive または CollaboratorStateInvited
+	IsDeleted bool   `json:"is_deleted"`
+}
+
+// 共同作業者の参加状態
+const (
+	CollaboratorStateActive  = "active"
+	CollaboratorStateInvited = "invited"
+)
+
 // ResourceTypes は同期するリソースタイプの定数
 const (
 	ResourceAll       = "all"
@@ -129,6 +239,9 @@ const (
 	ResourceNotes     = "notes"
 	ResourceFilters   = "filters"
 	ResourceReminders = "reminders"
+	ResourceUser      = "user"
+	// ResourceCollaborators は共同作業者（collaborators）と参加状態（collaborator_states）を返す
+	ResourceCollaborators = "collaborators"
 )
 
 // Command types for Sync API
@@ -137,6 +250,7 @@ const (
 	CommandItemUpdate     = "item_update"
 	CommandItemDelete     = "item_delete"
 	CommandItemComplete   = "item_complete"
+	CommandItemClose      = "item_close"
 	CommandItemUncomplete = "item_uncomplete"
 	CommandItemMove       = "item_move"
 
@@ -145,6 +259,8 @@ const (
 	CommandProjectDelete    = "project_delete"
 	CommandProjectArchive   = "project_archive"
 	CommandProjectUnarchive = "project_unarchive"
+	CommandProjectMove      = "project_move"
+	CommandProjectReorder   = "project_reorder"
 
 	// NOTE: 以下のセクション関連コマンドは現在のCLIでは未実装ですが、
 	// 将来的にセクション管理機能を追加する際に使用するため残しています。
@@ -165,6 +281,19 @@ const (
 	CommandNoteAdd    = "note_add"
 	CommandNoteUpdate = "note_update"
 	CommandNoteDelete = "note_delete"
+
+	// リマインダー関連コマンド
+	CommandReminderAdd    = "reminder_add"
+	CommandReminderDelete = "reminder_delete"
+
+	// フィルタ関連コマンド
+	CommandFilterAdd    = "filter_add"
+	CommandFilterUpdate = "filter_update"
+	CommandFilterDelete = "filter_delete"
+
+	// プロジェクト共有関連コマンド
+	CommandShareProject       = "share_project"
+	CommandDeleteCollaborator = "delete_collaborator"
 )
 
 // TodoistTime はTodoist APIの日時形式を扱うカスタム型
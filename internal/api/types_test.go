@@ -108,7 +108,9 @@ func TestItem_JSONUnmarshaling(t *testing.T) {
 			"lang": "en",
 			"is_recurring": false,
 			"timezone": "UTC"
-		}
+		},
+		"duration": {"amount": 45, "unit": "minute"},
+		"deadline": {"date": "2024-01-25", "lang": "en"}
 	}`
 
 	var item Item
@@ -162,6 +164,31 @@ func TestItem_JSONUnmarshaling(t *testing.T) {
 			t.Errorf("expected due string 'next Friday', got %s", item.Due.String)
 		}
 	}
+
+	if item.Duration == nil || item.Duration.Amount != 45 || item.Duration.Unit != DurationUnitMinute {
+		t.Errorf("expected duration 45 minute, got %+v", item.Duration)
+	}
+	if item.Deadline == nil || item.Deadline.Date != "2024-01-25" {
+		t.Errorf("expected deadline '2024-01-25', got %+v", item.Deadline)
+	}
+}
+
+func TestDuration_String(t *testing.T) {
+	tests := []struct {
+		duration Duration
+		want     string
+	}{
+		{Duration{Amount: 45, Unit: DurationUnitMinute}, "45m"},
+		{Duration{Amount: 120, Unit: DurationUnitMinute}, "2h"},
+		{Duration{Amount: 90, Unit: DurationUnitMinute}, "1h30m"},
+		{Duration{Amount: 2, Unit: DurationUnitDay}, "2d"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.duration.String(); got != tt.want {
+			t.Errorf("Duration%+v.String() = %q, want %q", tt.duration, got, tt.want)
+		}
+	}
 }
 
 func TestProject_JSONUnmarshaling(t *testing.T) {
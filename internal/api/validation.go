@@ -1,6 +1,11 @@
 package api
 
-import "fmt"
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+)
 
 // validateCreateProjectRequest はCreateProjectRequestの検証を行う
 func validateCreateProjectRequest(req *CreateProjectRequest) error {
@@ -29,6 +34,19 @@ func validateProjectID(projectID string) error {
 	return nil
 }
 
+// validateProjectOrders はプロジェクトの並び順の指定の検証を行う
+func validateProjectOrders(orders []ProjectOrder) error {
+	if len(orders) == 0 {
+		return fmt.Errorf("at least one project is required to reorder")
+	}
+	for _, order := range orders {
+		if err := validateProjectID(order.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // validateCreateTaskRequest はCreateTaskRequestの検証を行う
 func validateCreateTaskRequest(req *CreateTaskRequest) error {
 	if req == nil {
@@ -37,7 +55,7 @@ func validateCreateTaskRequest(req *CreateTaskRequest) error {
 	if req.Content == "" {
 		return fmt.Errorf("task content is required")
 	}
-	return nil
+	return validateTaskSchedule(req.DueDatetime, req.Duration, req.DeadlineDate)
 }
 
 // validateUpdateTaskRequest はUpdateTaskRequestの検証を行う
@@ -45,6 +63,175 @@ func validateUpdateTaskRequest(req *UpdateTaskRequest) error {
 	if req == nil {
 		return fmt.Errorf("update task request is required")
 	}
+	return validateTaskSchedule(req.DueDatetime, req.Duration, req.DeadlineDate)
+}
+
+// dueDatetimeLayouts は時刻付き期限として受け付ける形式（floating または UTC）
+var dueDatetimeLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04:05Z07:00"}
+
+// validateTaskSchedule はタスクの時刻付き期限・所要時間・締め切りの検証を行う
+func validateTaskSchedule(dueDatetime string, duration *Duration, deadlineDate string) error {
+	if dueDatetime != "" && !isValidDueDatetime(dueDatetime) {
+		return fmt.Errorf("invalid due datetime %q: must be YYYY-MM-DDTHH:MM:SS or RFC3339", dueDatetime)
+	}
+	if duration != nil {
+		if duration.Amount <= 0 {
+			return fmt.Errorf("duration amount must be positive: %d", duration.Amount)
+		}
+		if duration.Unit != DurationUnitMinute && duration.Unit != DurationUnitDay {
+			return fmt.Errorf("invalid duration unit %q: must be %s or %s", duration.Unit, DurationUnitMinute, DurationUnitDay)
+		}
+	}
+	if deadlineDate != "" {
+		if _, err := time.Parse("2006-01-02", deadlineDate); err != nil {
+			return fmt.Errorf("invalid deadline date %q: must be YYYY-MM-DD", deadlineDate)
+		}
+	}
+	return nil
+}
+
+// isValidDueDatetime は時刻付き期限の形式が正しいかを返す
+func isValidDueDatetime(value string) bool {
+	for _, layout := range dueDatetimeLayouts {
+		if _, err := time.Parse(layout, value); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateMoveTaskRequest はMoveTaskRequestの検証を行う
+func validateMoveTaskRequest(req *MoveTaskRequest) error {
+	if req == nil {
+		return fmt.Errorf("move task request is required")
+	}
+
+	destinations := 0
+	for _, id := range []string{req.ProjectID, req.SectionID, req.ParentID} {
+		if id != "" {
+			destinations++
+		}
+	}
+	if destinations != 1 {
+		return fmt.Errorf("exactly one of project, section or parent is required to move a task")
+	}
+	return nil
+}
+
+// validateCreateSectionRequest はCreateSectionRequestの検証を行う
+func validateCreateSectionRequest(req *CreateSectionRequest) error {
+	if req == nil {
+		return fmt.Errorf("create section request is required")
+	}
+	if req.Name == "" {
+		return fmt.Errorf("section name is required")
+	}
+	if req.ProjectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+	return nil
+}
+
+// validateCreateLabelRequest はCreateLabelRequestの検証を行う
+func validateCreateLabelRequest(req *CreateLabelRequest) error {
+	if req == nil {
+		return fmt.Errorf("create label request is required")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return fmt.Errorf("label name is required")
+	}
+	return nil
+}
+
+// validateCreateNoteRequest はCreateNoteRequestの検証を行う
+func validateCreateNoteRequest(req *CreateNoteRequest) error {
+	if req == nil {
+		return fmt.Errorf("create note request is required")
+	}
+	if req.ItemID == "" {
+		return fmt.Errorf("task ID is required")
+	}
+	if req.Content == "" {
+		return fmt.Errorf("note content is required")
+	}
+	return nil
+}
+
+// validateCreateReminderRequest はCreateReminderRequestの検証を行う
+func validateCreateReminderRequest(req *CreateReminderRequest) error {
+	if req == nil {
+		return fmt.Errorf("create reminder request is required")
+	}
+	if req.ItemID == "" {
+		return fmt.Errorf("task ID is required")
+	}
+	switch req.Type {
+	case ReminderTypeRelative:
+		if req.MinuteOffset < 0 {
+			return fmt.Errorf("minute offset must not be negative")
+		}
+	case ReminderTypeAbsolute:
+		if req.DueDate == "" {
+			return fmt.Errorf("reminder date is required")
+		}
+	default:
+		return fmt.Errorf("invalid reminder type: %s", req.Type)
+	}
+	return nil
+}
+
+// validateReminderID はリマインダーIDの検証を行う
+func validateReminderID(reminderID string) error {
+	if reminderID == "" {
+		return fmt.Errorf("reminder ID is required")
+	}
+	return nil
+}
+
+// validateCreateFilterRequest はCreateFilterRequestの検証を行う
+func validateCreateFilterRequest(req *CreateFilterRequest) error {
+	if req == nil {
+		return fmt.Errorf("create filter request is required")
+	}
+	if req.Name == "" {
+		return fmt.Errorf("filter name is required")
+	}
+	if req.Query == "" {
+		return fmt.Errorf("filter query is required")
+	}
+	return nil
+}
+
+// validateUpdateFilterRequest はUpdateFilterRequestの検証を行う
+func validateUpdateFilterRequest(req *UpdateFilterRequest) error {
+	if req == nil {
+		return fmt.Errorf("update filter request is required")
+	}
+	if req.Name == "" && req.Query == "" && req.Color == "" {
+		return fmt.Errorf("at least one field must be specified for update")
+	}
+	return nil
+}
+
+// validateFilterID はフィルタIDの検証を行う
+func validateFilterID(filterID string) error {
+	if filterID == "" {
+		return fmt.Errorf("filter ID is required")
+	}
+	return nil
+}
+
+// validateShareProjectArgs はプロジェクト共有・共有解除の引数の検証を行う
+func validateShareProjectArgs(projectID, email string) error {
+	if err := validateProjectID(projectID); err != nil {
+		return err
+	}
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+	if _, err := mail.ParseAddress(email); err != nil || strings.ContainsAny(email, "<> ") {
+		return fmt.Errorf("invalid email address: %s", email)
+	}
 	return nil
 }
 
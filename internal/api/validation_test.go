@@ -107,6 +107,57 @@ func TestValidateProjectID(t *testing.T) {
 	}
 }
 
+func TestValidateShareProjectArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		projectID string
+		email     string
+		wantErr   bool
+	}{
+		{
+			name:      "valid args",
+			projectID: "project-123",
+			email:     "alice@example.com",
+			wantErr:   false,
+		},
+		{
+			name:      "empty project ID",
+			projectID: "",
+			email:     "alice@example.com",
+			wantErr:   true,
+		},
+		{
+			name:      "empty email",
+			projectID: "project-123",
+			email:     "",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid email",
+			projectID: "project-123",
+			email:     "alice",
+			wantErr:   true,
+		},
+		{
+			name:      "email with display name",
+			projectID: "project-123",
+			email:     "Alice <alice@example.com>",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateShareProjectArgs(tt.projectID, tt.email)
+			if tt.wantErr {
+				assert.Error(t, err, "validateShareProjectArgsでエラーが期待されます")
+			} else {
+				assert.NoError(t, err, "validateShareProjectArgsでエラーが発生しました")
+			}
+		})
+	}
+}
+
 func TestValidateCreateTaskRequest(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -132,6 +183,40 @@ func TestValidateCreateTaskRequest(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid schedule",
+			req: &CreateTaskRequest{
+				Content:      "Test task",
+				DueDatetime:  "2026-11-01T09:30:00",
+				Duration:     &Duration{Amount: 45, Unit: DurationUnitMinute},
+				DeadlineDate: "2026-11-01",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid due datetime",
+			req: &CreateTaskRequest{
+				Content:     "Test task",
+				DueDatetime: "2026-11-01 09:30",
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero duration",
+			req: &CreateTaskRequest{
+				Content:  "Test task",
+				Duration: &Duration{Amount: 0, Unit: DurationUnitMinute},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid duration unit",
+			req: &CreateTaskRequest{
+				Content:  "Test task",
+				Duration: &Duration{Amount: 1, Unit: "hour"},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -164,6 +249,20 @@ func TestValidateUpdateTaskRequest(t *testing.T) {
 			req:     nil,
 			wantErr: true,
 		},
+		{
+			name: "UTC due datetime",
+			req: &UpdateTaskRequest{
+				DueDatetime: "2026-11-01T00:30:00Z",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid deadline",
+			req: &UpdateTaskRequest{
+				DeadlineDate: "2026/11/01",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -208,6 +307,81 @@ func TestValidateTaskID(t *testing.T) {
 	}
 }
 
+func TestValidateCreateLabelRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *CreateLabelRequest
+		wantErr bool
+	}{
+		{
+			name:    "valid request",
+			req:     &CreateLabelRequest{Name: "release", Color: "red"},
+			wantErr: false,
+		},
+		{
+			name:    "nil request",
+			req:     nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty name",
+			req:     &CreateLabelRequest{Name: " "},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCreateLabelRequest(tt.req)
+			if tt.wantErr {
+				assert.Error(t, err, "validateCreateLabelRequestでエラーが期待されます")
+			} else {
+				assert.NoError(t, err, "validateCreateLabelRequestでエラーが発生しました")
+			}
+		})
+	}
+}
+
+func TestValidateCreateNoteRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *CreateNoteRequest
+		wantErr bool
+	}{
+		{
+			name:    "valid request",
+			req:     &CreateNoteRequest{ItemID: "task-123", Content: "Check the logs"},
+			wantErr: false,
+		},
+		{
+			name:    "nil request",
+			req:     nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty task ID",
+			req:     &CreateNoteRequest{Content: "Check the logs"},
+			wantErr: true,
+		},
+		{
+			name:    "empty content",
+			req:     &CreateNoteRequest{ItemID: "task-123"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCreateNoteRequest(tt.req)
+			if tt.wantErr {
+				assert.Error(t, err, "validateCreateNoteRequestでエラーが期待されます")
+			} else {
+				assert.NoError(t, err, "validateCreateNoteRequestでエラーが発生しました")
+			}
+		})
+	}
+}
+
 func TestValidateAPIToken(t *testing.T) {
 	tests := []struct {
 		name    string
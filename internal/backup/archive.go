@@ -0,0 +1,96 @@
+// Package backup はローカルに同期したTodoistのデータのバックアップと、バックアップからの復元の計画を提供する
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+const (
+	// Format はバックアップファイルの種類を表す識別子
+	Format = "gotodoist-backup"
+	// Version はバックアップファイルの形式のバージョン（形式を変更したら上げる）
+	Version = 1
+)
+
+// Archive はバックアップファイルの内容
+type Archive struct {
+	Format    string        `json:"format"`
+	Version   int           `json:"version"`
+	CreatedAt time.Time     `json:"created_at"`
+	Projects  []api.Project `json:"projects"`
+	Sections  []api.Section `json:"sections"`
+	Tasks     []api.Item    `json:"tasks"`
+	Labels    []api.Label   `json:"labels"`
+	Notes     []api.Note    `json:"notes"`
+	Filters   []api.Filter  `json:"filters"`
+}
+
+// New は空のバックアップを作成する
+func New(createdAt time.Time) *Archive {
+	return &Archive{
+		Format:    Format,
+		Version:   Version,
+		CreatedAt: createdAt,
+	}
+}
+
+// Write はバックアップをJSONで書き出す
+func Write(w io.Writer, archive *Archive) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(archive)
+}
+
+// Read はバックアップを読み込む
+// 新しいバージョンのgotodoistで作成されたバックアップは読み込めない
+func Read(r io.Reader) (*Archive, error) {
+	var archive Archive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("invalid backup file: %w", err)
+	}
+	if archive.Format != Format {
+		return nil, fmt.Errorf("not a gotodoist backup file (format %q)", archive.Format)
+	}
+	if archive.Version < 1 || archive.Version > Version {
+		return nil, fmt.Errorf("unsupported backup version %d (supported: up to %d)", archive.Version, Version)
+	}
+	return &archive, nil
+}
+
+// IDMap は元のデータのID（キー）から作成したオブジェクトのIDへの対応
+// 復元や取り込みを繰り返しても同じオブジェクトを重複して作成しないために使う
+type IDMap map[string]string
+
+// ReadIDMap はIDの対応をファイルから読み込む（ファイルがない場合は空）
+func ReadIDMap(path string) (IDMap, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return IDMap{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids := IDMap{}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, fmt.Errorf("invalid ID map %s: %w", path, err)
+	}
+	return ids, nil
+}
+
+// WriteIDMap はIDの対応をファイルに書き出す
+func WriteIDMap(path string, ids IDMap) error {
+	data, err := json.MarshalIndent(ids, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
@@ -0,0 +1,185 @@
+package backup
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// testArchive はテスト用のバックアップ
+func testArchive() *Archive {
+	archive := New(time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC))
+	archive.Projects = []api.Project{
+		{ID: "p-inbox", Name: "Inbox", InboxProject: true},
+		{ID: "p-client", Name: "Client", ParentID: "p-work", ChildOrder: 1},
+		{ID: "p-work", Name: "Work", Color: "blue", ChildOrder: 2},
+		{ID: "p-old", Name: "Old", IsArchived: true},
+	}
+	archive.Sections = []api.Section{
+		{ID: "s-todo", Name: "Todo", ProjectID: "p-work", SectionOrder: 1},
+	}
+	archive.Tasks = []api.Item{
+		{ID: "t-sub", Content: "Write summary", ProjectID: "p-work", SectionID: "s-todo", ParentID: "t-report"},
+		{ID: "t-report", Content: "Weekly report", ProjectID: "p-work", SectionID: "s-todo", Labels: []string{"office"}},
+		{ID: "t-done", Content: "Done already", ProjectID: "p-work", DateCompleted: &api.TodoistTime{Time: time.Now()}},
+		{ID: "t-milk", Content: "Buy milk", ProjectID: "p-inbox", Labels: []string{"errand"}},
+		{ID: "t-call", Content: "Call client", ProjectID: "p-client"},
+	}
+	archive.Labels = []api.Label{
+		{ID: "l-office", Name: "office", Color: "red"},
+		{ID: "l-errand", Name: "Errand"},
+	}
+	archive.Notes = []api.Note{
+		{ID: "n-1", ItemID: "t-report", Content: "Use the template"},
+		{ID: "n-2", ProjectID: "p-work", Content: "Project comment"},
+	}
+	archive.Filters = []api.Filter{
+		{ID: "f-1", Name: "Urgent", Query: "p1"},
+	}
+	return archive
+}
+
+func TestWriteRead(t *testing.T) {
+	archive := testArchive()
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, archive))
+	got, err := Read(&buf)
+
+	require.NoError(t, err)
+	assert.Equal(t, Format, got.Format)
+	assert.Equal(t, Version, got.Version)
+	assert.True(t, archive.CreatedAt.Equal(got.CreatedAt))
+	assert.Equal(t, archive.Projects, got.Projects)
+	assert.Equal(t, archive.Tasks[0], got.Tasks[0])
+	assert.Equal(t, archive.Labels, got.Labels)
+	assert.Equal(t, archive.Filters, got.Filters)
+}
+
+func TestRead_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectError string
+	}{
+		{name: "JSONでない", input: "TYPE,CONTENT\n", expectError: "invalid backup file"},
+		{name: "別の形式", input: `{"format":"other","version":1}`, expectError: "not a gotodoist backup file"},
+		{name: "新しいバージョン", input: `{"format":"gotodoist-backup","version":99}`, expectError: "unsupported backup version 99"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.input))
+
+			assert.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestIDMap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backup.json.idmap.json")
+
+	// ファイルがない場合は空
+	ids, err := ReadIDMap(path)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	require.NoError(t, WriteIDMap(path, IDMap{"t-report": "new-1"}))
+	ids, err = ReadIDMap(path)
+	require.NoError(t, err)
+	assert.Equal(t, IDMap{"t-report": "new-1"}, ids)
+}
+
+func TestNewPlan(t *testing.T) {
+	// 現在はインボックス（IDは別）と「Buy milk」、ラベル「errand」のみが残っている
+	current := &Archive{
+		Projects: []api.Project{{ID: "p-inbox-now", Name: "Inbox", InboxProject: true}},
+		Tasks:    []api.Item{{ID: "t-milk", Content: "Buy milk", ProjectID: "p-inbox-now"}},
+		Labels:   []api.Label{{ID: "l-now", Name: "errand"}},
+	}
+
+	plan, err := NewPlan(testArchive(), current, IDMap{}, "")
+
+	require.NoError(t, err)
+	// 親プロジェクトが先、アーカイブ済みのプロジェクトは復元しない
+	assert.Equal(t, []string{"Work", "Client"}, projectNames(plan.Projects))
+	assert.Len(t, plan.Sections, 1)
+	// 親タスクが先、完了済みのタスクは復元しない
+	assert.Equal(t, []string{"Weekly report", "Write summary", "Call client"}, taskContents(plan.Tasks))
+	// ラベルは名前（大文字小文字を区別しない）で比較する
+	require.Len(t, plan.Labels, 1)
+	assert.Equal(t, "office", plan.Labels[0].Name)
+	// タスクのコメントのみ復元する
+	require.Len(t, plan.Notes, 1)
+	assert.Equal(t, "n-1", plan.Notes[0].ID)
+	assert.Len(t, plan.Filters, 1)
+	assert.Equal(t, 9, plan.Count())
+
+	// インボックスは現在のインボックスに対応させる
+	assert.Equal(t, "p-inbox-now", plan.Existing["p-inbox"])
+	assert.Equal(t, "t-milk", plan.Existing["t-milk"])
+}
+
+func TestNewPlan_IDMap(t *testing.T) {
+	// 前回の復元で作成したオブジェクトはIDの対応で存在を確認する
+	current := &Archive{
+		Projects: []api.Project{{ID: "p-inbox", Name: "Inbox", InboxProject: true}, {ID: "new-work", Name: "Work"}},
+		Sections: []api.Section{{ID: "new-todo", Name: "Todo", ProjectID: "new-work"}},
+		Tasks:    []api.Item{{ID: "new-report", Content: "Weekly report", ProjectID: "new-work"}},
+		Notes:    []api.Note{{ID: "new-note", ItemID: "new-report", Content: "Use the template"}},
+	}
+	ids := IDMap{"p-work": "new-work", "s-todo": "new-todo", "t-report": "new-report", "n-1": "new-note", "t-sub": "deleted-again"}
+
+	plan, err := NewPlan(testArchive(), current, ids, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Client"}, projectNames(plan.Projects))
+	assert.Equal(t, "new-work", plan.Existing["p-work"])
+	assert.Empty(t, plan.Sections)
+	// 作成後に削除されたタスクは再び復元する
+	assert.Equal(t, []string{"Write summary", "Buy milk", "Call client"}, taskContents(plan.Tasks))
+	assert.Equal(t, "new-report", plan.Existing["t-report"])
+	assert.Empty(t, plan.Notes)
+}
+
+func TestNewPlan_Project(t *testing.T) {
+	current := &Archive{
+		Projects: []api.Project{{ID: "p-inbox", Name: "Inbox", InboxProject: true}},
+	}
+
+	plan, err := NewPlan(testArchive(), current, IDMap{}, "p-client")
+
+	require.NoError(t, err)
+	// 親プロジェクトが復元対象外で存在しない場合は最上位に作成する
+	require.Len(t, plan.Projects, 1)
+	assert.Equal(t, "Client", plan.Projects[0].Name)
+	assert.Empty(t, plan.Projects[0].ParentID)
+	assert.Equal(t, []string{"Call client"}, taskContents(plan.Tasks))
+	assert.Empty(t, plan.Labels)
+	assert.Empty(t, plan.Filters)
+
+	_, err = NewPlan(testArchive(), current, IDMap{}, "p-missing")
+	assert.ErrorContains(t, err, "project not found in backup")
+}
+
+func projectNames(projects []api.Project) []string {
+	var names []string
+	for i := range projects {
+		names = append(names, projects[i].Name)
+	}
+	return names
+}
+
+func taskContents(tasks []api.Item) []string {
+	var contents []string
+	for i := range tasks {
+		contents = append(contents, tasks[i].Content)
+	}
+	return contents
+}
@@ -0,0 +1,298 @@
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// Plan はバックアップから復元する（現在のデータにない）オブジェクトの一覧
+// 各一覧は作成する順序（親が先）に並ぶ
+type Plan struct {
+	Projects []api.Project
+	Sections []api.Section
+	Tasks    []api.Item
+	Labels   []api.Label
+	Notes    []api.Note
+	Filters  []api.Filter
+
+	// Existing はバックアップ時のIDから、現在も存在するオブジェクトのIDへの対応
+	Existing map[string]string
+}
+
+// Count は復元するオブジェクトの総数を返す
+func (p *Plan) Count() int {
+	return len(p.Projects) + len(p.Sections) + len(p.Tasks) + len(p.Labels) + len(p.Notes) + len(p.Filters)
+}
+
+// FindProject はバックアップ内のプロジェクトをIDまたは名前（大文字小文字を区別しない）で検索する
+func (a *Archive) FindProject(nameOrID string) (*api.Project, error) {
+	for i := range a.Projects {
+		if a.Projects[i].ID == nameOrID {
+			return &a.Projects[i], nil
+		}
+	}
+	for i := range a.Projects {
+		if strings.EqualFold(a.Projects[i].Name, nameOrID) {
+			return &a.Projects[i], nil
+		}
+	}
+	return nil, fmt.Errorf("project not found in backup: %s", nameOrID)
+}
+
+// NewPlan はバックアップと現在のデータを比較して復元するオブジェクトを決める
+// 現在のデータにIDが残っているもの、または前回の復元で作成済み（idsに対応がある）のものは復元しない
+// ラベル・フィルタは名前が同じものがあれば復元しない
+// projectIDを指定した場合はそのプロジェクトとサブプロジェクト、そのタスクが使うラベルのみを復元する
+func NewPlan(archive, current *Archive, ids IDMap, projectID string) (*Plan, error) {
+	plan := &Plan{Existing: make(map[string]string)}
+
+	present := make(map[string]bool)
+	for i := range current.Projects {
+		present[current.Projects[i].ID] = true
+	}
+	for i := range current.Sections {
+		present[current.Sections[i].ID] = true
+	}
+	for i := range current.Tasks {
+		present[current.Tasks[i].ID] = true
+	}
+	for i := range current.Notes {
+		present[current.Notes[i].ID] = true
+	}
+	// existing はバックアップ時のIDに対応する現在のオブジェクトを記録する
+	existing := func(id string) bool {
+		if mapped := ids[id]; mapped != "" && present[mapped] {
+			plan.Existing[id] = mapped
+			return true
+		}
+		if present[id] {
+			plan.Existing[id] = id
+			return true
+		}
+		return false
+	}
+
+	// インボックスは作り直せないため、現在のインボックスに対応させる
+	currentInbox := ""
+	for i := range current.Projects {
+		if current.Projects[i].InboxProject {
+			currentInbox = current.Projects[i].ID
+		}
+	}
+
+	// 1. プロジェクト
+	scope, err := projectScope(archive, projectID)
+	if err != nil {
+		return nil, err
+	}
+	available := make(map[string]bool) // 復元後に存在するオブジェクト
+	for i := range archive.Projects {
+		project := archive.Projects[i]
+		if !scope[project.ID] || project.IsDeleted {
+			continue
+		}
+		if existing(project.ID) {
+			available[project.ID] = true
+			continue
+		}
+		if project.InboxProject && currentInbox != "" {
+			plan.Existing[project.ID] = currentInbox
+			available[project.ID] = true
+			continue
+		}
+		// アーカイブ済みのプロジェクトは復元しない
+		if project.IsArchived {
+			continue
+		}
+		plan.Projects = append(plan.Projects, project)
+		available[project.ID] = true
+	}
+	// 親が復元対象外で現在も存在しないプロジェクトは最上位のプロジェクトにする
+	for i := range plan.Projects {
+		if parentID := plan.Projects[i].ParentID; parentID != "" && !available[parentID] && !existing(parentID) {
+			plan.Projects[i].ParentID = ""
+		}
+	}
+	plan.Projects = sortProjectsParentFirst(plan.Projects)
+
+	// 2. セクション
+	for i := range archive.Sections {
+		section := archive.Sections[i]
+		if !available[section.ProjectID] || section.IsDeleted || section.DateArchived != nil {
+			continue
+		}
+		if !existing(section.ID) {
+			plan.Sections = append(plan.Sections, section)
+		}
+		available[section.ID] = true
+	}
+
+	// 3. 未完了のタスク（完了済みのタスクは復元しない）
+	usedLabels := make(map[string]bool)
+	for i := range archive.Tasks {
+		task := archive.Tasks[i]
+		if !available[task.ProjectID] || task.IsDeleted || task.DateCompleted != nil {
+			continue
+		}
+		if existing(task.ID) {
+			available[task.ID] = true
+			continue
+		}
+		// 復元しないセクションのタスクはセクションなしにする
+		if task.SectionID != "" && !available[task.SectionID] && !existing(task.SectionID) {
+			task.SectionID = ""
+		}
+		plan.Tasks = append(plan.Tasks, task)
+		available[task.ID] = true
+		for _, label := range task.Labels {
+			usedLabels[strings.ToLower(label)] = true
+		}
+	}
+	// 親が復元されず現在も存在しないサブタスクは最上位のタスクにする
+	for i := range plan.Tasks {
+		if parentID := plan.Tasks[i].ParentID; parentID != "" && !available[parentID] && !existing(parentID) {
+			plan.Tasks[i].ParentID = ""
+		}
+	}
+	plan.Tasks = sortTasksParentFirst(plan.Tasks)
+
+	// 4. コメント（タスクのコメントのみ）
+	for i := range archive.Notes {
+		note := archive.Notes[i]
+		if note.IsDeleted || note.ItemID == "" || !available[note.ItemID] || note.Content == "" {
+			continue
+		}
+		if !existing(note.ID) {
+			plan.Notes = append(plan.Notes, note)
+		}
+	}
+
+	// 5. ラベル
+	labelNames := make(map[string]bool)
+	for i := range current.Labels {
+		labelNames[strings.ToLower(current.Labels[i].Name)] = true
+	}
+	for i := range archive.Labels {
+		label := archive.Labels[i]
+		if label.IsDeleted || labelNames[strings.ToLower(label.Name)] {
+			continue
+		}
+		if projectID != "" && !usedLabels[strings.ToLower(label.Name)] {
+			continue
+		}
+		plan.Labels = append(plan.Labels, label)
+	}
+
+	// 6. フィルタ（プロジェクトを指定した場合は復元しない）
+	if projectID == "" {
+		filterNames := make(map[string]bool)
+		for i := range current.Filters {
+			filterNames[strings.ToLower(current.Filters[i].Name)] = true
+		}
+		for i := range archive.Filters {
+			filter := archive.Filters[i]
+			if !filter.IsDeleted && !filterNames[strings.ToLower(filter.Name)] {
+				plan.Filters = append(plan.Filters, filter)
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// projectScope は復元対象のプロジェクトのIDを返す（projectIDが空の場合は全て）
+func projectScope(archive *Archive, projectID string) (map[string]bool, error) {
+	scope := make(map[string]bool)
+	if projectID == "" {
+		for i := range archive.Projects {
+			scope[archive.Projects[i].ID] = true
+		}
+		return scope, nil
+	}
+
+	scope[projectID] = true
+	found := false
+	for i := range archive.Projects {
+		if archive.Projects[i].ID == projectID {
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("project not found in backup: %s", projectID)
+	}
+
+	// サブプロジェクトを辿る
+	for added := true; added; {
+		added = false
+		for i := range archive.Projects {
+			project := archive.Projects[i]
+			if !scope[project.ID] && scope[project.ParentID] {
+				scope[project.ID] = true
+				added = true
+			}
+		}
+	}
+	return scope, nil
+}
+
+// sortProjectsParentFirst は親プロジェクトがサブプロジェクトより前に並ぶように並べ替える
+func sortProjectsParentFirst(projects []api.Project) []api.Project {
+	inSet := make(map[string]bool, len(projects))
+	for i := range projects {
+		inSet[projects[i].ID] = true
+	}
+
+	children := make(map[string][]api.Project)
+	for i := range projects {
+		parent := projects[i].ParentID
+		if !inSet[parent] {
+			parent = ""
+		}
+		children[parent] = append(children[parent], projects[i])
+	}
+
+	var sorted []api.Project
+	var walk func(parentID string)
+	walk = func(parentID string) {
+		list := children[parentID]
+		sort.SliceStable(list, func(i, j int) bool { return list[i].ChildOrder < list[j].ChildOrder })
+		for i := range list {
+			sorted = append(sorted, list[i])
+			walk(list[i].ID)
+		}
+	}
+	walk("")
+	return sorted
+}
+
+// sortTasksParentFirst は親タスクがサブタスクより前に並ぶように並べ替える
+func sortTasksParentFirst(tasks []api.Item) []api.Item {
+	inSet := make(map[string]bool, len(tasks))
+	for i := range tasks {
+		inSet[tasks[i].ID] = true
+	}
+
+	// 一覧内に親がいないタスクを起点に子孫を辿る
+	children := make(map[string][]api.Item)
+	for i := range tasks {
+		parent := tasks[i].ParentID
+		if !inSet[parent] {
+			parent = ""
+		}
+		children[parent] = append(children[parent], tasks[i])
+	}
+
+	var sorted []api.Item
+	var walk func(parentID string)
+	walk = func(parentID string) {
+		for _, task := range children[parentID] {
+			sorted = append(sorted, task)
+			walk(task.ID)
+		}
+	}
+	walk("")
+	return sorted
+}
@@ -2,6 +2,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -88,3 +89,13 @@ func (o *Output) Plainf(format string, args ...interface{}) {
 func (o *Output) PlainNoNewlinef(format string, args ...interface{}) {
 	_, _ = fmt.Fprintf(o.stdout, format, args...)
 }
+
+// JSON は値をインデント付きのJSONとして出力する（stdout）
+func (o *Output) JSON(v interface{}) error {
+	encoder := json.NewEncoder(o.stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON output: %w", err)
+	}
+	return nil
+}
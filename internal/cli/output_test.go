@@ -41,6 +41,18 @@ func TestOutput_Errorf(t *testing.T) {
 	assert.Empty(t, stdout.String(), "Errorf()のstdout出力が空ではありません")
 }
 
+func TestOutput_JSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	output := NewWithWriters(&stdout, &stderr, false)
+
+	err := output.JSON(map[string]string{"id": "123"})
+
+	assert.NoError(t, err, "JSON()でエラーが発生しました")
+	expected := "{\n  \"id\": \"123\"\n}\n"
+	assert.Equal(t, expected, stdout.String(), "JSON()のstdout出力が期待値と異なります")
+	assert.Empty(t, stderr.String(), "JSON()のstderr出力が空ではありません")
+}
+
 func TestOutput_Debugf_Verbose(t *testing.T) {
 	var stdout, stderr bytes.Buffer
 	output := NewWithWriters(&stdout, &stderr, true) // verbose = true
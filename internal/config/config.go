@@ -23,6 +23,9 @@ type Config struct {
 	APIToken     string             `yaml:"api_token" mapstructure:"api_token"`
 	BaseURL      string             `yaml:"base_url,omitempty" mapstructure:"base_url"`
 	LocalStorage *repository.Config `yaml:"local_storage,omitempty" mapstructure:"local_storage"`
+
+	// DryRun は実行時のみの設定（--dry-run）。設定ファイルには保存しない
+	DryRun bool `yaml:"-" mapstructure:"-"`
 }
 
 // DefaultConfig はデフォルト設定を返す
@@ -47,6 +50,7 @@ func LoadConfig() (*Config, error) {
 	v.SetDefault("local_storage.enabled", defaultConfig.LocalStorage.Enabled)
 	v.SetDefault("local_storage.database_path", defaultConfig.LocalStorage.DatabasePath)
 	v.SetDefault("local_storage.initial_sync_on_startup", defaultConfig.LocalStorage.InitialSyncOnStart)
+	v.SetDefault("local_storage.trash_retention_days", defaultConfig.LocalStorage.TrashRetentionDays)
 
 	// 環境変数の設定（優先度最高）
 	v.SetEnvPrefix("TODOIST")
@@ -133,6 +137,9 @@ local_storage:
   
   # 起動時に初期同期を実行する
   initial_sync_on_startup: ` + fmt.Sprintf("%t", defaultConfig.LocalStorage.InitialSyncOnStart) + `
+  
+  # 削除したタスク・プロジェクトをゴミ箱に保持する日数（0の場合は自動で削除しない）
+  trash_retention_days: ` + fmt.Sprintf("%d", defaultConfig.LocalStorage.TrashRetentionDays) + `
 `
 
 	// ファイルに書き込み
@@ -0,0 +1,184 @@
+// Package csvtemplate はTodoistのCSVプロジェクトテンプレートの読み書きを提供する
+package csvtemplate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// 行の種類（TYPE列）
+const (
+	TypeTask    = "task"
+	TypeSection = "section"
+	TypeNote    = "note"
+	TypeMeta    = "meta"
+)
+
+// Columns はTodoistのテンプレートCSVの列（ヘッダー）
+var Columns = []string{
+	"TYPE", "CONTENT", "DESCRIPTION", "PRIORITY", "INDENT", "AUTHOR", "RESPONSIBLE",
+	"DATE", "DATE_LANG", "TIMEZONE", "DURATION", "DURATION_UNIT", "DEADLINE", "DEADLINE_LANG",
+}
+
+// Row はテンプレートCSVの1行
+// PriorityはTodoistの表示と同じく1が最も高い（p1）、0は未指定
+type Row struct {
+	Type         string
+	Content      string
+	Description  string
+	Priority     int
+	Indent       int
+	Author       string
+	Responsible  string
+	Date         string
+	DateLang     string
+	Timezone     string
+	Duration     int
+	DurationUnit string
+	Deadline     string
+	DeadlineLang string
+}
+
+// values は列の順に並べた値を返す
+func (r *Row) values() []string {
+	return []string{
+		r.Type, r.Content, r.Description, formatInt(r.Priority), formatInt(r.Indent), r.Author, r.Responsible,
+		r.Date, r.DateLang, r.Timezone, formatInt(r.Duration), r.DurationUnit, r.Deadline, r.DeadlineLang,
+	}
+}
+
+// formatInt は0を空欄として数値を文字列にする
+func formatInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// Write はテンプレートCSVを書き出す
+// Todoistのエクスポートと同じく、セクションの前には空行を入れる
+func Write(w io.Writer, rows []Row) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(Columns); err != nil {
+		return err
+	}
+
+	blank := make([]string, len(Columns))
+	for i := range rows {
+		if rows[i].Type == TypeSection && i > 0 {
+			if err := cw.Write(blank); err != nil {
+				return err
+			}
+		}
+		if err := cw.Write(rows[i].values()); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// Read はテンプレートCSVを読み込む
+// 列はヘッダーの名前で対応付けるため、列の順序や欠けている列があっても読み込める（TYPEとCONTENTは必須）
+// 空行は読み飛ばす
+func Read(r io.Reader) ([]Row, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("empty CSV file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		// UTF-8のBOM付きファイルにも対応する
+		name = strings.TrimPrefix(name, "\ufeff")
+		index[strings.ToUpper(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"TYPE", "CONTENT"} {
+		if _, ok := index[required]; !ok {
+			return nil, fmt.Errorf("missing %s column (not a Todoist template CSV?)", required)
+		}
+	}
+
+	var rows []Row
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		get := func(column string) string {
+			if i, ok := index[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		row := Row{
+			Type:         strings.ToLower(get("TYPE")),
+			Content:      get("CONTENT"),
+			Description:  get("DESCRIPTION"),
+			Author:       get("AUTHOR"),
+			Responsible:  get("RESPONSIBLE"),
+			Date:         get("DATE"),
+			DateLang:     get("DATE_LANG"),
+			Timezone:     get("TIMEZONE"),
+			DurationUnit: get("DURATION_UNIT"),
+			Deadline:     get("DEADLINE"),
+			DeadlineLang: get("DEADLINE_LANG"),
+		}
+		if row.Type == "" && row.Content == "" {
+			continue
+		}
+		if row.Priority, err = parseInt(get("PRIORITY")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid PRIORITY: %w", line, err)
+		}
+		if row.Indent, err = parseInt(get("INDENT")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid INDENT: %w", line, err)
+		}
+		if row.Duration, err = parseInt(get("DURATION")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid DURATION: %w", line, err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseInt は空欄を0として数値を読み込む
+func parseInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// SplitLabels はCONTENTに含まれる @label を取り出し、ラベルを除いた内容とラベルの一覧を返す
+func SplitLabels(content string) (string, []string) {
+	var words, labels []string
+	for _, word := range strings.Fields(content) {
+		if label, ok := strings.CutPrefix(word, "@"); ok && label != "" {
+			labels = append(labels, label)
+			continue
+		}
+		words = append(words, word)
+	}
+	return strings.Join(words, " "), labels
+}
+
+// JoinLabels はTodoistのエクスポートと同じくCONTENTの末尾に @label を付ける
+func JoinLabels(content string, labels []string) string {
+	for _, label := range labels {
+		content += " @" + label
+	}
+	return content
+}
@@ -0,0 +1,114 @@
+package csvtemplate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func TestFromProject(t *testing.T) {
+	sections := []api.Section{
+		{ID: "s-ship", Name: "Ship", SectionOrder: 2},
+		{ID: "s-prep", Name: "Prep", SectionOrder: 1},
+	}
+	tasks := []api.Item{
+		{ID: "t-run", Content: "Run tests", SectionID: "s-prep", ParentID: "t-freeze", ChildOrder: 1, Due: &api.Due{Date: "2026-11-03T10:00:00"}},
+		{ID: "t-freeze", Content: "Freeze branch", Description: "Announce it", SectionID: "s-prep", ChildOrder: 1, Priority: 4, Labels: []string{"release", "team"}, Due: &api.Due{Date: "2026-11-02"}},
+		{ID: "t-sync", Content: "Weekly sync", ChildOrder: 2, Due: &api.Due{Date: "2026-11-04", String: "every wed", Lang: "ja", IsRecurring: true}},
+		{ID: "t-kickoff", Content: "Kickoff", ChildOrder: 1, Duration: &api.Duration{Amount: 30, Unit: api.DurationUnitMinute}},
+		{ID: "t-tag", Content: "Tag release", SectionID: "s-ship", ChildOrder: 1, Deadline: &api.Deadline{Date: "2026-11-05"}},
+		{ID: "t-done", Content: "Done already", SectionID: "s-ship", ChildOrder: 2, DateCompleted: &api.TodoistTime{}},
+	}
+	notes := map[string][]api.Note{
+		"t-freeze": {{Content: "See runbook"}, {Content: "deleted", IsDeleted: true}},
+	}
+
+	rows := FromProject(sections, tasks, notes)
+
+	assert.Equal(t, []Row{
+		{Type: TypeTask, Content: "Kickoff", Priority: 4, Indent: 1, Duration: 30, DurationUnit: api.DurationUnitMinute},
+		{Type: TypeTask, Content: "Weekly sync", Priority: 4, Indent: 1, Date: "every wed", DateLang: "ja"},
+		{Type: TypeSection, Content: "Prep"},
+		{Type: TypeTask, Content: "Freeze branch @release @team", Description: "Announce it", Priority: 1, Indent: 1, Date: "2026-11-02", DateLang: "en"},
+		{Type: TypeNote, Content: "See runbook", Indent: 1},
+		{Type: TypeTask, Content: "Run tests", Priority: 4, Indent: 2, Date: "2026-11-03 10:00", DateLang: "en"},
+		{Type: TypeSection, Content: "Ship"},
+		{Type: TypeTask, Content: "Tag release", Priority: 4, Indent: 1, Deadline: "2026-11-05", DeadlineLang: "en"},
+	}, rows)
+}
+
+func TestWriteRead(t *testing.T) {
+	rows := []Row{
+		{Type: TypeTask, Content: "Kickoff, with comma", Priority: 4, Indent: 1},
+		{Type: TypeSection, Content: "Prep"},
+		{Type: TypeTask, Content: "Freeze branch @release", Description: "Line 1\nLine 2", Priority: 1, Indent: 1, Date: "every monday", DateLang: "en"},
+		{Type: TypeTask, Content: "Run tests", Priority: 4, Indent: 2, Duration: 1, DurationUnit: api.DurationUnitDay},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, rows))
+
+	// セクションの前に空行が入る
+	lines := strings.Split(buf.String(), "\n")
+	assert.Equal(t, strings.Join(Columns, ","), lines[0])
+	assert.Equal(t, strings.Repeat(",", len(Columns)-1), lines[2])
+
+	got, err := Read(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, rows, got)
+}
+
+func TestRead(t *testing.T) {
+	t.Run("列の順序が違い一部の列がなくても読み込める", func(t *testing.T) {
+		input := "\ufeffCONTENT,TYPE,INDENT,PRIORITY\n" +
+			"Buy milk @errand,task,1,2\n" +
+			",,,\n" +
+			"Groceries,section,,\n"
+
+		rows, err := Read(strings.NewReader(input))
+
+		require.NoError(t, err)
+		assert.Equal(t, []Row{
+			{Type: TypeTask, Content: "Buy milk @errand", Indent: 1, Priority: 2},
+			{Type: TypeSection, Content: "Groceries"},
+		}, rows)
+	})
+
+	errorTests := []struct {
+		name        string
+		input       string
+		expectError string
+	}{
+		{name: "空のファイル", input: "", expectError: "empty CSV file"},
+		{name: "TYPE列がない", input: "CONTENT,INDENT\nBuy milk,1\n", expectError: "missing TYPE column"},
+		{name: "不正なINDENT", input: "TYPE,CONTENT,INDENT\ntask,Buy milk,x\n", expectError: "line 2: invalid INDENT"},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.input))
+
+			assert.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestSplitLabels(t *testing.T) {
+	content, labels := SplitLabels("Email bob@example.com @work  about @q4 budget")
+
+	assert.Equal(t, "Email bob@example.com about budget", content)
+	assert.Equal(t, []string{"work", "q4"}, labels)
+}
+
+func TestPriority(t *testing.T) {
+	for apiPriority, templatePriority := range map[int]int{4: 1, 3: 2, 2: 3, 1: 4} {
+		assert.Equal(t, templatePriority, TemplatePriority(apiPriority))
+		assert.Equal(t, apiPriority, APIPriority(templatePriority))
+	}
+	assert.Equal(t, 4, TemplatePriority(0))
+	assert.Equal(t, 0, APIPriority(0))
+}
@@ -0,0 +1,120 @@
+package csvtemplate
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// FromProject はプロジェクトのセクション・未完了のタスク・コメントからテンプレートの行を構築する
+// セクションなしのタスクを先頭に、セクションは並び順に、サブタスクはINDENTを1つ深くして親の直後に並べる
+func FromProject(sections []api.Section, tasks []api.Item, notes map[string][]api.Note) []Row {
+	children := make(map[string][]api.Item)
+	ids := make(map[string]bool, len(tasks))
+	for i := range tasks {
+		if tasks[i].DateCompleted == nil {
+			ids[tasks[i].ID] = true
+		}
+	}
+	for i := range tasks {
+		task := tasks[i]
+		if !ids[task.ID] {
+			continue
+		}
+		// 親が一覧にない（完了済みなど）サブタスクはセクションの直下に並べる
+		key := "section:" + task.SectionID
+		if task.ParentID != "" && ids[task.ParentID] {
+			key = task.ParentID
+		}
+		children[key] = append(children[key], task)
+	}
+	for key := range children {
+		list := children[key]
+		sort.SliceStable(list, func(i, j int) bool { return list[i].ChildOrder < list[j].ChildOrder })
+	}
+
+	var rows []Row
+	var appendTree func(key string, indent int)
+	appendTree = func(key string, indent int) {
+		for i := range children[key] {
+			task := &children[key][i]
+			rows = append(rows, taskRow(task, indent))
+			for _, note := range notes[task.ID] {
+				if !note.IsDeleted && note.Content != "" {
+					rows = append(rows, Row{Type: TypeNote, Content: note.Content, Indent: indent})
+				}
+			}
+			appendTree(task.ID, indent+1)
+		}
+	}
+
+	appendTree("section:", 1)
+
+	sorted := make([]api.Section, len(sections))
+	copy(sorted, sections)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SectionOrder < sorted[j].SectionOrder })
+	for i := range sorted {
+		rows = append(rows, Row{Type: TypeSection, Content: sorted[i].Name})
+		appendTree("section:"+sorted[i].ID, 1)
+	}
+
+	return rows
+}
+
+// taskRow はタスクをテンプレートの行に変換する
+func taskRow(task *api.Item, indent int) Row {
+	row := Row{
+		Type:        TypeTask,
+		Content:     JoinLabels(task.Content, task.Labels),
+		Description: task.Description,
+		Priority:    TemplatePriority(task.Priority),
+		Indent:      indent,
+	}
+	if task.Due != nil {
+		row.Date = templateDate(task.Due)
+		row.DateLang = task.Due.Lang
+		if row.DateLang == "" {
+			row.DateLang = "en"
+		}
+		row.Timezone = task.Due.Timezone
+	}
+	if task.Duration != nil {
+		row.Duration = task.Duration.Amount
+		row.DurationUnit = task.Duration.Unit
+	}
+	if task.Deadline != nil {
+		row.Deadline = task.Deadline.Date
+		row.DeadlineLang = "en"
+	}
+	return row
+}
+
+// templateDate はテンプレートのDATE列に書く期限を返す
+// 繰り返しの期限は自然言語の指定、それ以外は日付（時刻付きの場合は YYYY-MM-DD HH:MM）
+func templateDate(due *api.Due) string {
+	if due.IsRecurring && due.String != "" {
+		return due.String
+	}
+	date, clock, ok := strings.Cut(due.Date, "T")
+	if !ok || len(clock) < len("15:04") {
+		return due.Date
+	}
+	return date + " " + clock[:len("15:04")]
+}
+
+// TemplatePriority はAPIの優先度（4が最も高い）をテンプレートの優先度（1が最も高い）に変換する
+func TemplatePriority(priority int) int {
+	if priority < 1 || priority > 4 {
+		return 4
+	}
+	return 5 - priority
+}
+
+// APIPriority はテンプレートの優先度をAPIの優先度に変換する（未指定・範囲外は0）
+func APIPriority(priority int) int {
+	if priority < 1 || priority > 4 {
+		return 0
+	}
+	return 5 - priority
+}
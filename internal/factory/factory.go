@@ -3,6 +3,7 @@ package factory
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/kyokomi/gotodoist/internal/api"
 	"github.com/kyokomi/gotodoist/internal/config"
@@ -33,11 +34,17 @@ func NewRepository(cfg *config.Config, verbose bool) (*repository.Repository, er
 		return nil, fmt.Errorf("failed to create API client: %w", err)
 	}
 
+	// ドライラン時はコマンドを送信せず標準出力へ表示する
+	if cfg.DryRun {
+		apiClient.SetDryRun(os.Stdout)
+	}
+
 	// Repositoryを作成
 	localRepository, err := repository.NewRepository(apiClient, cfg.LocalStorage, verbose)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Repository: %w", err)
 	}
+	localRepository.SetDryRun(cfg.DryRun)
 
 	return localRepository, nil
 }
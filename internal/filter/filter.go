@@ -0,0 +1,244 @@
+// Package filter はTodoistのフィルタクエリをローカルのタスクに対して評価する機能を提供する
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// Context はフィルタクエリの評価に必要な情報
+type Context struct {
+	Now      time.Time     // today・overdueなどの基準日時
+	Projects []api.Project // #Project・##Project の解決に使用する
+	Sections []api.Section // /Section の解決に使用する
+}
+
+// Query は解析済みのフィルタクエリ
+// カンマ区切りのクエリは複数のリストとして扱い、Filterはリストの順にタスクを並べる
+type Query struct {
+	raw   string
+	lists []matcher
+}
+
+// matcher はタスクが条件に一致するかを判定する関数
+type matcher func(task *api.Item, env *evalEnv) bool
+
+// evalEnv は評価時に参照する索引
+type evalEnv struct {
+	now            time.Time
+	today          time.Time
+	projectsByID   map[string]*api.Project
+	childProjects  map[string][]string
+	sectionsByID   map[string]*api.Section
+	projectMatches map[string]map[string]bool // パターン -> 一致するプロジェクトIDの集合
+}
+
+// Parse はフィルタクエリを解析する
+// 対応していない条件が含まれる場合はエラーを返す
+func Parse(query string) (*Query, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty filter query")
+	}
+
+	p := &parser{tokens: tokens}
+	q := &Query{raw: query}
+	for {
+		m, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		q.lists = append(q.lists, m)
+
+		if p.done() {
+			break
+		}
+		if tok := p.next(); tok.kind != tokenComma {
+			return nil, fmt.Errorf("unexpected %q in filter query", tok.text)
+		}
+	}
+
+	return q, nil
+}
+
+// String は元のフィルタクエリを返す
+func (q *Query) String() string {
+	return q.raw
+}
+
+// Filter はクエリに一致するタスクを返す
+// カンマ区切りのクエリは前のリストから順に並べ、複数のリストに一致するタスクは最初の1回だけ含める
+func (q *Query) Filter(tasks []api.Item, ctx *Context) []api.Item {
+	env := newEvalEnv(ctx)
+
+	var result []api.Item
+	included := make(map[string]bool)
+	for _, list := range q.lists {
+		for i := range tasks {
+			if included[tasks[i].ID] || !list(&tasks[i], env) {
+				continue
+			}
+			included[tasks[i].ID] = true
+			result = append(result, tasks[i])
+		}
+	}
+	return result
+}
+
+// newEvalEnv は評価用の索引を構築する
+func newEvalEnv(ctx *Context) *evalEnv {
+	now := ctx.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	env := &evalEnv{
+		now:            now,
+		today:          time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()),
+		projectsByID:   make(map[string]*api.Project, len(ctx.Projects)),
+		childProjects:  make(map[string][]string),
+		sectionsByID:   make(map[string]*api.Section, len(ctx.Sections)),
+		projectMatches: make(map[string]map[string]bool),
+	}
+	for i := range ctx.Projects {
+		project := &ctx.Projects[i]
+		env.projectsByID[project.ID] = project
+		if project.ParentID != "" {
+			env.childProjects[project.ParentID] = append(env.childProjects[project.ParentID], project.ID)
+		}
+	}
+	for i := range ctx.Sections {
+		env.sectionsByID[ctx.Sections[i].ID] = &ctx.Sections[i]
+	}
+	return env
+}
+
+// matchingProjects は名前のパターンに一致するプロジェクトIDの集合を返す
+// withChildrenがtrueの場合は子孫プロジェクトも含める
+func (env *evalEnv) matchingProjects(pattern *regexp.Regexp, withChildren bool) map[string]bool {
+	key := fmt.Sprintf("%t:%s", withChildren, pattern.String())
+	if ids, ok := env.projectMatches[key]; ok {
+		return ids
+	}
+
+	ids := make(map[string]bool)
+	var addWithChildren func(projectID string)
+	addWithChildren = func(projectID string) {
+		if ids[projectID] {
+			return
+		}
+		ids[projectID] = true
+		for _, childID := range env.childProjects[projectID] {
+			addWithChildren(childID)
+		}
+	}
+	for id, project := range env.projectsByID {
+		if !pattern.MatchString(project.Name) {
+			continue
+		}
+		if withChildren {
+			addWithChildren(id)
+		} else {
+			ids[id] = true
+		}
+	}
+
+	env.projectMatches[key] = ids
+	return ids
+}
+
+// parser はトークン列を再帰下降で解析する
+// 優先順位は ! > & > | で、括弧でまとめられる
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{kind: tokenEOF}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.peek()
+	if !p.done() {
+		p.pos++
+	}
+	return tok
+}
+
+// parseOr は | で結合された条件を解析する
+func (p *parser) parseOr() (matcher, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l, r := left, right
+		left = func(task *api.Item, env *evalEnv) bool { return l(task, env) || r(task, env) }
+	}
+	return left, nil
+}
+
+// parseAnd は & で結合された条件を解析する
+func (p *parser) parseAnd() (matcher, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l, r := left, right
+		left = func(task *api.Item, env *evalEnv) bool { return l(task, env) && r(task, env) }
+	}
+	return left, nil
+}
+
+// parseUnary は否定・括弧・単一の条件を解析する
+func (p *parser) parseUnary() (matcher, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNot:
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(task *api.Item, env *evalEnv) bool { return !inner(task, env) }, nil
+	case tokenLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, fmt.Errorf("missing closing parenthesis in filter query")
+		}
+		return inner, nil
+	case tokenTerm:
+		return parseTerm(tok.text)
+	case tokenEOF:
+		return nil, fmt.Errorf("unexpected end of filter query")
+	default:
+		return nil, fmt.Errorf("unexpected %q in filter query", tok.text)
+	}
+}
@@ -0,0 +1,105 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// testContext はテスト用の評価コンテキストを返す（基準日時: 2025-01-15 12:00 UTC）
+func testContext() *Context {
+	return &Context{
+		Now: time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC),
+		Projects: []api.Project{
+			{ID: "p-work", Name: "Work"},
+			{ID: "p-backend", Name: "Backend", ParentID: "p-work"},
+			{ID: "p-home", Name: "Home"},
+			{ID: "p-shop", Name: "Shopping & Errands"},
+		},
+		Sections: []api.Section{
+			{ID: "s-doing", Name: "Doing", ProjectID: "p-work"},
+		},
+	}
+}
+
+// testTasks はテスト用のタスクを返す
+func testTasks() []api.Item {
+	return []api.Item{
+		{ID: "t1", Content: "Deploy API", ProjectID: "p-backend", Priority: 4, Labels: []string{"release"}, Due: &api.Due{Date: "2025-01-15"}},
+		{ID: "t2", Content: "Write report", ProjectID: "p-work", SectionID: "s-doing", Priority: 3, Due: &api.Due{Date: "2025-01-14"}},
+		{ID: "t3", Content: "Clean kitchen", ProjectID: "p-home", Labels: []string{"chores"}, Due: &api.Due{Date: "2025-01-16", IsRecurring: true}},
+		{ID: "t4", Content: "Buy milk", ProjectID: "p-shop", Priority: 1, Deadline: &api.Deadline{Date: "2025-01-17"}},
+		{ID: "t5", Content: "Standup", ProjectID: "p-work", ParentID: "t2", Due: &api.Due{Date: "2025-01-15T09:00:00Z"}},
+		{ID: "t6", Content: "Plan offsite", ProjectID: "p-work", Due: &api.Due{Date: "2025-01-20"}, Deadline: &api.Deadline{Date: "2025-01-31"}},
+	}
+}
+
+func TestQuery_Filter(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "今日", query: "today", expected: []string{"t1", "t5"}},
+		{name: "期限切れ", query: "overdue", expected: []string{"t2", "t5"}},
+		{name: "明日", query: "tomorrow", expected: []string{"t3"}},
+		{name: "期限なし", query: "no date", expected: []string{"t4"}},
+		{name: "優先度p1はAPIの優先度4", query: "p1", expected: []string{"t1"}},
+		{name: "プロジェクト", query: "#Work", expected: []string{"t2", "t5", "t6"}},
+		{name: "サブプロジェクトを含むプロジェクト", query: "##work", expected: []string{"t1", "t2", "t5", "t6"}},
+		{name: "エスケープした演算子を含むプロジェクト", query: `#Shopping \& Errands`, expected: []string{"t4"}},
+		{name: "セクション", query: "/Doing", expected: []string{"t2"}},
+		{name: "ラベルのワイルドカード", query: "@rel*", expected: []string{"t1"}},
+		{name: "AND・OR・括弧", query: "(today | overdue) & #Work", expected: []string{"t2", "t5"}},
+		{name: "否定", query: "##Work & !subtask & !no date", expected: []string{"t1", "t2", "t6"}},
+		{name: "期間", query: "next 7 days", expected: []string{"t1", "t3", "t5", "t6"}},
+		{name: "期限の前", query: "due before: 2025-01-15", expected: []string{"t2"}},
+		{name: "期限の後", query: "due after: tomorrow", expected: []string{"t6"}},
+		{name: "締め切りあり", query: "deadline", expected: []string{"t4", "t6"}},
+		{name: "締め切りなし", query: "no deadline & #Work", expected: []string{"t2", "t5"}},
+		{name: "締め切りの前", query: "deadline before: 7 days", expected: []string{"t4"}},
+		{name: "締め切りの後", query: "deadline after: 2025-01-17", expected: []string{"t6"}},
+		{name: "検索", query: "search: MILK", expected: []string{"t4"}},
+		{name: "繰り返し", query: "recurring", expected: []string{"t3"}},
+		{name: "カンマ区切りはリストの順に並べる", query: "#Home, p1, today", expected: []string{"t3", "t1", "t5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.query)
+			require.NoError(t, err)
+
+			result := q.Filter(testTasks(), testContext())
+
+			var ids []string
+			for i := range result {
+				ids = append(ids, result[i].ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "空のクエリ", query: "  "},
+		{name: "閉じ括弧がない", query: "(today | overdue"},
+		{name: "演算子の後に条件がない", query: "today &"},
+		{name: "未対応の条件", query: "shared"},
+		{name: "不正な日付", query: "due before: someday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.query)
+			assert.Error(t, err)
+		})
+	}
+}
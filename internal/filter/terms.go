@@ -0,0 +1,278 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// daysPattern は期間指定（例: 7 days, next 7 days, -3 days）
+var daysPattern = regexp.MustCompile(`^(next\s+)?(-?\d+)\s+days?$`)
+
+// priorityTerms はフィルタクエリの優先度（p1が最も高い）とAPIの優先度の対応
+var priorityTerms = map[string]api.Priority{
+	"p1": api.PriorityUrgent,
+	"p2": api.PriorityVeryHigh,
+	"p3": api.PriorityHigh,
+	"p4": api.PriorityNormal,
+}
+
+// parseTerm は単一の条件を解析する
+func parseTerm(text string) (matcher, error) {
+	lower := strings.ToLower(strings.Join(strings.Fields(text), " "))
+
+	switch {
+	case strings.HasPrefix(lower, "##"):
+		return projectMatcher(strings.TrimSpace(text[2:]), true)
+	case strings.HasPrefix(lower, "#"):
+		return projectMatcher(strings.TrimSpace(text[1:]), false)
+	case strings.HasPrefix(lower, "/"):
+		return sectionMatcher(strings.TrimSpace(text[1:]))
+	case strings.HasPrefix(lower, "@"):
+		return labelMatcher(strings.TrimSpace(text[1:]))
+	}
+
+	// 値を伴う条件（search: foo, due before: 2025-01-10 など）
+	if name, value, ok := strings.Cut(lower, ":"); ok {
+		return parseKeyValueTerm(strings.TrimSpace(name), strings.TrimSpace(value), text)
+	}
+
+	if priority, ok := priorityTerms[lower]; ok {
+		return func(task *api.Item, _ *evalEnv) bool { return task.Priority == int(priority) }, nil
+	}
+
+	if m := daysPattern.FindStringSubmatch(lower); m != nil {
+		n, err := strconv.Atoi(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter term %q", text)
+		}
+		return daysMatcher(n), nil
+	}
+
+	switch lower {
+	case "view all", "all":
+		return func(*api.Item, *evalEnv) bool { return true }, nil
+	case "today":
+		return dueOnMatcher(0), nil
+	case "tomorrow":
+		return dueOnMatcher(1), nil
+	case "yesterday":
+		return dueOnMatcher(-1), nil
+	case "overdue", "od":
+		return overdueMatcher, nil
+	case "deadline":
+		return func(task *api.Item, _ *evalEnv) bool { return task.Deadline != nil && task.Deadline.Date != "" }, nil
+	case "no deadline":
+		return func(task *api.Item, _ *evalEnv) bool { return task.Deadline == nil || task.Deadline.Date == "" }, nil
+	case "no date", "no due date":
+		return func(task *api.Item, _ *evalEnv) bool { return task.Due == nil || task.Due.Date == "" }, nil
+	case "no time":
+		return func(task *api.Item, _ *evalEnv) bool {
+			return task.Due != nil && task.Due.Date != "" && len(task.Due.Date) <= len("2006-01-02")
+		}, nil
+	case "recurring":
+		return func(task *api.Item, _ *evalEnv) bool { return task.Due != nil && task.Due.IsRecurring }, nil
+	case "no labels":
+		return func(task *api.Item, _ *evalEnv) bool { return len(task.Labels) == 0 }, nil
+	case "no priority":
+		return func(task *api.Item, _ *evalEnv) bool { return task.Priority <= int(api.PriorityNormal) }, nil
+	case "subtask":
+		return func(task *api.Item, _ *evalEnv) bool { return task.ParentID != "" }, nil
+	case "assigned":
+		return func(task *api.Item, _ *evalEnv) bool { return task.ResponsibleUID != "" }, nil
+	}
+
+	return nil, fmt.Errorf("unsupported filter term %q", text)
+}
+
+// parseKeyValueTerm は「名前: 値」形式の条件を解析する
+func parseKeyValueTerm(name, value, text string) (matcher, error) {
+	switch name {
+	case "search":
+		if value == "" {
+			return nil, fmt.Errorf("search term is empty in %q", text)
+		}
+		return func(task *api.Item, _ *evalEnv) bool {
+			return strings.Contains(strings.ToLower(task.Content), value)
+		}, nil
+	case "due", "date", "due before", "date before", "due after", "date after",
+		"deadline", "deadline before", "deadline after":
+		target, err := parseDateValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date in %q: %w", text, err)
+		}
+		compare := func(day, target time.Time) bool { return day.Equal(target) }
+		if strings.HasSuffix(name, "before") {
+			compare = func(day, target time.Time) bool { return day.Before(target) }
+		} else if strings.HasSuffix(name, "after") {
+			compare = func(day, target time.Time) bool { return day.After(target) }
+		}
+		day := dueDay
+		if strings.HasPrefix(name, "deadline") {
+			day = deadlineDay
+		}
+		return func(task *api.Item, env *evalEnv) bool {
+			d, ok := day(task, env)
+			return ok && compare(d, target(env))
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported filter term %q", text)
+}
+
+// parseDateValue は日付指定（today, tomorrow, yesterday, N days, YYYY-MM-DD）を解析する
+// 評価時の基準日から対象の日付を求める関数を返す
+func parseDateValue(value string) (func(env *evalEnv) time.Time, error) {
+	offsets := map[string]int{"today": 0, "tomorrow": 1, "yesterday": -1}
+	offset, ok := offsets[value]
+	if m := daysPattern.FindStringSubmatch(value); m != nil && m[1] == "" {
+		n, err := strconv.Atoi(m[2])
+		if err == nil {
+			offset, ok = n, true
+		}
+	}
+	if ok {
+		return func(env *evalEnv) time.Time { return env.today.AddDate(0, 0, offset) }, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("use today, tomorrow, yesterday, N days or YYYY-MM-DD")
+	}
+	return func(env *evalEnv) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, env.today.Location())
+	}, nil
+}
+
+// projectMatcher はプロジェクト名（*をワイルドカードとして使える）の条件を作成する
+func projectMatcher(name string, withChildren bool) (matcher, error) {
+	pattern, err := namePattern(name)
+	if err != nil {
+		return nil, err
+	}
+	return func(task *api.Item, env *evalEnv) bool {
+		return env.matchingProjects(pattern, withChildren)[task.ProjectID]
+	}, nil
+}
+
+// sectionMatcher はセクション名（*をワイルドカードとして使える）の条件を作成する
+// 「/」のみの場合はセクションに属さないタスクに一致する
+func sectionMatcher(name string) (matcher, error) {
+	if name == "" {
+		return func(task *api.Item, _ *evalEnv) bool { return task.SectionID == "" }, nil
+	}
+	pattern, err := namePattern(name)
+	if err != nil {
+		return nil, err
+	}
+	return func(task *api.Item, env *evalEnv) bool {
+		section, ok := env.sectionsByID[task.SectionID]
+		return ok && pattern.MatchString(section.Name)
+	}, nil
+}
+
+// labelMatcher はラベル名（*をワイルドカードとして使える）の条件を作成する
+func labelMatcher(name string) (matcher, error) {
+	pattern, err := namePattern(name)
+	if err != nil {
+		return nil, err
+	}
+	return func(task *api.Item, _ *evalEnv) bool {
+		for _, label := range task.Labels {
+			if pattern.MatchString(label) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// namePattern は名前の指定を大文字小文字を区別しない完全一致の正規表現に変換する
+func namePattern(name string) (*regexp.Regexp, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name is empty in filter query")
+	}
+	parts := strings.Split(name, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.Compile("(?i)^" + strings.Join(parts, ".*") + "$")
+}
+
+// dueOnMatcher は基準日からoffset日後が期限のタスクに一致する条件を作成する
+func dueOnMatcher(offset int) matcher {
+	return func(task *api.Item, env *evalEnv) bool {
+		day, ok := dueDay(task, env)
+		return ok && day.Equal(env.today.AddDate(0, 0, offset))
+	}
+}
+
+// daysMatcher は期間内が期限のタスクに一致する条件を作成する
+// 正の値は今日からn日間、負の値は昨日までの過去n日間を表す
+func daysMatcher(n int) matcher {
+	return func(task *api.Item, env *evalEnv) bool {
+		day, ok := dueDay(task, env)
+		if !ok {
+			return false
+		}
+		if n >= 0 {
+			return !day.Before(env.today) && day.Before(env.today.AddDate(0, 0, n))
+		}
+		return !day.Before(env.today.AddDate(0, 0, n)) && day.Before(env.today)
+	}
+}
+
+// overdueMatcher は期限を過ぎたタスクに一致する
+// 時刻指定のある期限は現在時刻、日付のみの期限は今日と比較する
+func overdueMatcher(task *api.Item, env *evalEnv) bool {
+	if task.Due == nil || task.Due.Date == "" {
+		return false
+	}
+	if due, ok := dueTime(task.Due, env.now.Location()); ok && len(task.Due.Date) > len("2006-01-02") {
+		return due.Before(env.now)
+	}
+	day, ok := dueDay(task, env)
+	return ok && day.Before(env.today)
+}
+
+// dueDay はタスクの期限日（時刻を切り捨てた日付）を返す
+func dueDay(task *api.Item, env *evalEnv) (time.Time, bool) {
+	if task.Due == nil {
+		return time.Time{}, false
+	}
+	due, ok := dueTime(task.Due, env.now.Location())
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, env.now.Location()), true
+}
+
+// deadlineDay はタスクの締め切り日を返す
+func deadlineDay(task *api.Item, env *evalEnv) (time.Time, bool) {
+	if task.Deadline == nil {
+		return time.Time{}, false
+	}
+	day, err := time.ParseInLocation("2006-01-02", task.Deadline.Date, env.now.Location())
+	if err != nil {
+		return time.Time{}, false
+	}
+	return day, true
+}
+
+// dueTime は期限の日時を解析する
+// 日付のみ・タイムゾーンなしの日時はlocの日時、UTC指定の日時はlocに変換して返す
+func dueTime(due *api.Due, loc *time.Location) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, due.Date, loc); err == nil {
+			return t, true
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, due.Date); err == nil {
+		return t.In(loc), true
+	}
+	return time.Time{}, false
+}
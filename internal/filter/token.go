@@ -0,0 +1,75 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tokenKind はフィルタクエリのトークンの種類
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenTerm
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+// token はフィルタクエリのトークン
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// operatorTokens は演算子として扱う文字
+var operatorTokens = map[rune]tokenKind{
+	'&': tokenAnd,
+	'|': tokenOr,
+	'!': tokenNot,
+	'(': tokenLParen,
+	')': tokenRParen,
+	',': tokenComma,
+}
+
+// tokenize はフィルタクエリをトークンに分割する
+// 演算子以外の文字の並びは前後の空白を除いて1つの条件として扱う（#Work Stuff のように空白を含められる）
+// 演算子の文字を条件に含める場合はバックスラッシュでエスケープする（例: #Shopping \& Errands）
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+	var term strings.Builder
+
+	flushTerm := func() {
+		if text := strings.TrimSpace(term.String()); text != "" {
+			tokens = append(tokens, token{kind: tokenTerm, text: text})
+		}
+		term.Reset()
+	}
+
+	runes := []rune(query)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '\\' {
+			if i+1 >= len(runes) {
+				return nil, fmt.Errorf("trailing backslash in filter query")
+			}
+			i++
+			term.WriteRune(runes[i])
+			continue
+		}
+
+		kind, isOperator := operatorTokens[r]
+		if !isOperator {
+			term.WriteRune(r)
+			continue
+		}
+		flushTerm()
+		tokens = append(tokens, token{kind: kind, text: string(r)})
+	}
+	flushTerm()
+
+	return tokens, nil
+}
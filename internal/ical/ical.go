@@ -0,0 +1,234 @@
+// Package ical はタスクをiCalendar（RFC 5545）形式で書き出す機能を提供する
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// Format はiCalendar形式の書き出しの指定
+const Format = "ics"
+
+// ContentType はiCalendar形式のMIMEタイプ
+const ContentType = "text/calendar; charset=utf-8"
+
+// 書き出すコンポーネントの種類
+const (
+	ComponentEvent = "event" // 期限のあるタスクを予定（VEVENT）にする
+	ComponentTodo  = "todo"  // タスクをToDo（VTODO）にする
+)
+
+// Components は書き出しに対応しているコンポーネントの種類
+var Components = []string{ComponentEvent, ComponentTodo}
+
+// maxLineOctets は折り返す前の1行の最大のオクテット数
+const maxLineOctets = 75
+
+// Options はiCalendar形式の書き出しの指定
+type Options struct {
+	Name      string    // カレンダー名（X-WR-CALNAME）
+	Component string    // ComponentEvent または ComponentTodo（空の場合はComponentEvent）
+	Now       time.Time // DTSTAMPに使う日時
+}
+
+// UID はタスクIDから導出する、書き出しのたびに変わらないUID
+func UID(taskID string) string {
+	return "task-" + taskID + "@gotodoist"
+}
+
+// Write はタスクをiCalendar形式で書き出す
+// 予定には期限のあるタスクのみ、ToDoにはすべてのタスクを含める
+// 日付のみの期限は終日、タイムゾーンなしの期限はフローティング、タイムゾーン指定の期限はUTCの日時とする
+func Write(w io.Writer, tasks []api.Item, opts Options) error {
+	component := opts.Component
+	if component == "" {
+		component = ComponentEvent
+	}
+	if component != ComponentEvent && component != ComponentTodo {
+		return fmt.Errorf("unsupported component: %s (supported: %s)", component, strings.Join(Components, ", "))
+	}
+
+	cal := &calendarWriter{stamp: opts.Now.UTC().Format("20060102T150405Z")}
+	cal.line("BEGIN:VCALENDAR")
+	cal.line("VERSION:2.0")
+	cal.line("PRODID:-//kyokomi//gotodoist//EN")
+	cal.line("CALSCALE:GREGORIAN")
+	cal.line("METHOD:PUBLISH")
+	if opts.Name != "" {
+		cal.line("X-WR-CALNAME:" + escapeText(opts.Name))
+	}
+	for i := range tasks {
+		task := &tasks[i]
+		if component == ComponentTodo {
+			cal.todo(task)
+		} else if task.Due != nil && task.Due.Date != "" {
+			cal.event(task)
+		}
+	}
+	cal.line("END:VCALENDAR")
+
+	_, err := io.WriteString(w, cal.b.String())
+	return err
+}
+
+// calendarWriter はiCalendar形式の行を組み立てる
+type calendarWriter struct {
+	b     strings.Builder
+	stamp string
+}
+
+// event はタスクを予定として書き出す
+func (c *calendarWriter) event(task *api.Item) {
+	start, ok := parseDue(task.Due.Date)
+	if !ok {
+		return
+	}
+
+	c.line("BEGIN:VEVENT")
+	c.common(task)
+	c.line(start.property("DTSTART"))
+	if start.allDay {
+		// 終日の予定は翌日（日単位の所要時間がある場合はその日数後）を終わりとする
+		days := 1
+		if task.Duration != nil && task.Duration.Unit == api.DurationUnitDay && task.Duration.Amount > 1 {
+			days = task.Duration.Amount
+		}
+		end := dueValue{t: start.t.AddDate(0, 0, days), allDay: true}
+		c.line(end.property("DTEND"))
+	} else if task.Duration != nil && task.Duration.Amount > 0 {
+		c.line("DURATION:" + formatDuration(task.Duration))
+	}
+	c.recurrence(task.Due)
+	c.line("TRANSP:TRANSPARENT")
+	c.line("END:VEVENT")
+}
+
+// todo はタスクをToDoとして書き出す
+func (c *calendarWriter) todo(task *api.Item) {
+	c.line("BEGIN:VTODO")
+	c.common(task)
+	if task.Due != nil {
+		if due, ok := parseDue(task.Due.Date); ok {
+			c.line(due.property("DUE"))
+			c.recurrence(task.Due)
+		}
+	}
+	if task.DateCompleted != nil {
+		c.line("STATUS:COMPLETED")
+		c.line("COMPLETED:" + task.DateCompleted.UTC().Format("20060102T150405Z"))
+	} else {
+		c.line("STATUS:NEEDS-ACTION")
+	}
+	c.line("END:VTODO")
+}
+
+// common は予定とToDoに共通のプロパティを書き出す
+func (c *calendarWriter) common(task *api.Item) {
+	c.line("UID:" + UID(task.ID))
+	c.line("DTSTAMP:" + c.stamp)
+	c.line("SUMMARY:" + escapeText(task.Content))
+	if task.Description != "" {
+		c.line("DESCRIPTION:" + escapeText(task.Description))
+	}
+	if len(task.Labels) > 0 {
+		labels := make([]string, len(task.Labels))
+		for i, label := range task.Labels {
+			labels[i] = escapeText(label)
+		}
+		c.line("CATEGORIES:" + strings.Join(labels, ","))
+	}
+	if priority := icalPriority(task.Priority); priority > 0 {
+		c.line("PRIORITY:" + strconv.Itoa(priority))
+	}
+}
+
+// recurrence は表現できる繰り返しをRRULEとして書き出す
+func (c *calendarWriter) recurrence(due *api.Due) {
+	if rule, ok := RRule(due); ok {
+		c.line("RRULE:" + rule)
+	}
+}
+
+// line は1行を75オクテットごとに折り返してCRLFで書き出す
+func (c *calendarWriter) line(text string) {
+	limit := maxLineOctets
+	for len(text) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		c.b.WriteString(text[:cut])
+		c.b.WriteString("\r\n ")
+		text = text[cut:]
+		// 継続行は先頭の空白を含めて75オクテットにする
+		limit = maxLineOctets - 1
+	}
+	c.b.WriteString(text)
+	c.b.WriteString("\r\n")
+}
+
+// dueValue は解析した期限
+type dueValue struct {
+	t      time.Time
+	allDay bool // 日付のみ
+	utc    bool // タイムゾーン指定（UTC）の日時、falseの場合はフローティング
+}
+
+// parseDue は期限の日付を解析する
+func parseDue(date string) (dueValue, bool) {
+	if t, err := time.Parse("2006-01-02", date); err == nil {
+		return dueValue{t: t, allDay: true}, true
+	}
+	if t, err := time.Parse("2006-01-02T15:04:05", date); err == nil {
+		return dueValue{t: t}, true
+	}
+	if t, err := time.Parse(time.RFC3339, date); err == nil {
+		return dueValue{t: t.UTC(), utc: true}, true
+	}
+	return dueValue{}, false
+}
+
+// property はDATE型またはDATE-TIME型のプロパティの行にする（例: DTSTART;VALUE=DATE:20261020）
+func (v dueValue) property(name string) string {
+	switch {
+	case v.allDay:
+		return name + ";VALUE=DATE:" + v.t.Format("20060102")
+	case v.utc:
+		return name + ":" + v.t.Format("20060102T150405Z")
+	default:
+		return name + ":" + v.t.Format("20060102T150405")
+	}
+}
+
+// formatDuration は所要時間をDURATION型の値（PT30M、P2D）にする
+func formatDuration(duration *api.Duration) string {
+	if duration.Unit == api.DurationUnitDay {
+		return fmt.Sprintf("P%dD", duration.Amount)
+	}
+	return fmt.Sprintf("PT%dM", duration.Amount)
+}
+
+// icalPriority はAPIの優先度（4が最も高い）をiCalendarの優先度（1が最も高い、0は指定なし）に変換する
+func icalPriority(priority int) int {
+	switch priority {
+	case 4:
+		return 1
+	case 3:
+		return 5
+	case 2:
+		return 9
+	default:
+		return 0
+	}
+}
+
+// escapeText はTEXT型の値の「\」「;」「,」と改行をエスケープする
+func escapeText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
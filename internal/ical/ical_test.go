@@ -0,0 +1,147 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// testTasks はテスト用のタスク
+func testTasks() []api.Item {
+	completed := api.TodoistTime{Time: time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC)}
+	return []api.Item{
+		{ID: "t1", Content: "Pay rent", Description: "Bank transfer; ref 12, see notes", Priority: 4, Labels: []string{"home"}, Due: &api.Due{Date: "2026-11-01", String: "every month", IsRecurring: true}},
+		{ID: "t2", Content: "Standup", Due: &api.Due{Date: "2026-10-20T09:30:00", String: "every weekday at 9:30", IsRecurring: true}, Duration: &api.Duration{Amount: 15, Unit: api.DurationUnitMinute}},
+		{ID: "t3", Content: "Call Tokyo office", Priority: 1, Due: &api.Due{Date: "2026-10-21T01:00:00Z", Timezone: "Asia/Tokyo"}},
+		{ID: "t4", Content: "Someday", Priority: 1},
+		{ID: "t5", Content: "Filed taxes", Priority: 2, Due: &api.Due{Date: "2026-10-10"}, DateCompleted: &completed},
+	}
+}
+
+func TestWrite_Events(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := Write(&buf, testTasks(), Options{Name: "Todoist", Now: time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)})
+
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//kyokomi//gotodoist//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Todoist",
+		"BEGIN:VEVENT",
+		"UID:task-t1@gotodoist",
+		"DTSTAMP:20261018T090000Z",
+		"SUMMARY:Pay rent",
+		`DESCRIPTION:Bank transfer\; ref 12\, see notes`,
+		"CATEGORIES:home",
+		"PRIORITY:1",
+		"DTSTART;VALUE=DATE:20261101",
+		"DTEND;VALUE=DATE:20261102",
+		"RRULE:FREQ=MONTHLY",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:task-t2@gotodoist",
+		"DTSTAMP:20261018T090000Z",
+		"SUMMARY:Standup",
+		"DTSTART:20261020T093000",
+		"DURATION:PT15M",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:task-t3@gotodoist",
+		"DTSTAMP:20261018T090000Z",
+		"SUMMARY:Call Tokyo office",
+		"DTSTART:20261021T010000Z",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:task-t5@gotodoist",
+		"DTSTAMP:20261018T090000Z",
+		"SUMMARY:Filed taxes",
+		"PRIORITY:9",
+		"DTSTART;VALUE=DATE:20261010",
+		"DTEND;VALUE=DATE:20261011",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n"), buf.String())
+}
+
+func TestWrite_Todos(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := Write(&buf, testTasks(), Options{Component: ComponentTodo, Now: time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)})
+
+	// Assert: 期限のないタスクも含め、完了状態を書き出す
+	require.NoError(t, err)
+	output := buf.String()
+	assert.Equal(t, 5, strings.Count(output, "BEGIN:VTODO"))
+	assert.NotContains(t, output, "VEVENT")
+	assert.NotContains(t, output, "X-WR-CALNAME")
+	assert.Contains(t, output, "DUE;VALUE=DATE:20261101\r\nRRULE:FREQ=MONTHLY\r\nSTATUS:NEEDS-ACTION\r\n")
+	assert.Contains(t, output, "SUMMARY:Someday\r\nSTATUS:NEEDS-ACTION\r\n")
+	assert.Contains(t, output, "STATUS:COMPLETED\r\nCOMPLETED:20261012T080000Z\r\n")
+
+	err = Write(&buf, nil, Options{Component: "journal"})
+	assert.ErrorContains(t, err, "unsupported component: journal")
+}
+
+func TestWrite_FoldsLongLines(t *testing.T) {
+	var buf bytes.Buffer
+	tasks := []api.Item{{ID: "t1", Content: strings.Repeat("長い", 40), Due: &api.Due{Date: "2026-10-20"}}}
+
+	require.NoError(t, Write(&buf, tasks, Options{}))
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, "1行は75オクテット以内に折り返すべきです")
+	}
+	// 折り返しを戻すと元の内容になる
+	unfolded := strings.ReplaceAll(buf.String(), "\r\n ", "")
+	assert.Contains(t, unfolded, "SUMMARY:"+strings.Repeat("長い", 40)+"\r\n")
+}
+
+func TestRRule(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"every day", "FREQ=DAILY"},
+		{"daily", "FREQ=DAILY"},
+		{"every day at 9am", "FREQ=DAILY"},
+		{"every 2 weeks", "FREQ=WEEKLY;INTERVAL=2"},
+		{"every other month", "FREQ=MONTHLY;INTERVAL=2"},
+		{"every 1 year", "FREQ=YEARLY"},
+		{"every 15th", "FREQ=MONTHLY;BYMONTHDAY=15"},
+		{"every Mon, Wed and Fri", "FREQ=WEEKLY;BYDAY=MO,WE,FR"},
+		{"every wednesday", "FREQ=WEEKLY;BYDAY=WE"},
+		{"every weekend", "FREQ=WEEKLY;BYDAY=SA,SU"},
+		// 完了日を基準にする繰り返しや複雑な指定は表現できない
+		{"every! 3 days", ""},
+		{"after 2 weeks", ""},
+		{"every last day", ""},
+		{"every day starting oct 1", ""},
+		{"毎日", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			rule, ok := RRule(&api.Due{Date: "2026-10-20", String: tt.spec, IsRecurring: true})
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, tt.want, rule)
+		})
+	}
+
+	_, ok := RRule(&api.Due{Date: "2026-10-20", String: "every day"})
+	assert.False(t, ok, "繰り返しでない期限にはRRULEを付けないべきです")
+}
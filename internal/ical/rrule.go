@@ -0,0 +1,106 @@
+package ical
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// intervalPattern は間隔を指定した繰り返し（例: every 2 weeks, every other month）
+var intervalPattern = regexp.MustCompile(`^every\s+(?:(\d+|other)\s+)?(day|week|month|year)s?$`)
+
+// monthDayPattern は毎月の日付を指定した繰り返し（例: every 15th, every 1st）
+var monthDayPattern = regexp.MustCompile(`^every\s+(\d{1,2})(?:st|nd|rd|th)?$`)
+
+// frequencies は繰り返しの単位に対応するFREQ
+var frequencies = map[string]string{"day": "DAILY", "week": "WEEKLY", "month": "MONTHLY", "year": "YEARLY"}
+
+// recurrenceAliases は繰り返し指定の別名
+var recurrenceAliases = map[string]string{
+	"daily":    "every day",
+	"weekly":   "every week",
+	"monthly":  "every month",
+	"yearly":   "every year",
+	"annually": "every year",
+}
+
+// weekdays は曜日の名前（省略形を含む）に対応するBYDAYの値
+var weekdays = map[string]string{
+	"monday": "MO", "mon": "MO",
+	"tuesday": "TU", "tue": "TU", "tues": "TU",
+	"wednesday": "WE", "wed": "WE",
+	"thursday": "TH", "thu": "TH", "thurs": "TH",
+	"friday": "FR", "fri": "FR",
+	"saturday": "SA", "sat": "SA",
+	"sunday": "SU", "sun": "SU",
+}
+
+// RRule はTodoistの繰り返し指定をRFC 5545のRRULEの値（例: FREQ=WEEKLY;BYDAY=MO,WE）に変換する
+// 完了日を基準にする繰り返し（every!・after）や、開始・終了の指定など表現できない場合はfalseを返す
+func RRule(due *api.Due) (string, bool) {
+	if due == nil || !due.IsRecurring {
+		return "", false
+	}
+
+	// 時刻指定（"every day at 9am"の"at 9am"）は期限の日時側に含まれるため除く
+	spec := strings.ToLower(strings.Join(strings.Fields(due.String), " "))
+	if i := strings.Index(spec, " at "); i >= 0 {
+		spec = spec[:i]
+	}
+	if alias, ok := recurrenceAliases[spec]; ok {
+		spec = alias
+	}
+
+	if m := intervalPattern.FindStringSubmatch(spec); m != nil {
+		rule := "FREQ=" + frequencies[m[2]]
+		switch m[1] {
+		case "":
+		case "other":
+			rule += ";INTERVAL=2"
+		default:
+			n, err := strconv.Atoi(m[1])
+			if err != nil || n < 1 {
+				return "", false
+			}
+			if n > 1 {
+				rule += ";INTERVAL=" + m[1]
+			}
+		}
+		return rule, true
+	}
+
+	if m := monthDayPattern.FindStringSubmatch(spec); m != nil {
+		day, err := strconv.Atoi(m[1])
+		if err != nil || day < 1 || day > 31 {
+			return "", false
+		}
+		return "FREQ=MONTHLY;BYMONTHDAY=" + m[1], true
+	}
+
+	if days, ok := weekdayList(strings.TrimPrefix(spec, "every ")); ok && strings.HasPrefix(spec, "every ") {
+		return "FREQ=WEEKLY;BYDAY=" + strings.Join(days, ","), true
+	}
+	return "", false
+}
+
+// weekdayList は曜日の並び（例: "mon, wed and fri"、"weekday"）をBYDAYの値にする
+func weekdayList(spec string) ([]string, bool) {
+	switch spec {
+	case "weekday", "workday":
+		return []string{"MO", "TU", "WE", "TH", "FR"}, true
+	case "weekend":
+		return []string{"SA", "SU"}, true
+	}
+
+	var days []string
+	for _, name := range strings.FieldsFunc(strings.ReplaceAll(spec, " and ", ","), func(r rune) bool { return r == ',' || r == ' ' }) {
+		day, ok := weekdays[name]
+		if !ok {
+			return nil, false
+		}
+		days = append(days, day)
+	}
+	return days, len(days) > 0
+}
@@ -0,0 +1,97 @@
+package plaintext
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// Outline は書き出す見出しとその下のタスク
+// 最上位の見出しはプロジェクト名（またはフィルタ）で、セクションを子の見出しとする
+type Outline struct {
+	Title    string
+	Tasks    []Task // セクションなしのタスク（サブタスクを含む）
+	Sections []Outline
+}
+
+// Count は見出しの下にあるサブタスクを含むタスクの数を返す
+func (o *Outline) Count() int {
+	count := Count(o.Tasks)
+	for i := range o.Sections {
+		count += o.Sections[i].Count()
+	}
+	return count
+}
+
+// ProjectOutline はプロジェクトのセクションとタスクから見出しを構築する
+// セクションなしのタスクを先頭に、セクションは並び順に、サブタスクは親の下に並べる
+// 親が一覧にないサブタスクはセクションの直下に並べる
+func ProjectOutline(title string, sections []api.Section, tasks []api.Item, loc *time.Location) Outline {
+	children := make(map[string][]api.Item)
+	ids := make(map[string]bool, len(tasks))
+	for i := range tasks {
+		ids[tasks[i].ID] = true
+	}
+	for i := range tasks {
+		key := "section:" + tasks[i].SectionID
+		if tasks[i].ParentID != "" && ids[tasks[i].ParentID] {
+			key = tasks[i].ParentID
+		}
+		children[key] = append(children[key], tasks[i])
+	}
+	for key := range children {
+		list := children[key]
+		sort.SliceStable(list, func(i, j int) bool { return list[i].ChildOrder < list[j].ChildOrder })
+	}
+
+	var tree func(key string) []Task
+	tree = func(key string) []Task {
+		var result []Task
+		for i := range children[key] {
+			task := TaskFromItem(&children[key][i], loc)
+			task.Subtasks = tree(children[key][i].ID)
+			result = append(result, task)
+		}
+		return result
+	}
+
+	outline := Outline{Title: title, Tasks: tree("section:")}
+	sorted := make([]api.Section, len(sections))
+	copy(sorted, sections)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SectionOrder < sorted[j].SectionOrder })
+	for i := range sorted {
+		outline.Sections = append(outline.Sections, Outline{Title: sorted[i].Name, Tasks: tree("section:" + sorted[i].ID)})
+	}
+	return outline
+}
+
+// TaskFromItem はTodoistのタスクを書き出し用のタスクに変換する（サブタスクは含まない）
+// UTC指定の期限はlocの日時に変換する
+func TaskFromItem(item *api.Item, loc *time.Location) Task {
+	task := Task{
+		Content:     item.Content,
+		Description: item.Description,
+		Labels:      item.Labels,
+		Priority:    item.Priority,
+		Completed:   item.DateCompleted != nil,
+	}
+	if item.Due != nil {
+		task.Due = itemDue(item.Due.Date, loc)
+		if item.Due.IsRecurring {
+			task.Recurrence = item.Due.String
+		}
+	}
+	return task
+}
+
+// itemDue はTodoistの期限の日付を書き出し用の形式（YYYY-MM-DD または YYYY-MM-DDTHH:MM:SS）にする
+func itemDue(date string, loc *time.Location) string {
+	if isDate(date) {
+		return date
+	}
+	if t, err := time.Parse(time.RFC3339, date); err == nil {
+		return t.In(loc).Format("2006-01-02T15:04:05")
+	}
+	return normalizeDue(date)
+}
@@ -0,0 +1,96 @@
+// Package plaintext はtodo.txt・Taskwarrior・TaskPaper・Markdown・Orgなどテキスト形式のタスクのデータを扱う
+package plaintext
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// テキスト形式の種類
+const (
+	FormatTodoTxt     = "todotxt"
+	FormatTaskwarrior = "taskwarrior"
+	FormatTaskPaper   = "taskpaper"
+	FormatMarkdown    = "markdown"
+	FormatOrg         = "org"
+)
+
+// ImportFormats は取り込みに対応している形式
+var ImportFormats = []string{FormatTodoTxt, FormatTaskwarrior, FormatTaskPaper}
+
+// ExportFormats は書き出しに対応している形式
+var ExportFormats = []string{FormatMarkdown, FormatOrg, FormatTaskPaper}
+
+// Task はテキスト形式から読み込んだ、またはテキスト形式に書き出すタスク
+type Task struct {
+	Key         string   // 再実行時に取り込み済みか判定するためのキー（ファイル内で一意）
+	Content     string   // タスク名（プロジェクト・ラベルなどの記法は取り除く）
+	Description string   // 注釈・ノート
+	Project     []string // プロジェクトの階層（空の場合は取り込み先の既定のプロジェクト）
+	Labels      []string
+	Priority    int    // APIの優先度（4が最も高い、0は指定なし）
+	Due         string // YYYY-MM-DD または YYYY-MM-DDTHH:MM:SS
+	Completed   bool
+	Recurrence  string // 繰り返しの指定（書き出し時のみ、Todoistの自然言語の指定）
+	Subtasks    []Task
+}
+
+// Parse は指定した形式のテキストからタスクを読み込む
+// Taskwarriorの期限（UTC）はlocの日時に変換する
+func Parse(format string, r io.Reader, loc *time.Location) ([]Task, error) {
+	switch format {
+	case FormatTodoTxt:
+		return ParseTodoTxt(r)
+	case FormatTaskwarrior:
+		return ParseTaskwarrior(r, loc)
+	case FormatTaskPaper:
+		return ParseTaskPaper(r)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s (supported: %s)", format, strings.Join(ImportFormats, ", "))
+	}
+}
+
+// Count はサブタスクを含むタスクの数を返す
+func Count(tasks []Task) int {
+	count := 0
+	for i := range tasks {
+		count += 1 + Count(tasks[i].Subtasks)
+	}
+	return count
+}
+
+// keyCounter は同じ内容のタスクにも一意のキーを割り当てる
+type keyCounter map[string]int
+
+// key は同じ内容の何番目のタスクかを含めたキーを返す
+func (c keyCounter) key(format string, parts ...string) string {
+	base := format + ":" + strings.Join(parts, "\x1f")
+	c[base]++
+	if c[base] == 1 {
+		return base
+	}
+	return fmt.Sprintf("%s#%d", base, c[base])
+}
+
+// isDate はYYYY-MM-DD形式の日付かどうかを返す
+func isDate(value string) bool {
+	_, err := time.Parse("2006-01-02", value)
+	return err == nil
+}
+
+// normalizeDue は日付または日時（YYYY-MM-DD HH:MM など）を期限の形式にする
+// 解釈できない場合は空文字列を返す
+func normalizeDue(value string) string {
+	value = strings.TrimSpace(value)
+	if isDate(value) {
+		return value
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format("2006-01-02T15:04:05")
+		}
+	}
+	return ""
+}
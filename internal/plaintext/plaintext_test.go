@@ -0,0 +1,203 @@
+package plaintext
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func TestParseTodoTxt(t *testing.T) {
+	input := "(A) 2026-10-01 Call mom +Family @phone due:2026-10-20\n" +
+		"\n" +
+		"x 2026-10-05 2026-10-01 Pay rent +Home +Bills pri:B\n" +
+		"Read book t:2026-11-01\n" +
+		"Read book\n"
+
+	tasks, err := ParseTodoTxt(strings.NewReader(input))
+
+	require.NoError(t, err)
+	assert.Equal(t, []Task{
+		{Key: "todotxt:Family\x1fCall mom", Content: "Call mom", Project: []string{"Family"}, Labels: []string{"phone"}, Priority: 4, Due: "2026-10-20"},
+		{Key: "todotxt:Home\x1fPay rent", Content: "Pay rent", Project: []string{"Home"}, Labels: []string{"Bills"}, Priority: 3, Completed: true},
+		// 対応していないkey:valueは内容に残す
+		{Key: "todotxt:\x1fRead book t:2026-11-01", Content: "Read book t:2026-11-01"},
+		{Key: "todotxt:\x1fRead book", Content: "Read book"},
+	}, tasks)
+}
+
+func TestParseTodoTxt_DuplicateKeys(t *testing.T) {
+	tasks, err := ParseTodoTxt(strings.NewReader("Water plants\nWater plants\n"))
+
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.NotEqual(t, tasks[0].Key, tasks[1].Key, "同じ内容のタスクにも別のキーを割り当てるべきです")
+}
+
+func TestParseTaskwarrior(t *testing.T) {
+	input := `[
+{"uuid":"a1","description":"Plant tulips","project":"Home.Garden","tags":["outside"],"priority":"H","due":"20261020T150000Z","status":"pending","annotations":[{"entry":"20261001T000000Z","description":"Buy bulbs first"}]},
+{"uuid":"a2","description":"File taxes","priority":"L","due":"20261031T000000Z","status":"completed"},
+{"uuid":"a3","description":"Old idea","status":"deleted"},
+{"uuid":"a4","description":"Weekly review","status":"recurring"},
+{"uuid":"a5","description":"Waiting on reply","status":"waiting"}
+]`
+
+	tasks, err := ParseTaskwarrior(strings.NewReader(input), time.UTC)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Task{
+		{
+			Key: "taskwarrior:a1", Content: "Plant tulips", Description: "Buy bulbs first",
+			Project: []string{"Home", "Garden"}, Labels: []string{"outside"}, Priority: 4, Due: "2026-10-20T15:00:00",
+		},
+		// 0時ちょうどの期限は日付のみ
+		{Key: "taskwarrior:a2", Content: "File taxes", Priority: 2, Due: "2026-10-31", Completed: true},
+		{Key: "taskwarrior:a5", Content: "Waiting on reply"},
+	}, tasks)
+}
+
+func TestParseTaskwarrior_Lines(t *testing.T) {
+	// 古いバージョンの1行1タスクの出力にも対応する
+	input := `{"uuid":"a1","description":"First","status":"pending"}
+{"uuid":"a2","description":"Second","status":"pending"}
+`
+	tasks, err := ParseTaskwarrior(strings.NewReader(input), time.UTC)
+
+	require.NoError(t, err)
+	assert.Len(t, tasks, 2)
+
+	_, err = ParseTaskwarrior(strings.NewReader("todo: not json"), time.UTC)
+	assert.ErrorContains(t, err, "invalid Taskwarrior export")
+}
+
+func TestParseTaskPaper(t *testing.T) {
+	input := "Work:\n" +
+		"\t- Write report @priority(1) @due(2026-10-20 14:30) @office\n" +
+		"\t\tInclude Q3 numbers\n" +
+		"\t\t- Collect data @done(2026-10-10)\n" +
+		"\tClient:\n" +
+		"\t\t- Send invoice @flagged\n" +
+		"- Loose task\n"
+
+	tasks, err := ParseTaskPaper(strings.NewReader(input))
+
+	require.NoError(t, err)
+	assert.Equal(t, []Task{
+		{
+			Key: "taskpaper:Work\x1fWrite report", Content: "Write report", Description: "Include Q3 numbers",
+			Project: []string{"Work"}, Labels: []string{"office"}, Priority: 4, Due: "2026-10-20T14:30:00",
+			Subtasks: []Task{
+				{Key: "taskpaper:Work\x1fWrite report\x1fCollect data", Content: "Collect data", Project: []string{"Work"}, Completed: true},
+			},
+		},
+		{Key: "taskpaper:Work/Client\x1fSend invoice", Content: "Send invoice", Project: []string{"Work", "Client"}, Labels: []string{"flagged"}},
+		{Key: "taskpaper:\x1fLoose task", Content: "Loose task"},
+	}, tasks)
+	assert.Equal(t, 4, Count(tasks))
+}
+
+func TestParse_UnsupportedFormat(t *testing.T) {
+	_, err := Parse("csv", strings.NewReader(""), time.UTC)
+
+	assert.ErrorContains(t, err, "unsupported format: csv")
+}
+
+// testOutline はテスト用のプロジェクトの見出し
+func testOutline() Outline {
+	completed := api.TodoistTime{Time: time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)}
+	sections := []api.Section{
+		{ID: "s-ship", Name: "Ship", SectionOrder: 2},
+		{ID: "s-prep", Name: "Prep", SectionOrder: 1},
+	}
+	tasks := []api.Item{
+		{ID: "t-sync", Content: "Weekly sync", Priority: 1, Due: &api.Due{Date: "2026-10-21", String: "every wednesday", IsRecurring: true}},
+		{ID: "t-tests", Content: "Run tests", ParentID: "t-freeze", SectionID: "s-prep", Priority: 1, Due: &api.Due{Date: "2026-10-20T10:00:00"}},
+		{ID: "t-freeze", Content: "Freeze branch", Description: "Announce in #release", SectionID: "s-prep", Priority: 4, Labels: []string{"release", "team a"}, Due: &api.Due{Date: "2026-10-20"}, ChildOrder: 1},
+		{ID: "t-docs", Content: "Update docs", SectionID: "s-prep", Priority: 2, DateCompleted: &completed, ChildOrder: 2},
+		{ID: "t-tag", Content: "Tag release", SectionID: "s-ship", Priority: 3},
+	}
+	return ProjectOutline("Release", sections, tasks, time.UTC)
+}
+
+func TestRender_Markdown(t *testing.T) {
+	outline := testOutline()
+	var buf bytes.Buffer
+
+	require.NoError(t, Render(&buf, FormatMarkdown, &outline))
+
+	assert.Equal(t, `# Release
+
+- [ ] Weekly sync 🔁 every wednesday 📅 2026-10-21
+
+## Prep
+
+- [ ] Freeze branch #release #team_a ⏫ 📅 2026-10-20
+  Announce in #release
+  - [ ] Run tests 📅 2026-10-20 10:00
+- [x] Update docs 🔽
+
+## Ship
+
+- [ ] Tag release 🔼
+`, buf.String())
+}
+
+func TestRender_Org(t *testing.T) {
+	outline := testOutline()
+	var buf bytes.Buffer
+
+	require.NoError(t, Render(&buf, FormatOrg, &outline))
+
+	assert.Equal(t, `#+TITLE: Release
+- [ ] Weekly sync <2026-10-21 Wed>
+* Prep
+- [ ] [#A] Freeze branch <2026-10-20 Tue> :release:team_a:
+  Announce in #release
+  - [ ] Run tests <2026-10-20 Tue 10:00>
+- [x] [#C] Update docs
+* Ship
+- [ ] [#B] Tag release
+`, buf.String())
+}
+
+func TestRender_TaskPaper(t *testing.T) {
+	outline := testOutline()
+	var buf bytes.Buffer
+
+	require.NoError(t, Render(&buf, FormatTaskPaper, &outline))
+
+	assert.Equal(t, "Release:\n"+
+		"\t- Weekly sync @due(2026-10-21)\n"+
+		"\tPrep:\n"+
+		"\t\t- Freeze branch @release @team_a @priority(1) @due(2026-10-20)\n"+
+		"\t\t\tAnnounce in #release\n"+
+		"\t\t\t- Run tests @due(2026-10-20 10:00)\n"+
+		"\t\t- Update docs @priority(3) @done\n"+
+		"\tShip:\n"+
+		"\t\t- Tag release @priority(2)\n", buf.String())
+
+	// 書き出したTaskPaperは取り込みで読み込める
+	tasks, err := ParseTaskPaper(&buf)
+	require.NoError(t, err)
+	require.Len(t, tasks, 4)
+	freeze := tasks[1]
+	assert.Equal(t, []string{"Release", "Prep"}, freeze.Project)
+	assert.Equal(t, "Announce in #release", freeze.Description)
+	assert.Equal(t, 4, freeze.Priority)
+	assert.Equal(t, "2026-10-20", freeze.Due)
+	require.Len(t, freeze.Subtasks, 1)
+	assert.Equal(t, "2026-10-20T10:00:00", freeze.Subtasks[0].Due)
+	assert.True(t, tasks[2].Completed)
+}
+
+func TestRender_UnsupportedFormat(t *testing.T) {
+	err := Render(&bytes.Buffer{}, "html", &Outline{Title: "Release"})
+
+	assert.ErrorContains(t, err, "unsupported format: html")
+}
@@ -0,0 +1,196 @@
+package plaintext
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Render は見出しとタスクを指定した形式で書き出す
+func Render(w io.Writer, format string, outline *Outline) error {
+	var b strings.Builder
+	switch format {
+	case FormatMarkdown:
+		renderMarkdown(&b, outline, 1)
+	case FormatOrg:
+		renderOrg(&b, outline, 0)
+	case FormatTaskPaper:
+		renderTaskPaper(&b, outline, 0)
+	default:
+		return fmt.Errorf("unsupported format: %s (supported: %s)", format, strings.Join(ExportFormats, ", "))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownPriorities はAPIの優先度に対応するMarkdown（Obsidian Tasks形式）の優先度の記号
+var markdownPriorities = map[int]string{4: "⏫", 3: "🔼", 2: "🔽"}
+
+// renderMarkdown は見出しを「#」、タスクをチェックボックスのリストで書き出す
+// 優先度・繰り返し・期限はObsidian Tasks形式の記号、ラベルは#tagとする
+func renderMarkdown(b *strings.Builder, outline *Outline, level int) {
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(b, "%s %s\n", strings.Repeat("#", level), outline.Title)
+	if len(outline.Tasks) > 0 {
+		b.WriteString("\n")
+		renderMarkdownTasks(b, outline.Tasks, 0)
+	}
+	for i := range outline.Sections {
+		renderMarkdown(b, &outline.Sections[i], level+1)
+	}
+}
+
+// renderMarkdownTasks はタスクとサブタスクを入れ子のチェックボックスで書き出す
+func renderMarkdownTasks(b *strings.Builder, tasks []Task, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for i := range tasks {
+		task := &tasks[i]
+		parts := []string{checkbox(task.Completed), task.Content}
+		for _, label := range task.Labels {
+			parts = append(parts, "#"+tagName(label, "-/"))
+		}
+		if symbol := markdownPriorities[task.Priority]; symbol != "" {
+			parts = append(parts, symbol)
+		}
+		if task.Recurrence != "" {
+			parts = append(parts, "🔁 "+task.Recurrence)
+		}
+		if task.Due != "" {
+			parts = append(parts, "📅 "+dueText(task.Due))
+		}
+		fmt.Fprintf(b, "%s- %s\n", indent, strings.Join(parts, " "))
+		writeLines(b, task.Description, indent+"  ")
+		renderMarkdownTasks(b, task.Subtasks, depth+1)
+	}
+}
+
+// orgPriorities はAPIの優先度に対応するOrgの優先度のクッキー
+var orgPriorities = map[int]string{4: "[#A]", 3: "[#B]", 2: "[#C]"}
+
+// renderOrg は見出しを「*」、タスクをチェックボックスのリストで書き出す
+// 最上位の見出しは#+TITLE、優先度は[#A]〜[#C]、期限はアクティブなタイムスタンプ、ラベルは:tag:とする
+func renderOrg(b *strings.Builder, outline *Outline, level int) {
+	if level == 0 {
+		fmt.Fprintf(b, "#+TITLE: %s\n", outline.Title)
+	} else {
+		fmt.Fprintf(b, "%s %s\n", strings.Repeat("*", level), outline.Title)
+	}
+	renderOrgTasks(b, outline.Tasks, 0)
+	for i := range outline.Sections {
+		renderOrg(b, &outline.Sections[i], level+1)
+	}
+}
+
+// renderOrgTasks はタスクとサブタスクを入れ子のチェックボックスで書き出す
+func renderOrgTasks(b *strings.Builder, tasks []Task, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for i := range tasks {
+		task := &tasks[i]
+		parts := []string{checkbox(task.Completed)}
+		if cookie := orgPriorities[task.Priority]; cookie != "" {
+			parts = append(parts, cookie)
+		}
+		parts = append(parts, task.Content)
+		if timestamp := orgTimestamp(task.Due); timestamp != "" {
+			parts = append(parts, timestamp)
+		}
+		if len(task.Labels) > 0 {
+			tags := make([]string, len(task.Labels))
+			for j, label := range task.Labels {
+				tags[j] = tagName(label, "@#%")
+			}
+			parts = append(parts, ":"+strings.Join(tags, ":")+":")
+		}
+		fmt.Fprintf(b, "%s- %s\n", indent, strings.Join(parts, " "))
+		writeLines(b, task.Description, indent+"  ")
+		renderOrgTasks(b, task.Subtasks, depth+1)
+	}
+}
+
+// orgTimestamp は期限をOrgのアクティブなタイムスタンプ（<2006-01-02 Mon 15:04>）にする
+func orgTimestamp(due string) string {
+	date, clock, _ := strings.Cut(dueText(due), " ")
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return ""
+	}
+	if clock == "" {
+		return day.Format("<2006-01-02 Mon>")
+	}
+	return day.Format("<2006-01-02 Mon ") + clock + ">"
+}
+
+// renderTaskPaper は見出しを「名前:」のプロジェクト、タスクを「- 」の行としてタブのインデントで書き出す
+// 期限は@due(...)、優先度は@priority(1〜3)、完了は@done、ラベルは@tagとする（ParseTaskPaperで読み込める形式）
+func renderTaskPaper(b *strings.Builder, outline *Outline, depth int) {
+	fmt.Fprintf(b, "%s%s:\n", strings.Repeat("\t", depth), outline.Title)
+	renderTaskPaperTasks(b, outline.Tasks, depth+1)
+	for i := range outline.Sections {
+		renderTaskPaper(b, &outline.Sections[i], depth+1)
+	}
+}
+
+// renderTaskPaperTasks はタスクとサブタスクをインデントを深くして書き出す
+func renderTaskPaperTasks(b *strings.Builder, tasks []Task, depth int) {
+	indent := strings.Repeat("\t", depth)
+	for i := range tasks {
+		task := &tasks[i]
+		parts := []string{"-", task.Content}
+		for _, label := range task.Labels {
+			parts = append(parts, "@"+tagName(label, "-"))
+		}
+		if task.Priority >= 2 && task.Priority <= 4 {
+			parts = append(parts, "@priority("+strconv.Itoa(5-task.Priority)+")")
+		}
+		if task.Due != "" {
+			parts = append(parts, "@due("+dueText(task.Due)+")")
+		}
+		if task.Completed {
+			parts = append(parts, "@done")
+		}
+		fmt.Fprintf(b, "%s%s\n", indent, strings.Join(parts, " "))
+		writeLines(b, task.Description, indent+"\t")
+		renderTaskPaperTasks(b, task.Subtasks, depth+1)
+	}
+}
+
+// checkbox は完了状態のチェックボックスを返す
+func checkbox(completed bool) string {
+	if completed {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+// dueText は期限を「YYYY-MM-DD」または「YYYY-MM-DD HH:MM」にする
+func dueText(due string) string {
+	date, clock, ok := strings.Cut(due, "T")
+	if !ok || len(clock) < len("15:04") {
+		return date
+	}
+	return date + " " + clock[:len("15:04")]
+}
+
+// tagName はラベルを文字・数字・_とextraの文字だけのタグ名にする（それ以外の文字は_に置き換える）
+func tagName(label, extra string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || strings.ContainsRune(extra, r) {
+			return r
+		}
+		return '_'
+	}, label)
+}
+
+// writeLines は複数行のテキストを空行を除いてインデントを付けて書き出す
+func writeLines(b *strings.Builder, text, indent string) {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			fmt.Fprintf(b, "%s%s\n", indent, line)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package plaintext
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// taskPaperTagPattern はTaskPaperのタグ（@tag または @tag(value)）
+var taskPaperTagPattern = regexp.MustCompile(`(?:^|\s)@([\w-]+)(?:\(([^)]*)\))?`)
+
+// taskPaperTag はTaskPaperのタグ
+type taskPaperTag struct {
+	name  string
+	value string
+}
+
+// taskPaperNode はTaskPaperのアウトラインの1行
+type taskPaperNode struct {
+	indent   int
+	project  []string // プロジェクトの行の階層
+	isTask   bool
+	task     Task
+	keyPath  []string // キーに使う親タスクからの内容の並び
+	children []*taskPaperNode
+}
+
+// ParseTaskPaper はTaskPaper形式のテキストからタスクを読み込む
+// 「名前:」の行をプロジェクト（インデントで階層）、「- 」の行をタスク（インデントでサブタスク）、それ以外の行を直前のタスクのノートとする
+// @due(日付)を期限、@priority(1〜4、1が最も高い)を優先度、@doneを完了、それ以外のタグをラベルとする
+func ParseTaskPaper(r io.Reader) ([]Task, error) {
+	var roots []*taskPaperNode
+	var stack []*taskPaperNode
+	keys := keyCounter{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "\ufeff")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		indent := taskPaperIndent(line)
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		var parent *taskPaperNode
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+		project := nearestTaskPaperProject(stack)
+
+		switch {
+		case trimmed == "-" || strings.HasPrefix(trimmed, "- "):
+			node := &taskPaperNode{indent: indent, isTask: true}
+			node.task = parseTaskPaperTask(strings.TrimSpace(strings.TrimPrefix(trimmed, "-")))
+			node.task.Project = project
+			if node.task.Content == "" {
+				continue
+			}
+			if parent != nil && parent.isTask {
+				node.keyPath = append(append([]string{}, parent.keyPath...), node.task.Content)
+				parent.children = append(parent.children, node)
+			} else {
+				node.keyPath = []string{node.task.Content}
+				roots = append(roots, node)
+			}
+			node.task.Key = keys.key(FormatTaskPaper, append([]string{strings.Join(project, "/")}, node.keyPath...)...)
+			stack = append(stack, node)
+
+		case isTaskPaperProject(trimmed):
+			name, _ := splitTaskPaperTags(trimmed)
+			name = strings.TrimSpace(strings.TrimSuffix(name, ":"))
+			path := append(append([]string{}, project...), name)
+			stack = append(stack, &taskPaperNode{indent: indent, project: path})
+
+		default:
+			// ノートは直前のタスクの説明に追加する
+			if parent != nil && parent.isTask {
+				if parent.task.Description != "" {
+					parent.task.Description += "\n"
+				}
+				parent.task.Description += trimmed
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return taskPaperTasks(roots), nil
+}
+
+// taskPaperIndent はインデントの幅を返す（タブは4文字分）
+func taskPaperIndent(line string) int {
+	width := 0
+	for _, r := range line {
+		switch r {
+		case '\t':
+			width += 4
+		case ' ':
+			width++
+		default:
+			return width
+		}
+	}
+	return width
+}
+
+// nearestTaskPaperProject はアウトラインを遡って最も近いプロジェクトの階層を返す
+func nearestTaskPaperProject(stack []*taskPaperNode) []string {
+	for i := len(stack) - 1; i >= 0; i-- {
+		if !stack[i].isTask {
+			return stack[i].project
+		}
+	}
+	return nil
+}
+
+// isTaskPaperProject はプロジェクトの行（タグを除いて「:」で終わる）かどうかを返す
+func isTaskPaperProject(line string) bool {
+	name, _ := splitTaskPaperTags(line)
+	return strings.HasSuffix(name, ":") && len(name) > 1
+}
+
+// splitTaskPaperTags は行からタグを取り除いた内容とタグを返す
+func splitTaskPaperTags(line string) (string, []taskPaperTag) {
+	var tags []taskPaperTag
+	for _, match := range taskPaperTagPattern.FindAllStringSubmatch(line, -1) {
+		tags = append(tags, taskPaperTag{name: match[1], value: strings.TrimSpace(match[2])})
+	}
+	content := taskPaperTagPattern.ReplaceAllString(line, "")
+	return strings.Join(strings.Fields(content), " "), tags
+}
+
+// parseTaskPaperTask はタスクの行の内容からタスクを作成する
+func parseTaskPaperTask(line string) Task {
+	content, tags := splitTaskPaperTags(line)
+	task := Task{Content: content}
+	for _, tag := range tags {
+		switch strings.ToLower(tag.name) {
+		case "done":
+			task.Completed = true
+		case "due":
+			task.Due = normalizeDue(tag.value)
+		case "priority":
+			if priority, err := strconv.Atoi(tag.value); err == nil && priority >= 1 && priority <= 4 {
+				task.Priority = 5 - priority
+			}
+		default:
+			task.Labels = append(task.Labels, tag.name)
+		}
+	}
+	return task
+}
+
+// taskPaperTasks はアウトラインのノードをタスクに変換する
+func taskPaperTasks(nodes []*taskPaperNode) []Task {
+	var tasks []Task
+	for _, node := range nodes {
+		task := node.task
+		task.Subtasks = taskPaperTasks(node.children)
+		tasks = append(tasks, task)
+	}
+	return tasks
+}
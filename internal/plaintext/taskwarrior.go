@@ -0,0 +1,113 @@
+package plaintext
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// taskwarriorTask は `task export` が出力するタスク
+type taskwarriorTask struct {
+	UUID        string   `json:"uuid"`
+	Description string   `json:"description"`
+	Project     string   `json:"project"`
+	Tags        []string `json:"tags"`
+	Priority    string   `json:"priority"`
+	Due         string   `json:"due"`
+	Status      string   `json:"status"`
+	Annotations []struct {
+		Description string `json:"description"`
+	} `json:"annotations"`
+}
+
+// taskwarriorPriorities はTaskwarriorの優先度（H/M/L）とAPIの優先度の対応
+var taskwarriorPriorities = map[string]int{"H": 4, "M": 3, "L": 2}
+
+// ParseTaskwarrior は `task export` のJSON（配列または1行1タスク）からタスクを読み込む
+// プロジェクトの階層（Home.Garden）をプロジェクト、タグをラベル、注釈を説明とする
+// 削除済みのタスクと繰り返しのテンプレートは読み込まない
+func ParseTaskwarrior(r io.Reader, loc *time.Location) ([]Task, error) {
+	exported, err := decodeTaskwarrior(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Taskwarrior export: %w", err)
+	}
+
+	var tasks []Task
+	for i := range exported {
+		tw := &exported[i]
+		if tw.Status == "deleted" || tw.Status == "recurring" || strings.TrimSpace(tw.Description) == "" {
+			continue
+		}
+
+		task := Task{
+			Key:       FormatTaskwarrior + ":" + tw.UUID,
+			Content:   strings.TrimSpace(tw.Description),
+			Labels:    tw.Tags,
+			Priority:  taskwarriorPriorities[tw.Priority],
+			Completed: tw.Status == "completed",
+		}
+		if tw.UUID == "" {
+			task.Key = FormatTaskwarrior + ":" + task.Content
+		}
+		if tw.Project != "" {
+			task.Project = strings.Split(tw.Project, ".")
+		}
+		if tw.Due != "" {
+			due, err := time.Parse("20060102T150405Z", tw.Due)
+			if err != nil {
+				return nil, fmt.Errorf("task %s: invalid due %q", tw.UUID, tw.Due)
+			}
+			task.Due = taskwarriorDue(due.In(loc))
+		}
+		var notes []string
+		for _, annotation := range tw.Annotations {
+			notes = append(notes, annotation.Description)
+		}
+		task.Description = strings.Join(notes, "\n")
+
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// decodeTaskwarrior はJSONの配列、または1行に1つのJSONオブジェクトを読み込む
+func decodeTaskwarrior(r io.Reader) ([]taskwarriorTask, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	if data[0] == '[' {
+		var tasks []taskwarriorTask
+		if err := json.Unmarshal(data, &tasks); err != nil {
+			return nil, err
+		}
+		return tasks, nil
+	}
+
+	var tasks []taskwarriorTask
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for decoder.More() {
+		var task taskwarriorTask
+		if err := decoder.Decode(&task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// taskwarriorDue はTaskwarriorの期限を期限の形式にする（0時ちょうどは日付のみの期限とみなす）
+func taskwarriorDue(due time.Time) string {
+	if due.Hour() == 0 && due.Minute() == 0 && due.Second() == 0 {
+		return due.Format("2006-01-02")
+	}
+	return due.Format("2006-01-02T15:04:05")
+}
@@ -0,0 +1,79 @@
+package plaintext
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// todoTxtPriorities はtodo.txtの優先度（A〜C）とAPIの優先度の対応
+var todoTxtPriorities = map[string]int{"A": 4, "B": 3, "C": 2}
+
+// ParseTodoTxt はtodo.txt形式のテキストからタスクを読み込む
+// 最初の+projectをプロジェクト、@contextと2つ目以降の+projectをラベル、(A)〜(C)とpri:を優先度、due:を期限とする
+func ParseTodoTxt(r io.Reader) ([]Task, error) {
+	var tasks []Task
+	keys := keyCounter{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+		if line == "" {
+			continue
+		}
+		task := parseTodoTxtLine(line)
+		if task.Content == "" {
+			continue
+		}
+		task.Key = keys.key(FormatTodoTxt, strings.Join(task.Project, "/"), task.Content)
+		tasks = append(tasks, task)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// parseTodoTxtLine はtodo.txtの1行をタスクに変換する
+func parseTodoTxtLine(line string) Task {
+	var task Task
+	fields := strings.Fields(line)
+
+	// 完了マーク・優先度・完了日・作成日
+	if len(fields) > 0 && fields[0] == "x" {
+		task.Completed = true
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && len(fields[0]) == 3 && fields[0][0] == '(' && fields[0][2] == ')' {
+		task.Priority = todoTxtPriorities[fields[0][1:2]]
+		fields = fields[1:]
+	}
+	for range 2 {
+		if len(fields) > 0 && isDate(fields[0]) {
+			fields = fields[1:]
+		}
+	}
+
+	var words []string
+	for _, field := range fields {
+		switch {
+		case len(field) > 1 && field[0] == '+':
+			if task.Project == nil {
+				task.Project = []string{field[1:]}
+			} else {
+				task.Labels = append(task.Labels, field[1:])
+			}
+		case len(field) > 1 && field[0] == '@':
+			task.Labels = append(task.Labels, field[1:])
+		case strings.HasPrefix(field, "due:") && normalizeDue(field[len("due:"):]) != "":
+			task.Due = normalizeDue(field[len("due:"):])
+		case strings.HasPrefix(field, "pri:") && todoTxtPriorities[field[len("pri:"):]] > 0:
+			// 完了時に優先度を pri:A として残す慣習に対応
+			task.Priority = todoTxtPriorities[field[len("pri:"):]]
+		default:
+			words = append(words, field)
+		}
+	}
+	task.Content = strings.Join(words, " ")
+	return task
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/backup"
+)
+
+// RestoreResult はバックアップからの復元結果
+type RestoreResult struct {
+	Projects int
+	Sections int
+	Tasks    int
+	Labels   int
+	Notes    int
+	Filters  int
+	IDs      backup.IDMap // バックアップ時のIDから作成したオブジェクトのIDへの対応
+	Response *api.SyncResponse
+}
+
+// CreateBackup はローカルに同期したデータからバックアップを作成する
+func (c *Repository) CreateBackup(_ context.Context) (*backup.Archive, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("local storage is disabled. Enable it in config to use backups")
+	}
+
+	archive := backup.New(time.Now())
+	var err error
+	if archive.Projects, err = c.storage.GetAllProjects(); err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	if archive.Sections, err = c.storage.GetAllSections(); err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+	if archive.Tasks, err = c.storage.GetTasks(); err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	if archive.Labels, err = c.storage.GetLabels(); err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+	if archive.Notes, err = c.storage.GetAllNotes(); err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	if archive.Filters, err = c.storage.GetFilters(); err != nil {
+		return nil, fmt.Errorf("failed to get filters: %w", err)
+	}
+	return archive, nil
+}
+
+// PlanRestore はバックアップと現在のローカルのデータを比較して復元の計画を作成する
+func (c *Repository) PlanRestore(ctx context.Context, archive *backup.Archive, ids backup.IDMap, projectID string) (*backup.Plan, error) {
+	current, err := c.CreateBackup(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return backup.NewPlan(archive, current, ids, projectID)
+}
+
+// RestoreBackup は復元の計画に従って現在のデータにないオブジェクトを作成する
+// 全てのコマンドをtemp_idで関連付けてまとめて送信する
+func (c *Repository) RestoreBackup(ctx context.Context, plan *backup.Plan) (*RestoreResult, error) {
+	if plan.Count() == 0 {
+		return nil, fmt.Errorf("nothing to restore")
+	}
+
+	commands, tempIDs, err := restoreCommands(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	// API実行（まとめて送信）
+	resp, execErr := c.executeCreateCommands(ctx, commands)
+	if resp == nil {
+		return nil, execErr
+	}
+
+	result := &RestoreResult{
+		Projects: len(plan.Projects),
+		Sections: len(plan.Sections),
+		Tasks:    len(plan.Tasks),
+		Labels:   len(plan.Labels),
+		Notes:    len(plan.Notes),
+		Filters:  len(plan.Filters),
+		IDs:      backup.IDMap{},
+		Response: resp,
+	}
+	for oldID, tempID := range tempIDs {
+		if id := resp.TempIDMapping[tempID]; id != "" {
+			result.IDs[oldID] = id
+		}
+	}
+
+	// 復元したプロジェクトはプロジェクトごと、既存のプロジェクトに復元したタスクはタスクごと取り消せる
+	restored := make(map[string]bool, len(tempIDs))
+	for oldID := range tempIDs {
+		restored[oldID] = true
+	}
+	var steps []undoStep
+	for i := range plan.Projects {
+		project := plan.Projects[i]
+		if id := result.IDs[project.ID]; id != "" && !restored[project.ParentID] {
+			steps = append(steps, undoStep{Type: api.CommandProjectAdd, ProjectID: id, Label: project.Name})
+		}
+	}
+	for i := range plan.Tasks {
+		task := plan.Tasks[i]
+		if id := result.IDs[task.ID]; id != "" && !restored[task.ProjectID] && !restored[task.ParentID] {
+			steps = append(steps, undoStep{Type: api.CommandItemAdd, TaskID: id, Label: task.Content})
+		}
+	}
+	c.recordUndo(steps...)
+
+	// 増分同期で復元したデータをローカルに反映
+	c.reconcile(ctx, steps...)
+
+	return result, execErr
+}
+
+// restoreCommands は復元の計画から作成コマンドを構築する
+// バックアップ時のIDからtemp_idへの対応も返す
+func restoreCommands(plan *backup.Plan) ([]api.Command, map[string]string, error) {
+	// refs はバックアップ時のIDからコマンドで参照するID（temp_idまたは現在のID）への対応
+	refs := make(map[string]string, len(plan.Existing))
+	for oldID, id := range plan.Existing {
+		refs[oldID] = id
+	}
+	tempIDs := make(map[string]string)
+	var commands []api.Command
+	add := func(oldID string, cmd api.Command) {
+		refs[oldID] = cmd.TempID
+		tempIDs[oldID] = cmd.TempID
+		commands = append(commands, cmd)
+	}
+
+	// ラベルはタスクより先に作成する（タスク作成時に自動で作られる色なしのラベルを避ける）
+	for i := range plan.Labels {
+		label := plan.Labels[i]
+		cmd, err := api.NewCreateLabelCommand(&api.CreateLabelRequest{
+			Name:       label.Name,
+			Color:      label.Color,
+			ItemOrder:  label.ItemOrder,
+			IsFavorite: label.IsFavorite,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("label %q: %w", label.Name, err)
+		}
+		add(label.ID, cmd)
+	}
+
+	for i := range plan.Projects {
+		project := plan.Projects[i]
+		cmd, err := api.NewCreateProjectCommand(&api.CreateProjectRequest{
+			Name:       project.Name,
+			ParentID:   refs[project.ParentID],
+			Color:      project.Color,
+			IsFavorite: project.IsFavorite,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("project %q: %w", project.Name, err)
+		}
+		add(project.ID, cmd)
+	}
+
+	for i := range plan.Sections {
+		section := plan.Sections[i]
+		cmd, err := api.NewCreateSectionCommand(&api.CreateSectionRequest{
+			Name:      section.Name,
+			ProjectID: refs[section.ProjectID],
+			Order:     section.SectionOrder,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("section %q: %w", section.Name, err)
+		}
+		add(section.ID, cmd)
+	}
+
+	// タスクはプロジェクトが異なるため1件ずつ構築する（親タスクは先に構築したtemp_idを参照する）
+	for i := range plan.Tasks {
+		task := plan.Tasks[i]
+		cmds, err := copyTaskCommands([]api.Item{task}, refs[task.ProjectID], refs[task.SectionID], nil, refs, 0)
+		if err != nil {
+			return nil, nil, fmt.Errorf("task %q: %w", task.Content, err)
+		}
+		add(task.ID, cmds[0])
+	}
+
+	for i := range plan.Notes {
+		note := plan.Notes[i]
+		cmd, err := api.NewCreateNoteCommand(&api.CreateNoteRequest{ItemID: refs[note.ItemID], Content: note.Content})
+		if err != nil {
+			return nil, nil, fmt.Errorf("comment: %w", err)
+		}
+		add(note.ID, cmd)
+	}
+
+	for i := range plan.Filters {
+		filter := plan.Filters[i]
+		cmd, err := api.NewCreateFilterCommand(&api.CreateFilterRequest{
+			Name:       filter.Name,
+			Query:      filter.Query,
+			Color:      filter.Color,
+			IsFavorite: filter.IsFavorite,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("filter %q: %w", filter.Name, err)
+		}
+		add(filter.ID, cmd)
+	}
+
+	return commands, tempIDs, nil
+}
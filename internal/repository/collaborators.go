@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// CurrentUserRef は共同作業者の指定でログイン中のユーザーを表す
+const CurrentUserRef = "me"
+
+// ErrCollaboratorNotFound は共同作業者が見つからない場合のエラー
+var ErrCollaboratorNotFound = errors.New("collaborator not found")
+
+// GetCollaborators は共同作業者・参加状態・ログイン中のユーザーを取得する（ローカル優先）
+func (c *Repository) GetCollaborators(ctx context.Context) (*api.CollaboratorList, error) {
+	if !c.config.Enabled {
+		return c.apiClient.GetAllCollaborators(ctx)
+	}
+
+	// ローカルから高速取得
+	collaborators, err := c.storage.GetCollaborators()
+	if err != nil {
+		return nil, err
+	}
+	states, err := c.storage.GetCollaboratorStates("")
+	if err != nil {
+		return nil, err
+	}
+	currentUserID, err := c.storage.GetCurrentUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	list := &api.CollaboratorList{Collaborators: collaborators, States: states}
+	for i := range collaborators {
+		if collaborators[i].ID == currentUserID {
+			list.CurrentUser = &api.User{
+				ID:       collaborators[i].ID,
+				Email:    collaborators[i].Email,
+				FullName: collaborators[i].FullName,
+				Timezone: collaborators[i].Timezone,
+			}
+			break
+		}
+	}
+	return list, nil
+}
+
+// FindCollaborator は「me」・ID・メールアドレス・名前で共同作業者を検索する
+// 名前は大文字小文字を区別せず、完全一致が無い場合は一意に定まる部分一致を使う
+// projectIDを指定した場合はそのプロジェクトに参加している共同作業者から検索する
+func (c *Repository) FindCollaborator(ctx context.Context, ref, projectID string) (*api.Collaborator, error) {
+	list, err := c.GetCollaborators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collaborators: %w", err)
+	}
+	return findCollaborator(list, ref, projectID)
+}
+
+// findCollaborator は共同作業者の一覧から指定に一致する共同作業者を返す
+func findCollaborator(list *api.CollaboratorList, ref, projectID string) (*api.Collaborator, error) {
+	if strings.EqualFold(ref, CurrentUserRef) {
+		if list.CurrentUser == nil {
+			return nil, fmt.Errorf("%w: current user is not synced yet (run 'gotodoist sync')", ErrCollaboratorNotFound)
+		}
+		ref = list.CurrentUser.ID
+	}
+
+	candidates := list.Collaborators
+	if projectID != "" {
+		members := make(map[string]bool)
+		for i := range list.States {
+			if list.States[i].ProjectID == projectID && list.States[i].State == api.CollaboratorStateActive {
+				members[list.States[i].UserID] = true
+			}
+		}
+		candidates = nil
+		for i := range list.Collaborators {
+			if members[list.Collaborators[i].ID] {
+				candidates = append(candidates, list.Collaborators[i])
+			}
+		}
+	}
+
+	// ID・メールアドレス・名前の完全一致
+	for i := range candidates {
+		if candidates[i].ID == ref || strings.EqualFold(candidates[i].Email, ref) || strings.EqualFold(candidates[i].FullName, ref) {
+			return &candidates[i], nil
+		}
+	}
+
+	// 名前の部分一致（一意の場合のみ）
+	var matches []*api.Collaborator
+	lowerRef := strings.ToLower(ref)
+	for i := range candidates {
+		if strings.Contains(strings.ToLower(candidates[i].FullName), lowerRef) {
+			matches = append(matches, &candidates[i])
+		}
+	}
+	switch len(matches) {
+	case 0:
+		if projectID != "" {
+			return nil, fmt.Errorf("%w: %s is not a member of the project", ErrCollaboratorNotFound, ref)
+		}
+		return nil, fmt.Errorf("%w: %s", ErrCollaboratorNotFound, ref)
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, len(matches))
+		for i, match := range matches {
+			names[i] = fmt.Sprintf("%s <%s>", match.DisplayName(), match.Email)
+		}
+		return nil, fmt.Errorf("collaborator %q is ambiguous: %s", ref, strings.Join(names, ", "))
+	}
+}
+
+// ProjectMember はプロジェクトの参加者（招待中を含む）
+type ProjectMember struct {
+	api.Collaborator
+	State         string // api.CollaboratorStateActive または api.CollaboratorStateInvited
+	IsCurrentUser bool
+}
+
+// IsInvited は招待を承諾していない参加者かを返す
+func (m *ProjectMember) IsInvited() bool {
+	return m.State == api.CollaboratorStateInvited
+}
+
+// Label は表示用の名前を返す（名前・メールアドレスが未同期の場合はID）
+func (m *ProjectMember) Label() string {
+	if name := m.DisplayName(); name != "" {
+		return name
+	}
+	return m.ID
+}
+
+// GetProjectMembers はプロジェクトの参加者と招待中のユーザーを取得する
+// 参加中の共同作業者を先に、招待中のユーザーを後に並べる
+func (c *Repository) GetProjectMembers(ctx context.Context, projectID string) ([]ProjectMember, error) {
+	list, err := c.GetCollaborators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collaborators: %w", err)
+	}
+	return projectMembers(list, projectID), nil
+}
+
+// projectMembers は共同作業者の一覧からプロジェクトの参加者を抽出する
+func projectMembers(list *api.CollaboratorList, projectID string) []ProjectMember {
+	collaborators := make(map[string]api.Collaborator, len(list.Collaborators))
+	for i := range list.Collaborators {
+		collaborators[list.Collaborators[i].ID] = list.Collaborators[i]
+	}
+
+	var members []ProjectMember
+	for i := range list.States {
+		state := list.States[i]
+		if state.ProjectID != projectID || state.IsDeleted {
+			continue
+		}
+		collaborator, ok := collaborators[state.UserID]
+		if !ok {
+			// 共同作業者の情報が未同期の場合はIDのみで表示する
+			collaborator = api.Collaborator{ID: state.UserID}
+		}
+		members = append(members, ProjectMember{
+			Collaborator:  collaborator,
+			State:         state.State,
+			IsCurrentUser: list.CurrentUser != nil && list.CurrentUser.ID == state.UserID,
+		})
+	}
+
+	sort.SliceStable(members, func(i, j int) bool {
+		if members[i].IsInvited() != members[j].IsInvited() {
+			return !members[i].IsInvited()
+		}
+		return strings.ToLower(members[i].Label()) < strings.ToLower(members[j].Label())
+	})
+	return members
+}
+
+// ShareProject はプロジェクトをメールアドレスで指定したユーザーに共有する（API実行 + ローカル反映）
+func (c *Repository) ShareProject(ctx context.Context, projectID, email string) (*api.SyncResponse, error) {
+	// API実行
+	resp, err := c.apiClient.ShareProject(ctx, projectID, email)
+	if err != nil {
+		return nil, err
+	}
+	step := c.captureProjectStep(api.CommandShareProject, projectID)
+	step.Email = email
+	c.recordUndo(step)
+
+	// 招待中の参加状態は増分同期で取り込む
+	c.reconcile(ctx)
+
+	return resp, nil
+}
+
+// UnshareProject はプロジェクトから共同作業者を外す、または招待を取り消す（API実行 + ローカル反映）
+func (c *Repository) UnshareProject(ctx context.Context, projectID, email string) (*api.SyncResponse, error) {
+	// API実行
+	resp, err := c.apiClient.UnshareProject(ctx, projectID, email)
+	if err != nil {
+		return nil, err
+	}
+	step := c.captureProjectStep(api.CommandDeleteCollaborator, projectID)
+	step.Email = email
+	c.recordUndo(step)
+
+	// 削除された参加状態は増分同期で取り込む
+	c.reconcile(ctx)
+
+	return resp, nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func TestFindCollaborator(t *testing.T) {
+	list := &api.CollaboratorList{
+		Collaborators: []api.Collaborator{
+			{ID: "u1", Email: "me@example.com", FullName: "Taro Yamada"},
+			{ID: "u2", Email: "alice@example.com", FullName: "Alice Smith"},
+			{ID: "u3", Email: "alicia@example.com", FullName: "Alicia Keys"},
+			{ID: "u4", Email: "bob@example.com", FullName: "Bob Brown"},
+		},
+		States: []api.CollaboratorState{
+			{ProjectID: "p1", UserID: "u1", State: api.CollaboratorStateActive},
+			{ProjectID: "p1", UserID: "u2", State: api.CollaboratorStateActive},
+			{ProjectID: "p1", UserID: "u4", State: api.CollaboratorStateInvited},
+		},
+		CurrentUser: &api.User{ID: "u1", Email: "me@example.com", FullName: "Taro Yamada"},
+	}
+
+	tests := []struct {
+		name        string
+		ref         string
+		projectID   string
+		expectedID  string
+		expectError string
+	}{
+		{name: "me", ref: "me", expectedID: "u1"},
+		{name: "ID", ref: "u4", expectedID: "u4"},
+		{name: "メールアドレス（大文字小文字無視）", ref: "Alice@Example.com", expectedID: "u2"},
+		{name: "名前の完全一致", ref: "bob brown", expectedID: "u4"},
+		{name: "名前の一意な部分一致", ref: "keys", expectedID: "u3"},
+		{name: "名前の部分一致が曖昧", ref: "ali", expectError: "ambiguous"},
+		{name: "プロジェクト内で一意になる部分一致", ref: "ali", projectID: "p1", expectedID: "u2"},
+		{name: "招待中はプロジェクトのメンバーではない", ref: "bob@example.com", projectID: "p1", expectError: "not a member"},
+		{name: "見つからない", ref: "carol", expectError: "collaborator not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collaborator, err := findCollaborator(list, tt.ref, tt.projectID)
+
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedID, collaborator.ID)
+		})
+	}
+}
+
+func TestFindCollaborator_CurrentUserNotSynced(t *testing.T) {
+	_, err := findCollaborator(&api.CollaboratorList{}, "me", "")
+
+	assert.ErrorIs(t, err, ErrCollaboratorNotFound)
+}
+
+func TestProjectMembers(t *testing.T) {
+	list := &api.CollaboratorList{
+		Collaborators: []api.Collaborator{
+			{ID: "u1", Email: "me@example.com", FullName: "Taro Yamada"},
+			{ID: "u2", Email: "alice@example.com", FullName: "Alice Smith"},
+			{ID: "u4", Email: "bob@example.com", FullName: "Bob Brown"},
+		},
+		States: []api.CollaboratorState{
+			{ProjectID: "p1", UserID: "u4", State: api.CollaboratorStateInvited},
+			{ProjectID: "p1", UserID: "u1", State: api.CollaboratorStateActive},
+			{ProjectID: "p1", UserID: "u2", State: api.CollaboratorStateActive},
+			{ProjectID: "p1", UserID: "u5", State: api.CollaboratorStateActive},
+			{ProjectID: "p2", UserID: "u2", State: api.CollaboratorStateActive},
+		},
+		CurrentUser: &api.User{ID: "u1"},
+	}
+
+	members := projectMembers(list, "p1")
+
+	// 参加中（名前順）→ 招待中の順に並び、未同期の共同作業者もIDで含まれる
+	require.Len(t, members, 4)
+	ids := make([]string, len(members))
+	for i := range members {
+		ids[i] = members[i].ID
+	}
+	assert.Equal(t, []string{"u2", "u1", "u5", "u4"}, ids)
+	assert.True(t, members[1].IsCurrentUser)
+	assert.False(t, members[0].IsCurrentUser)
+	assert.Equal(t, "u5", members[2].Label())
+	assert.True(t, members[3].IsInvited())
+	assert.False(t, members[0].IsInvited())
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// completedHistoryOverlap は前回取得した期間の終わりと重ねて再取得する時間
+// 前回取得の直前に完了したタスクの反映遅れを取りこぼさないようにする
+const completedHistoryOverlap = time.Hour
+
+// GetCompletedTasks は指定日時以降に完了したタスクを完了日時の新しい順に取得する
+// ローカルストレージが有効な場合は未取得の期間のみAPIから取得してローカルに保存する
+func (c *Repository) GetCompletedTasks(ctx context.Context, since time.Time, projectID string) ([]api.Item, error) {
+	if !c.shouldWriteLocal() {
+		// ローカルストレージに保存しない場合はAPIから直接取得
+		tasks, err := c.apiClient.GetCompletedTasks(ctx, &api.CompletedTasksRequest{Since: since, ProjectID: projectID})
+		if err != nil {
+			return nil, err
+		}
+		sortCompletedTasks(tasks)
+		return tasks, nil
+	}
+
+	if err := c.syncCompletedTasks(ctx, since); err != nil {
+		return nil, err
+	}
+	return c.storage.GetCompletedTasks(since, projectID)
+}
+
+// syncCompletedTasks は完了済みタスクの履歴をAPIから取得してローカルに保存する
+// 取得済みの期間は記録しておき、次回以降は差分の期間だけを取得する
+// 完了は履歴に記録し、未完了のタスクとしてローカルに無いタスクのみタスクとしても保存する
+func (c *Repository) syncCompletedTasks(ctx context.Context, since time.Time) error {
+	now := time.Now()
+	fetchFrom := since
+	historySince := since
+
+	fetchedSince, fetchedUntil, err := c.storage.GetCompletedHistoryRange()
+	if err == nil && !since.Before(fetchedSince) {
+		historySince = fetchedSince
+		if resume := fetchedUntil.Add(-completedHistoryOverlap); resume.After(fetchFrom) {
+			fetchFrom = resume
+		}
+	}
+
+	tasks, err := c.apiClient.GetCompletedTasks(ctx, &api.CompletedTasksRequest{Since: fetchFrom, Until: now})
+	if err != nil {
+		return fmt.Errorf("failed to fetch completed tasks: %w", err)
+	}
+
+	for i := range tasks {
+		// 削除・アーカイブ済みなどローカルに無いプロジェクトのタスクは保存できないためスキップする
+		project, err := c.storage.GetProjectByID(tasks[i].ProjectID)
+		if err != nil || project == nil {
+			if c.verbose {
+				log.Printf("Skipping completed task %s: project %s is not in local storage", tasks[i].ID, tasks[i].ProjectID)
+			}
+			continue
+		}
+		if err := c.storage.InsertCompletedTask(tasks[i]); err != nil {
+			return fmt.Errorf("failed to store completed task: %w", err)
+		}
+
+		// 繰り返しタスクの完了は未完了のタスクと同じIDのため、未完了のタスクは上書きしない
+		open, err := c.storage.IsOpenTask(tasks[i].ID)
+		if err != nil {
+			return err
+		}
+		if open {
+			continue
+		}
+		if err := c.storage.InsertTask(tasks[i]); err != nil {
+			return fmt.Errorf("failed to store completed task: %w", err)
+		}
+	}
+
+	if err := c.storage.SetCompletedHistoryRange(historySince, now); err != nil {
+		log.Printf("Failed to record completed task history range: %v", err)
+	}
+	return nil
+}
+
+// sortCompletedTasks は完了済みタスクを完了日時の新しい順に並べ替える
+func sortCompletedTasks(tasks []api.Item) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].DateCompleted == nil || tasks[j].DateCompleted == nil {
+			return tasks[i].DateCompleted != nil
+		}
+		return tasks[i].DateCompleted.After(tasks[j].DateCompleted.Time)
+	})
+}
@@ -10,14 +10,19 @@ type Config struct {
 	Enabled            bool   `yaml:"enabled" mapstructure:"enabled"`
 	DatabasePath       string `yaml:"database_path" mapstructure:"database_path"`
 	InitialSyncOnStart bool   `yaml:"initial_sync_on_startup" mapstructure:"initial_sync_on_startup"`
+	TrashRetentionDays int    `yaml:"trash_retention_days" mapstructure:"trash_retention_days"` // 0の場合は自動で削除しない
 }
 
+// defaultTrashRetentionDays は削除したタスク・プロジェクトをゴミ箱に保持する既定の日数
+const defaultTrashRetentionDays = 30
+
 // DefaultConfig はデフォルトのローカルストレージ設定を返す
 func DefaultConfig() *Config {
 	return &Config{
 		Enabled:            true,
 		DatabasePath:       getDefaultDatabasePath(),
 		InitialSyncOnStart: true,
+		TrashRetentionDays: defaultTrashRetentionDays,
 	}
 }
 
@@ -0,0 +1,328 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// DueShift は複製・コピーしたタスクの期限をずらす指定
+// 繰り返しの期限はずらさずにそのまま引き継ぐ
+type DueShift struct {
+	Days  int    // 期限をずらす日数
+	Start string // 最も早い期限をこの日付（YYYY-MM-DD）に合わせる（指定した場合はDaysより優先）
+}
+
+// days は実際にずらす日数を返す
+func (s DueShift) days(tasks []api.Item) (int, error) {
+	if s.Start == "" {
+		return s.Days, nil
+	}
+
+	start, err := time.Parse("2006-01-02", s.Start)
+	if err != nil {
+		return 0, fmt.Errorf("invalid start date %q (use YYYY-MM-DD)", s.Start)
+	}
+
+	// 繰り返しでない期限のうち最も早い日付を基準にする
+	var earliest time.Time
+	for i := range tasks {
+		due := tasks[i].Due
+		if due == nil || due.IsRecurring || len(due.Date) < len("2006-01-02") {
+			continue
+		}
+		day, err := time.Parse("2006-01-02", due.Date[:len("2006-01-02")])
+		if err != nil {
+			continue
+		}
+		if earliest.IsZero() || day.Before(earliest) {
+			earliest = day
+		}
+	}
+	if earliest.IsZero() {
+		return 0, nil
+	}
+	return int(start.Sub(earliest).Hours() / 24), nil
+}
+
+// shiftDate は日付（YYYY-MM-DD、時刻付きも可）を指定日数ずらす
+// 時刻部分はそのまま残す
+func shiftDate(value string, days int) (string, error) {
+	if days == 0 {
+		return value, nil
+	}
+
+	const layout = "2006-01-02"
+	if len(value) < len(layout) {
+		return "", fmt.Errorf("invalid date %q", value)
+	}
+	day, err := time.Parse(layout, value[:len(layout)])
+	if err != nil {
+		return "", fmt.Errorf("invalid date %q", value)
+	}
+	return day.AddDate(0, 0, days).Format(layout) + value[len(layout):], nil
+}
+
+// copyTaskCommands はタスクのコピーを作成するコマンドを構築する（tasksは親から順に並んでいること）
+// 親タスクも一緒にコピーする場合は親のtemp_idを参照し、コピー元のIDからtemp_idへの対応をtempIDsに追加する
+// sectionIDsを指定した場合はコピー元のセクションを対応するセクションに置き換え、指定しない場合はsectionIDに作成する
+func copyTaskCommands(tasks []api.Item, projectID, sectionID string, sectionIDs, tempIDs map[string]string, shiftDays int) ([]api.Command, error) {
+	var commands []api.Command
+	for i := range tasks {
+		task := tasks[i]
+
+		req := &api.CreateTaskRequest{
+			Content:     task.Content,
+			Description: task.Description,
+			ProjectID:   projectID,
+			SectionID:   sectionID,
+			ParentID:    tempIDs[task.ParentID],
+			Order:       task.ChildOrder,
+			Labels:      task.Labels,
+			Priority:    task.Priority,
+			Duration:    task.Duration,
+		}
+		if sectionIDs != nil {
+			req.SectionID = sectionIDs[task.SectionID]
+		}
+		if task.Deadline != nil {
+			deadline, err := shiftDate(task.Deadline.Date, shiftDays)
+			if err != nil {
+				return nil, err
+			}
+			req.DeadlineDate = deadline
+		}
+
+		cmd, err := api.NewCreateTaskCommand(req)
+		if err != nil {
+			return nil, err
+		}
+		if task.Due != nil {
+			due := *task.Due
+			if !due.IsRecurring {
+				if due.Date, err = shiftDate(due.Date, shiftDays); err != nil {
+					return nil, err
+				}
+			}
+			cmd.Args["due"] = dueArgs(&due)
+		}
+
+		tempIDs[task.ID] = cmd.TempID
+		commands = append(commands, cmd)
+	}
+	return commands, nil
+}
+
+// openTasksParentFirst は未完了のタスクを親から順に並べて返す
+func openTasksParentFirst(tasks []api.Item) []api.Item {
+	var open []api.Item
+	for i := range tasks {
+		if tasks[i].DateCompleted == nil {
+			open = append(open, tasks[i])
+		}
+	}
+	return sortTasksParentFirst(open)
+}
+
+// executeCreateCommands は作成コマンドをまとめて送信し、失敗したコマンドがあればエラーを返す
+func (c *Repository) executeCreateCommands(ctx context.Context, commands []api.Command) (*api.SyncResponse, error) {
+	resp, err := c.apiClient.ExecuteCommands(ctx, commands)
+	if err != nil {
+		return resp, err
+	}
+
+	failed := 0
+	var firstErr error
+	for _, cmd := range commands {
+		if cmdErr := resp.CommandError(cmd.UUID); cmdErr != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = cmdErr
+			}
+		}
+	}
+	if failed > 0 {
+		return resp, fmt.Errorf("%d of %d commands failed: %w", failed, len(commands), firstErr)
+	}
+	return resp, nil
+}
+
+// DuplicateProjectOptions はプロジェクト複製の指定
+type DuplicateProjectOptions struct {
+	Name     string   // 複製したプロジェクトの名前
+	ParentID string   // 複製したプロジェクトの親プロジェクト（空の場合はルート）
+	DueShift DueShift // 期限をずらす指定
+}
+
+// DuplicateResult はプロジェクト複製の結果
+type DuplicateResult struct {
+	ProjectID string // 複製したプロジェクトのID
+	Sections  int
+	Tasks     int
+	Response  *api.SyncResponse
+}
+
+// DuplicateProject はプロジェクトをセクション・未完了のタスク（サブタスクを含む）ごと複製する
+// 全てのコマンドをtemp_idで関連付け、まとめて送信する
+func (c *Repository) DuplicateProject(ctx context.Context, projectID string, opts DuplicateProjectOptions) (*DuplicateResult, error) {
+	projects, err := c.GetAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	var source *api.Project
+	for i := range projects {
+		if projects[i].ID == projectID {
+			source = &projects[i]
+			break
+		}
+	}
+	if source == nil {
+		return nil, fmt.Errorf("project not found: %s", projectID)
+	}
+
+	allSections, err := c.GetAllSections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+	tasks, err := c.GetTasksByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	tasks = openTasksParentFirst(tasks)
+	shiftDays, err := opts.DueShift.days(tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. プロジェクト
+	projectCmd, err := api.NewCreateProjectCommand(&api.CreateProjectRequest{
+		Name:     opts.Name,
+		ParentID: opts.ParentID,
+		Color:    source.Color,
+	})
+	if err != nil {
+		return nil, err
+	}
+	commands := []api.Command{projectCmd}
+	result := &DuplicateResult{}
+
+	// 2. セクション
+	sectionIDs := map[string]string{"": ""}
+	for i := range allSections {
+		section := allSections[i]
+		if section.ProjectID != projectID {
+			continue
+		}
+		cmd, err := api.NewCreateSectionCommand(&api.CreateSectionRequest{
+			Name:      section.Name,
+			ProjectID: projectCmd.TempID,
+			Order:     section.SectionOrder,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sectionIDs[section.ID] = cmd.TempID
+		commands = append(commands, cmd)
+		result.Sections++
+	}
+
+	// 3. タスク（親から順に作成し、サブタスクは親のtemp_idを参照する）
+	taskCommands, err := copyTaskCommands(tasks, projectCmd.TempID, "", sectionIDs, make(map[string]string), shiftDays)
+	if err != nil {
+		return nil, err
+	}
+	commands = append(commands, taskCommands...)
+	result.Tasks = len(taskCommands)
+
+	// API実行（まとめて送信）
+	resp, execErr := c.executeCreateCommands(ctx, commands)
+	if resp == nil {
+		return nil, execErr
+	}
+	result.Response = resp
+	result.ProjectID = resp.TempIDMapping[projectCmd.TempID]
+
+	// 複製したプロジェクトを削除すれば取り消せる
+	step := undoStep{Type: api.CommandProjectAdd, ProjectID: result.ProjectID, Label: opts.Name}
+	if step.ProjectID != "" {
+		c.recordUndo(step)
+	}
+
+	// 増分同期で複製したプロジェクトをローカルに反映
+	c.reconcile(ctx, step)
+
+	return result, execErr
+}
+
+// CopyTaskOptions はタスクコピーの指定
+type CopyTaskOptions struct {
+	ProjectID string   // コピー先のプロジェクト
+	SectionID string   // コピー先のセクション（空の場合はセクションなし）
+	DueShift  DueShift // 期限をずらす指定
+}
+
+// CopyTaskResult はタスクコピーの結果
+type CopyTaskResult struct {
+	TaskID   string // コピーしたタスク（最上位）のID
+	Tasks    int    // コピーしたタスク数（サブタスクを含む）
+	Response *api.SyncResponse
+}
+
+// CopyTask はタスクを未完了のサブタスクごと別のプロジェクト・セクションにコピーする
+// サブタスクは親のtemp_idを参照し、並び順・ラベル・説明を保ったまままとめて送信する
+func (c *Repository) CopyTask(ctx context.Context, taskID string, opts CopyTaskOptions) (*CopyTaskResult, error) {
+	allTasks, err := c.GetTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	tree := collectTaskTree(allTasks, taskID)
+	if len(tree) == 0 {
+		return nil, fmt.Errorf("task not found: %s", taskID)
+	}
+
+	// 完了済みのサブタスク（とその子孫）はコピーしない
+	tasks := tree[:1]
+	copied := map[string]bool{taskID: true}
+	for i := range tree[1:] {
+		task := tree[i+1]
+		if task.DateCompleted == nil && copied[task.ParentID] {
+			tasks = append(tasks, task)
+			copied[task.ID] = true
+		}
+	}
+
+	shiftDays, err := opts.DueShift.days(tasks)
+	if err != nil {
+		return nil, err
+	}
+	commands, err := copyTaskCommands(tasks, opts.ProjectID, opts.SectionID, nil, make(map[string]string), shiftDays)
+	if err != nil {
+		return nil, err
+	}
+	// コピーしたタスク自体はコピー先の末尾に追加する
+	delete(commands[0].Args, "child_order")
+
+	// API実行（まとめて送信）
+	resp, execErr := c.executeCreateCommands(ctx, commands)
+	if resp == nil {
+		return nil, execErr
+	}
+	result := &CopyTaskResult{
+		TaskID:   resp.TempIDMapping[commands[0].TempID],
+		Tasks:    len(commands),
+		Response: resp,
+	}
+
+	// コピーしたタスクを削除すればサブタスクごと取り消せる
+	step := undoStep{Type: api.CommandItemAdd, TaskID: result.TaskID, Label: tasks[0].Content}
+	if step.TaskID != "" {
+		c.recordUndo(step)
+	}
+
+	// 増分同期でコピーしたタスクをローカルに反映
+	c.reconcile(ctx, step)
+
+	return result, execErr
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// ErrFilterNotFound は保存済みフィルタが見つからない場合のエラー
+var ErrFilterNotFound = errors.New("filter not found")
+
+// GetFilters は保存済みフィルタを取得する（ローカル優先）
+func (c *Repository) GetFilters(ctx context.Context) ([]api.Filter, error) {
+	if !c.config.Enabled {
+		return c.apiClient.GetAllFilters(ctx)
+	}
+
+	// ローカルから高速取得
+	return c.storage.GetFilters()
+}
+
+// FindFilter は名前（大文字小文字を区別しない）またはIDで保存済みフィルタを検索する
+func (c *Repository) FindFilter(ctx context.Context, nameOrID string) (*api.Filter, error) {
+	if c.config.Enabled {
+		filter, err := c.storage.GetFilterByName(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if filter == nil {
+			return nil, fmt.Errorf("%w: %s", ErrFilterNotFound, nameOrID)
+		}
+		return filter, nil
+	}
+
+	filters, err := c.apiClient.GetAllFilters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range filters {
+		if filters[i].ID == nameOrID {
+			return &filters[i], nil
+		}
+	}
+	for i := range filters {
+		if strings.EqualFold(filters[i].Name, nameOrID) {
+			return &filters[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrFilterNotFound, nameOrID)
+}
+
+// CreateFilter は保存済みフィルタを作成する（API実行 + ローカル反映）
+func (c *Repository) CreateFilter(ctx context.Context, req *api.CreateFilterRequest) (*api.SyncResponse, error) {
+	// API実行
+	resp, err := c.apiClient.CreateFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if filterID := createdResourceID(resp); filterID != "" {
+		c.recordUndo(undoStep{Type: api.CommandFilterAdd, FilterID: filterID, Label: req.Name})
+	}
+
+	// 増分同期で作成したフィルタをローカルに反映
+	c.reconcile(ctx)
+
+	return resp, nil
+}
+
+// UpdateFilter は保存済みフィルタを更新する（API実行 + ローカル反映）
+func (c *Repository) UpdateFilter(ctx context.Context, filterID string, req *api.UpdateFilterRequest) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureFilterStep(api.CommandFilterUpdate, filterID)
+
+	// API実行
+	resp, err := c.apiClient.UpdateFilter(ctx, filterID, req)
+	if err != nil {
+		return nil, err
+	}
+	c.recordUndo(step)
+
+	// 増分同期で更新したフィルタをローカルに反映
+	c.reconcile(ctx)
+
+	return resp, nil
+}
+
+// DeleteFilter は保存済みフィルタを削除する（API実行 + ローカル反映）
+func (c *Repository) DeleteFilter(ctx context.Context, filterID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureFilterStep(api.CommandFilterDelete, filterID)
+
+	// API実行
+	resp, err := c.apiClient.DeleteFilter(ctx, filterID)
+	if err != nil {
+		return nil, err
+	}
+	c.recordUndo(step)
+
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
+
+	return resp, nil
+}
@@ -0,0 +1,402 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// undoStep は1つのコマンドを取り消すために記録する変更前データ
+type undoStep struct {
+	Type       string             `json:"type"`  // 取り消し対象のコマンド種別
+	Label      string             `json:"label"` // 表示用のタスク名・プロジェクト名
+	TaskID     string             `json:"task_id,omitempty"`
+	ProjectID  string             `json:"project_id,omitempty"`
+	ReminderID string             `json:"reminder_id,omitempty"`
+	FilterID   string             `json:"filter_id,omitempty"`
+	Email      string             `json:"email,omitempty"`     // 共有・共有解除したユーザー（share_project、delete_collaborator）
+	ParentID   string             `json:"parent_id,omitempty"` // 移動先の親プロジェクト（project_move、空の場合はルート）
+	Orders     []api.ProjectOrder `json:"orders,omitempty"`    // 変更後の並び順（project_reorder）
+	Tasks      []api.Item         `json:"tasks,omitempty"`     // 変更前のタスク（削除時はサブタスクを含む）
+	Projects   []api.Project      `json:"projects,omitempty"`  // 変更前のプロジェクト（削除時は子プロジェクトを含む）
+	Sections   []api.Section      `json:"sections,omitempty"`  // 削除したプロジェクトのセクション
+	Reminders  []api.Reminder     `json:"reminders,omitempty"` // 削除したリマインダー
+	Filters    []api.Filter       `json:"filters,omitempty"`   // 変更前の保存済みフィルタ
+}
+
+// undoVerbs はコマンド種別ごとの表示用の動詞
+var undoVerbs = map[string]string{
+	api.CommandItemAdd:            "create",
+	api.CommandItemUpdate:         "update",
+	api.CommandItemDelete:         "delete",
+	api.CommandItemComplete:       "complete",
+	api.CommandItemClose:          "complete",
+	api.CommandItemUncomplete:     "reopen",
+	api.CommandItemMove:           "move",
+	api.CommandProjectAdd:         "create",
+	api.CommandProjectUpdate:      "update",
+	api.CommandProjectDelete:      "delete",
+	api.CommandProjectArchive:     "archive",
+	api.CommandProjectUnarchive:   "unarchive",
+	api.CommandProjectMove:        "move",
+	api.CommandProjectReorder:     "reorder",
+	api.CommandReminderAdd:        "create",
+	api.CommandReminderDelete:     "delete",
+	api.CommandFilterAdd:          "create",
+	api.CommandFilterUpdate:       "update",
+	api.CommandFilterDelete:       "delete",
+	api.CommandShareProject:       "share",
+	api.CommandDeleteCollaborator: "unshare",
+}
+
+// isProjectCommand はプロジェクトに対するコマンドかどうかを返す
+func isProjectCommand(cmdType string) bool {
+	switch cmdType {
+	case api.CommandProjectAdd, api.CommandProjectUpdate, api.CommandProjectDelete,
+		api.CommandProjectArchive, api.CommandProjectUnarchive, api.CommandProjectMove, api.CommandProjectReorder:
+		return true
+	default:
+		return false
+	}
+}
+
+// undoKind はコマンド種別ごとの表示用の対象の種類を返す
+func undoKind(cmdType string) string {
+	switch {
+	case isProjectCommand(cmdType), cmdType == api.CommandShareProject, cmdType == api.CommandDeleteCollaborator:
+		return "project"
+	case cmdType == api.CommandReminderAdd || cmdType == api.CommandReminderDelete:
+		return "reminder"
+	case cmdType == api.CommandFilterAdd || cmdType == api.CommandFilterUpdate || cmdType == api.CommandFilterDelete:
+		return "filter"
+	default:
+		return "task"
+	}
+}
+
+// describeUndoSteps はジャーナルに表示する操作の説明を返す
+func describeUndoSteps(steps []undoStep) string {
+	kind := undoKind(steps[0].Type)
+
+	verb := undoVerbs[steps[0].Type]
+	for _, step := range steps[1:] {
+		if undoVerbs[step.Type] != verb {
+			verb = "change"
+		}
+		// 種類の異なる対象が混在する場合（取り込みなど）
+		if undoKind(step.Type) != undoKind(steps[0].Type) {
+			kind = "item"
+		}
+	}
+
+	if len(steps) == 1 {
+		if steps[0].Email != "" {
+			return fmt.Sprintf("%s %s %q (%s)", verb, kind, steps[0].Label, steps[0].Email)
+		}
+		return fmt.Sprintf("%s %s %q", verb, kind, steps[0].Label)
+	}
+	return fmt.Sprintf("%s %d %ss", verb, len(steps), kind)
+}
+
+// remapJournalIDs は未取り消しのジャーナルに含まれるIDを再作成後のIDに置き換える
+// 削除したタスクやプロジェクトを再作成した後も、古いジャーナルを取り消せるようにする
+// タスク名などの文字列を書き換えないよう、ID項目のみを置き換える
+func (c *Repository) remapJournalIDs(idMap map[string]string) error {
+	if len(idMap) == 0 {
+		return nil
+	}
+	return c.storage.RewriteJournalPayloads(func(payload string) (string, error) {
+		var steps []undoStep
+		if err := json.Unmarshal([]byte(payload), &steps); err != nil {
+			return "", fmt.Errorf("failed to decode undo journal: %w", err)
+		}
+		if !remapStepIDs(steps, idMap) {
+			return payload, nil
+		}
+		encoded, err := json.Marshal(steps)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode undo journal: %w", err)
+		}
+		return string(encoded), nil
+	})
+}
+
+// remapStepIDs は変更前データのID項目をidMapに従って置き換え、置き換えがあったかを返す
+// 置き換えは1回のみ行うため、結果はidMapの走査順に依存しない
+func remapStepIDs(steps []undoStep, idMap map[string]string) bool {
+	changed := false
+	remap := func(id *string) {
+		if newID, ok := idMap[*id]; ok && *id != "" {
+			*id = newID
+			changed = true
+		}
+	}
+
+	for i := range steps {
+		step := &steps[i]
+		remap(&step.TaskID)
+		remap(&step.ProjectID)
+		remap(&step.ParentID)
+		remap(&step.ReminderID)
+		remap(&step.FilterID)
+		for j := range step.Orders {
+			remap(&step.Orders[j].ID)
+		}
+		for j := range step.Tasks {
+			remap(&step.Tasks[j].ID)
+			remap(&step.Tasks[j].ParentID)
+			remap(&step.Tasks[j].ProjectID)
+			remap(&step.Tasks[j].SectionID)
+		}
+		for j := range step.Projects {
+			remap(&step.Projects[j].ID)
+			remap(&step.Projects[j].ParentID)
+		}
+		for j := range step.Sections {
+			remap(&step.Sections[j].ID)
+			remap(&step.Sections[j].ProjectID)
+		}
+		for j := range step.Reminders {
+			remap(&step.Reminders[j].ID)
+			remap(&step.Reminders[j].ItemID)
+		}
+		for j := range step.Filters {
+			remap(&step.Filters[j].ID)
+		}
+	}
+	return changed
+}
+
+// recordUndo は変更操作を取り消し用ジャーナルに記録する
+// 記録に失敗しても変更操作自体は成功しているため、ログ出力のみ行う
+func (c *Repository) recordUndo(steps ...undoStep) {
+	if !c.shouldWriteLocal() || len(steps) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(steps)
+	if err != nil {
+		log.Printf("Failed to encode undo journal: %v", err)
+		return
+	}
+
+	if _, err := c.storage.InsertJournalEntry(steps[0].Type, describeUndoSteps(steps), string(payload)); err != nil {
+		log.Printf("Failed to record undo journal: %v", err)
+	}
+}
+
+// loadTaskSnapshot は変更前データとして記録するためにローカルのタスクを取得する
+// ローカルストレージに書き込まない場合は記録しないためnilを返す
+func (c *Repository) loadTaskSnapshot() []api.Item {
+	if !c.shouldWriteLocal() {
+		return nil
+	}
+
+	tasks, err := c.storage.GetTasks()
+	if err != nil {
+		log.Printf("Failed to load tasks for undo journal: %v", err)
+		return nil
+	}
+	return tasks
+}
+
+// captureTaskStep はタスクに対するコマンドの変更前データを記録する
+func (c *Repository) captureTaskStep(cmdType, taskID string) undoStep {
+	return newTaskUndoStep(c.loadTaskSnapshot(), cmdType, taskID)
+}
+
+// newTaskUndoStep はタスク一覧から変更前データを取り出してundoStepを作成する
+func newTaskUndoStep(tasks []api.Item, cmdType, taskID string) undoStep {
+	step := undoStep{Type: cmdType, TaskID: taskID, Label: taskID}
+
+	if cmdType == api.CommandItemDelete {
+		// サブタスクも一緒に削除されるため、子孫タスクもまとめて記録する
+		step.Tasks = collectTaskTree(tasks, taskID)
+	} else {
+		for i := range tasks {
+			if tasks[i].ID == taskID {
+				step.Tasks = []api.Item{tasks[i]}
+				break
+			}
+		}
+	}
+
+	if len(step.Tasks) > 0 {
+		step.Label = step.Tasks[0].Content
+	}
+	return step
+}
+
+// collectTaskTree は指定したタスクとその子孫タスクを親から順に返す
+func collectTaskTree(tasks []api.Item, rootID string) []api.Item {
+	var tree []api.Item
+	for i := range tasks {
+		if tasks[i].ID == rootID {
+			tree = append(tree, tasks[i])
+			break
+		}
+	}
+
+	// 幅優先で子孫を辿るため、親は必ず子より前に並ぶ
+	for i := 0; i < len(tree); i++ {
+		for j := range tasks {
+			if tasks[j].ParentID == tree[i].ID {
+				tree = append(tree, tasks[j])
+			}
+		}
+	}
+	return tree
+}
+
+// captureReminderStep はリマインダーに対するコマンドの変更前データを記録する
+// 表示用にはリマインダーを設定したタスクの内容を使う
+func (c *Repository) captureReminderStep(cmdType, reminderID, taskID string) undoStep {
+	step := undoStep{Type: cmdType, ReminderID: reminderID, TaskID: taskID, Label: reminderID}
+	if !c.shouldWriteLocal() {
+		return step
+	}
+
+	if cmdType == api.CommandReminderDelete {
+		reminders, err := c.storage.GetReminders("")
+		if err != nil {
+			log.Printf("Failed to load reminders for undo journal: %v", err)
+			return step
+		}
+		for i := range reminders {
+			if reminders[i].ID == reminderID {
+				step.Reminders = []api.Reminder{reminders[i]}
+				step.TaskID = reminders[i].ItemID
+				break
+			}
+		}
+	}
+
+	tasks := c.loadTaskSnapshot()
+	for i := range tasks {
+		if tasks[i].ID == step.TaskID {
+			step.Label = tasks[i].Content
+			break
+		}
+	}
+	return step
+}
+
+// captureFilterStep は保存済みフィルタに対するコマンドの変更前データを記録する
+func (c *Repository) captureFilterStep(cmdType, filterID string) undoStep {
+	step := undoStep{Type: cmdType, FilterID: filterID, Label: filterID}
+	if !c.shouldWriteLocal() {
+		return step
+	}
+
+	filters, err := c.storage.GetFilters()
+	if err != nil {
+		log.Printf("Failed to load filters for undo journal: %v", err)
+		return step
+	}
+	for i := range filters {
+		if filters[i].ID == filterID {
+			step.Filters = []api.Filter{filters[i]}
+			step.Label = filters[i].Name
+			break
+		}
+	}
+	return step
+}
+
+// captureProjectStep はプロジェクトに対するコマンドの変更前データを記録する
+func (c *Repository) captureProjectStep(cmdType, projectID string) undoStep {
+	step := undoStep{Type: cmdType, ProjectID: projectID, Label: projectID}
+	if !c.shouldWriteLocal() {
+		return step
+	}
+
+	projects, err := c.storage.GetAllProjects()
+	if err != nil {
+		log.Printf("Failed to load projects for undo journal: %v", err)
+		return step
+	}
+
+	if cmdType != api.CommandProjectDelete {
+		for i := range projects {
+			if projects[i].ID == projectID {
+				step.Projects = []api.Project{projects[i]}
+				step.Label = projects[i].Name
+				break
+			}
+		}
+		return step
+	}
+
+	// 子プロジェクト・セクション・タスクも一緒に削除されるため、まとめて記録する
+	step.Projects = collectProjectTree(projects, projectID)
+	for i := range step.Projects {
+		sections, err := c.storage.GetSectionsByProject(step.Projects[i].ID)
+		if err != nil {
+			log.Printf("Failed to load sections for undo journal: %v", err)
+			continue
+		}
+		step.Sections = append(step.Sections, sections...)
+
+		tasks, err := c.storage.GetTasksByProject(step.Projects[i].ID)
+		if err != nil {
+			log.Printf("Failed to load tasks for undo journal: %v", err)
+			continue
+		}
+		step.Tasks = append(step.Tasks, sortTasksParentFirst(tasks)...)
+	}
+
+	if len(step.Projects) > 0 {
+		step.Label = step.Projects[0].Name
+	}
+	return step
+}
+
+// collectProjectTree は指定したプロジェクトとその子孫プロジェクトを親から順に返す
+func collectProjectTree(projects []api.Project, rootID string) []api.Project {
+	var tree []api.Project
+	for i := range projects {
+		if projects[i].ID == rootID {
+			tree = append(tree, projects[i])
+			break
+		}
+	}
+
+	for i := 0; i < len(tree); i++ {
+		for j := range projects {
+			if projects[j].ParentID == tree[i].ID {
+				tree = append(tree, projects[j])
+			}
+		}
+	}
+	return tree
+}
+
+// sortTasksParentFirst は親タスクが子タスクより前に並ぶように並べ替える
+func sortTasksParentFirst(tasks []api.Item) []api.Item {
+	inSet := make(map[string]bool, len(tasks))
+	for i := range tasks {
+		inSet[tasks[i].ID] = true
+	}
+
+	var sorted []api.Item
+	for i := range tasks {
+		// 一覧内に親がいないタスクを起点に子孫を辿る
+		if tasks[i].ParentID == "" || !inSet[tasks[i].ParentID] {
+			sorted = append(sorted, collectTaskTree(tasks, tasks[i].ID)...)
+		}
+	}
+	return sorted
+}
+
+// createdResourceID は作成コマンド1件のレスポンスから作成されたリソースのIDを返す
+func createdResourceID(resp *api.SyncResponse) string {
+	if resp == nil || len(resp.TempIDMapping) != 1 {
+		return ""
+	}
+	for _, id := range resp.TempIDMapping {
+		return id
+	}
+	return ""
+}
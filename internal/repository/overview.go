@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/filter"
+)
+
+// completedWindow はプロジェクト概要で集計する完了済みタスクの期間
+const completedWindow = 7 * 24 * time.Hour
+
+// ProjectOverview はプロジェクトの概要（ローカルストレージから集計）
+type ProjectOverview struct {
+	Project        api.Project
+	Path           string
+	Sections       []SectionStats // セクションなしのタスクは先頭（SectionIDが空）
+	Total          SectionStats   // 全セクションの合計
+	CompletedCount int            // 直近7日間に完了したタスク数
+	TopTasks       []api.Item     // 優先度の高い未完了タスク
+}
+
+// SectionStats はセクションごとのタスク数
+type SectionStats struct {
+	SectionID string
+	Name      string
+	Open      int
+	Overdue   int
+	DueToday  int
+}
+
+// GetProjectOverview はプロジェクトのセクション別の集計・直近の完了数・優先度の高いタスクを返す
+// ローカルストレージのデータのみから集計する
+func (c *Repository) GetProjectOverview(projectID string, now time.Time, topN int) (*ProjectOverview, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("project overview requires local storage to be enabled")
+	}
+
+	projects, err := c.storage.GetAllProjects()
+	if err != nil {
+		return nil, err
+	}
+	var project *api.Project
+	for i := range projects {
+		if projects[i].ID == projectID {
+			project = &projects[i]
+			break
+		}
+	}
+	if project == nil {
+		return nil, fmt.Errorf("project not found: %s", projectID)
+	}
+
+	sections, err := c.storage.GetSectionsByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := c.storage.GetTasksByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := c.storage.GetCompletedTasks(now.Add(-completedWindow), projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &ProjectOverview{
+		Project:        *project,
+		Path:           ProjectPath(projects, projectID),
+		CompletedCount: len(completed),
+		Total:          SectionStats{Name: "Total"},
+	}
+
+	var open []api.Item
+	for i := range tasks {
+		if tasks[i].DateCompleted == nil {
+			open = append(open, tasks[i])
+		}
+	}
+	overview.Sections, err = sectionStats(sections, open, &filter.Context{Now: now, Projects: projects, Sections: sections})
+	if err != nil {
+		return nil, err
+	}
+	for _, stats := range overview.Sections {
+		overview.Total.Open += stats.Open
+		overview.Total.Overdue += stats.Overdue
+		overview.Total.DueToday += stats.DueToday
+	}
+	overview.TopTasks = topPriorityTasks(open, topN)
+
+	return overview, nil
+}
+
+// sectionStats はセクションごとに未完了・期限切れ・今日が期限のタスク数を集計する
+// セクションなしのタスクがある場合は先頭に含める
+// 期限の判定は task list --filter の overdue・today と同じ規則で行う
+func sectionStats(sections []api.Section, open []api.Item, ctx *filter.Context) ([]SectionStats, error) {
+	overdue, err := matchingTaskIDs("overdue", open, ctx)
+	if err != nil {
+		return nil, err
+	}
+	dueToday, err := matchingTaskIDs("today", open, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(sections)+1)
+	stats := []SectionStats{{Name: "(No section)"}}
+	for i := range sections {
+		index[sections[i].ID] = len(stats)
+		stats = append(stats, SectionStats{SectionID: sections[i].ID, Name: sections[i].Name})
+	}
+
+	for i := range open {
+		pos, ok := index[open[i].SectionID]
+		if !ok {
+			pos = 0 // 未同期・削除済みのセクションはセクションなしとして数える
+		}
+		stats[pos].Open++
+		if overdue[open[i].ID] {
+			stats[pos].Overdue++
+		}
+		if dueToday[open[i].ID] {
+			stats[pos].DueToday++
+		}
+	}
+
+	if stats[0].Open == 0 {
+		return stats[1:], nil
+	}
+	return stats, nil
+}
+
+// matchingTaskIDs はフィルタクエリに一致するタスクのIDの集合を返す
+func matchingTaskIDs(query string, tasks []api.Item, ctx *filter.Context) (map[string]bool, error) {
+	q, err := filter.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool)
+	for _, task := range q.Filter(tasks, ctx) {
+		ids[task.ID] = true
+	}
+	return ids, nil
+}
+
+// topPriorityTasks は優先度の高い順（同じ優先度は期限の近い順）に最大n件のタスクを返す
+func topPriorityTasks(open []api.Item, n int) []api.Item {
+	if n <= 0 {
+		return nil
+	}
+
+	sorted := make([]api.Item, len(open))
+	copy(sorted, open)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		di, dj := dueSortKey(&sorted[i]), dueSortKey(&sorted[j])
+		if (di == "") != (dj == "") {
+			return di != ""
+		}
+		return di < dj
+	})
+
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// dueSortKey は期限の並び替えに使う文字列を返す（期限なしは空）
+func dueSortKey(task *api.Item) string {
+	if task.Due == nil {
+		return ""
+	}
+	return task.Due.Date
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// reconcile は変更操作の実行後にローカルストレージをサーバーの状態に揃える
+// すべての変更操作はこの処理を通してローカルに反映する
+//  1. 結果が明らかなコマンドの効果（完了・削除など）を即座にローカルに反映する
+//  2. 保存済みのsync_tokenから増分同期を行い、サーバー側で確定した変更を取り込む
+//
+// コマンドのレスポンスに含まれるsync_tokenは保存しない
+// 前回の同期からコマンド実行までの間に他のクライアントで行われた変更を取りこぼすため
+// stepsは成功したコマンドの変更前データで、空の場合は増分同期のみを行う
+func (c *Repository) reconcile(ctx context.Context, steps ...undoStep) {
+	if !c.shouldWriteLocal() {
+		return
+	}
+
+	// 1. 結果が明らかな効果を反映
+	for i := range steps {
+		if err := c.applyKnownEffect(&steps[i]); err != nil {
+			log.Printf("Failed to apply %s to local storage: %v", steps[i].Type, err)
+		}
+	}
+
+	// 2. 保存済みのsync_tokenから増分同期
+	if err := c.syncManager.IncrementalSync(ctx); err != nil {
+		log.Printf("Failed to sync after %s: %v", describeReconcile(steps), err)
+
+		// 同期できない場合は繰り返しタスクの次回の期限を計算して反映
+		for i := range steps {
+			if steps[i].Type == api.CommandItemClose && len(steps[i].Tasks) > 0 && isRecurring(&steps[i].Tasks[0]) {
+				c.advanceRecurringTask(steps[i].Tasks[0], time.Now())
+			}
+		}
+	}
+}
+
+// applyKnownEffect はサーバーに問い合わせなくても結果が明らかなコマンドの効果をローカルに反映する
+// 作成・更新・移動などの結果は増分同期で取り込む
+func (c *Repository) applyKnownEffect(step *undoStep) error {
+	switch step.Type {
+	case api.CommandItemClose:
+		// 繰り返しタスクは完了にならず再スケジュールされるため、増分同期の結果に任せる
+		if len(step.Tasks) > 0 && isRecurring(&step.Tasks[0]) {
+			return nil
+		}
+		return c.storage.UpdateTaskCompleted(step.TaskID, true)
+
+	case api.CommandItemComplete:
+		return c.storage.UpdateTaskCompleted(step.TaskID, true)
+
+	case api.CommandItemUncomplete:
+		return c.storage.UpdateTaskCompleted(step.TaskID, false)
+
+	case api.CommandItemDelete:
+		// サブタスクも一緒に削除される
+		if err := c.storage.DeleteTask(step.TaskID); err != nil {
+			return err
+		}
+		for i := range step.Tasks {
+			if err := c.storage.DeleteTask(step.Tasks[i].ID); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case api.CommandProjectDelete:
+		// 子プロジェクトとプロジェクトに属するタスクも一緒に削除される
+		projectIDs := []string{step.ProjectID}
+		for i := range step.Projects {
+			if step.Projects[i].ID != step.ProjectID {
+				projectIDs = append(projectIDs, step.Projects[i].ID)
+			}
+		}
+		for _, projectID := range projectIDs {
+			if err := c.storage.DeleteTasksByProject(projectID); err != nil {
+				return err
+			}
+			if err := c.storage.DeleteProject(projectID); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case api.CommandProjectMove:
+		return c.storage.UpdateProjectParent(step.ProjectID, step.ParentID)
+
+	case api.CommandProjectReorder:
+		for _, order := range step.Orders {
+			if err := c.storage.UpdateProjectChildOrder(order.ID, order.ChildOrder); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case api.CommandReminderDelete:
+		return c.storage.DeleteReminder(step.ReminderID)
+
+	case api.CommandFilterDelete:
+		return c.storage.DeleteFilter(step.FilterID)
+
+	default:
+		return nil
+	}
+}
+
+// describeReconcile はログ出力用に反映した操作の説明を返す
+func describeReconcile(steps []undoStep) string {
+	if len(steps) == 0 {
+		return "changes"
+	}
+	switch steps[0].Type {
+	case api.CommandReminderDelete:
+		return fmt.Sprintf("delete reminder %s", steps[0].ReminderID)
+	case api.CommandFilterDelete:
+		return fmt.Sprintf("delete filter %s", steps[0].FilterID)
+	default:
+		return describeUndoSteps(steps)
+	}
+}
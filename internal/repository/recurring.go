@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// recurrencePattern は期限を計算できる単純な繰り返し指定（例: every 2 weeks, every! day, after 3 days）
+var recurrencePattern = regexp.MustCompile(`^(every!?|after)\s+(?:(\d+|other)\s+)?(day|week|month|year)s?$`)
+
+// recurrenceAliases は繰り返し指定の別名
+var recurrenceAliases = map[string]string{
+	"daily":    "every day",
+	"weekly":   "every week",
+	"monthly":  "every month",
+	"yearly":   "every year",
+	"annually": "every year",
+}
+
+// isRecurring は繰り返しタスクかどうかを返す
+func isRecurring(task *api.Item) bool {
+	return task.Due != nil && task.Due.IsRecurring
+}
+
+// advanceRecurringTask は繰り返しタスクの期限を次回に進めてローカルに保存する
+func (c *Repository) advanceRecurringTask(task api.Item, now time.Time) {
+	next, ok := nextRecurringDate(task.Due, now)
+	if !ok {
+		log.Printf("Could not compute the next occurrence of %q; run 'gotodoist sync' to update it", task.Content)
+		return
+	}
+
+	due := *task.Due
+	due.Date = next
+	task.Due = &due
+	if err := c.storage.InsertTask(task); err != nil {
+		log.Printf("Failed to update rescheduled task in local storage: %v", err)
+	}
+}
+
+// nextRecurringDate は繰り返しタスクを完了した後の次回の期限を計算する
+// 計算できない繰り返し指定の場合はfalseを返す
+func nextRecurringDate(due *api.Due, now time.Time) (string, bool) {
+	if due == nil || len(due.Date) < len("2006-01-02") {
+		return "", false
+	}
+
+	// 時刻指定（"every day at 9am"の"at 9am"）は期限の日時側に含まれるため除く
+	spec := strings.ToLower(strings.TrimSpace(due.String))
+	if i := strings.Index(spec, " at "); i >= 0 {
+		spec = spec[:i]
+	}
+	if alias, ok := recurrenceAliases[spec]; ok {
+		spec = alias
+	}
+
+	m := recurrencePattern.FindStringSubmatch(spec)
+	if m == nil {
+		return "", false
+	}
+
+	interval := 1
+	switch m[2] {
+	case "":
+	case "other":
+		interval = 2
+	default:
+		n, err := strconv.Atoi(m[2])
+		if err != nil || n < 1 {
+			return "", false
+		}
+		interval = n
+	}
+
+	advance := func(t time.Time) time.Time {
+		switch m[3] {
+		case "week":
+			return t.AddDate(0, 0, 7*interval)
+		case "month":
+			return t.AddDate(0, interval, 0)
+		case "year":
+			return t.AddDate(interval, 0, 0)
+		default:
+			return t.AddDate(0, 0, interval)
+		}
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", due.Date[:10], now.Location())
+	if err != nil {
+		return "", false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var next time.Time
+	if m[1] == "every" {
+		// 期限を基準に、今日より後になるまで進める
+		next = advance(date)
+		for !next.After(today) {
+			next = advance(next)
+		}
+	} else {
+		// every! と after は完了日を基準にする
+		next = advance(today)
+	}
+
+	// 時刻部分（"T09:00:00"など）はそのまま引き継ぐ
+	return next.Format("2006-01-02") + due.Date[10:], true
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+func TestNextRecurringDate(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		due    *api.Due
+		want   string
+		wantOK bool
+	}{
+		{name: "every day from today", due: &api.Due{Date: "2025-03-10", String: "every day", IsRecurring: true}, want: "2025-03-11", wantOK: true},
+		{name: "overdue daily task moves past today", due: &api.Due{Date: "2025-03-07", String: "daily", IsRecurring: true}, want: "2025-03-11", wantOK: true},
+		{name: "every 2 weeks keeps the cycle", due: &api.Due{Date: "2025-03-03", String: "every 2 weeks", IsRecurring: true}, want: "2025-03-17", wantOK: true},
+		{name: "every other month", due: &api.Due{Date: "2025-03-10", String: "every other month", IsRecurring: true}, want: "2025-05-10", wantOK: true},
+		{name: "every! counts from completion", due: &api.Due{Date: "2025-03-01", String: "every! 3 days", IsRecurring: true}, want: "2025-03-13", wantOK: true},
+		{name: "after counts from completion", due: &api.Due{Date: "2025-03-01", String: "after 1 week", IsRecurring: true}, want: "2025-03-17", wantOK: true},
+		{name: "time of day is kept", due: &api.Due{Date: "2025-03-10T09:00:00", String: "every day at 9am", IsRecurring: true}, want: "2025-03-11T09:00:00", wantOK: true},
+		{name: "yearly", due: &api.Due{Date: "2024-12-24", String: "every year", IsRecurring: true}, want: "2025-12-24", wantOK: true},
+		{name: "weekday pattern is not supported", due: &api.Due{Date: "2025-03-10", String: "every monday", IsRecurring: true}, wantOK: false},
+		{name: "no due", due: nil, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := nextRecurringDate(tt.due, now)
+
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ package repository
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/kyokomi/gotodoist/internal/api"
@@ -19,6 +18,7 @@ type Repository struct {
 	syncManager *sync.Manager
 	config      *Config
 	verbose     bool
+	dryRun      bool // ドライラン時はローカルストレージに書き込まない
 }
 
 // NewRepository は新しいローカルファーストリポジトリを作成する
@@ -52,12 +52,25 @@ func NewRepository(apiClient api.Interface, config *Config, verbose bool) (*Repo
 	return client, nil
 }
 
+// SetDryRun はドライランモードを設定する（有効な間はローカルストレージに書き込まない）
+func (c *Repository) SetDryRun(dryRun bool) {
+	c.dryRun = dryRun
+}
+
+// shouldWriteLocal は変更結果をローカルストレージに書き込むかどうかを返す
+func (c *Repository) shouldWriteLocal() bool {
+	return c.config.Enabled && !c.dryRun
+}
+
 // Initialize はRepositoryを初期化する（必要に応じて初期同期を実行）
 func (c *Repository) Initialize(ctx context.Context) error {
-	if !c.config.Enabled {
-		return nil // ローカルストレージが無効の場合は何もしない
+	if !c.shouldWriteLocal() {
+		return nil // ローカルストレージが無効、またはドライランの場合は何もしない
 	}
 
+	// 保持期間を過ぎたゴミ箱内のタスク・プロジェクトを削除
+	c.purgeExpiredTrash()
+
 	// 初期同期が必要かチェック
 	if c.config.InitialSyncOnStart {
 		initialDone, err := c.storage.IsInitialSyncDone()
@@ -128,6 +141,87 @@ func (c *Repository) GetAllSections(ctx context.Context) ([]api.Section, error)
 	return c.storage.GetAllSections()
 }
 
+// GetNotesByTask はタスクに付いたコメントを取得する（ローカル優先）
+func (c *Repository) GetNotesByTask(ctx context.Context, taskID string) ([]api.Note, error) {
+	if !c.config.Enabled {
+		notes, err := c.apiClient.GetAllNotes(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var taskNotes []api.Note
+		for i := range notes {
+			if notes[i].ItemID == taskID {
+				taskNotes = append(taskNotes, notes[i])
+			}
+		}
+		return taskNotes, nil
+	}
+
+	// ローカルから高速取得
+	return c.storage.GetNotesByTask(taskID)
+}
+
+// GetReminders はタスクに設定されたリマインダーを取得する（ローカル優先）
+// taskIDが空の場合は全タスクのリマインダーを取得する
+func (c *Repository) GetReminders(ctx context.Context, taskID string) ([]api.Reminder, error) {
+	if !c.config.Enabled {
+		reminders, err := c.apiClient.GetAllReminders(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if taskID == "" {
+			return reminders, nil
+		}
+
+		var taskReminders []api.Reminder
+		for i := range reminders {
+			if reminders[i].ItemID == taskID {
+				taskReminders = append(taskReminders, reminders[i])
+			}
+		}
+		return taskReminders, nil
+	}
+
+	// ローカルから高速取得
+	return c.storage.GetReminders(taskID)
+}
+
+// CreateReminder はリマインダーを作成する（API実行 + ローカル反映）
+func (c *Repository) CreateReminder(ctx context.Context, req *api.CreateReminderRequest) (*api.SyncResponse, error) {
+	// API実行
+	resp, err := c.apiClient.CreateReminder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if reminderID := createdResourceID(resp); reminderID != "" {
+		c.recordUndo(c.captureReminderStep(api.CommandReminderAdd, reminderID, req.ItemID))
+	}
+
+	// 増分同期で作成したリマインダーをローカルに反映
+	c.reconcile(ctx)
+
+	return resp, nil
+}
+
+// DeleteReminder はリマインダーを削除する（API実行 + ローカル反映）
+func (c *Repository) DeleteReminder(ctx context.Context, reminderID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureReminderStep(api.CommandReminderDelete, reminderID, "")
+
+	// API実行
+	resp, err := c.apiClient.DeleteReminder(ctx, reminderID)
+	if err != nil {
+		return nil, err
+	}
+	c.recordUndo(step)
+
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
+
+	return resp, nil
+}
+
 // CreateTask はタスクを作成する（API実行 + ローカル反映）
 func (c *Repository) CreateTask(ctx context.Context, req *api.CreateTaskRequest) (*api.SyncResponse, error) {
 	// API実行
@@ -135,106 +229,148 @@ func (c *Repository) CreateTask(ctx context.Context, req *api.CreateTaskRequest)
 	if err != nil {
 		return nil, err
 	}
-
-	// ローカルストレージが有効な場合は増分同期で最新データを取得
-	if c.config.Enabled {
-		// 作成後に増分同期を実行して新しいタスクを取得
-		if err := c.syncManager.IncrementalSync(ctx); err != nil {
-			log.Printf("Failed to sync after task creation: %v", err)
-		}
+	step := undoStep{Type: api.CommandItemAdd, TaskID: createdResourceID(resp), Label: req.Content}
+	if step.TaskID != "" {
+		c.recordUndo(step)
 	}
 
+	// 増分同期で作成したタスクをローカルに反映
+	c.reconcile(ctx, step)
+
 	return resp, nil
 }
 
 // UpdateTask はタスクを更新する（API実行 + ローカル反映）
 func (c *Repository) UpdateTask(ctx context.Context, taskID string, req *api.UpdateTaskRequest) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureTaskStep(api.CommandItemUpdate, taskID)
+
 	// API実行
 	resp, err := c.apiClient.UpdateTask(ctx, taskID, req)
 	if err != nil {
 		return nil, err
 	}
+	c.recordUndo(step)
 
-	// ローカルストレージが有効な場合は増分同期で最新データを取得
-	if c.config.Enabled {
-		// 更新後に増分同期を実行して変更をローカルに反映
-		if err := c.syncManager.IncrementalSync(ctx); err != nil {
-			log.Printf("Failed to sync after task update: %v", err)
-		}
-	}
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
 	return resp, nil
 }
 
 // DeleteTask はタスクを削除する（API実行 + ローカル反映）
 func (c *Repository) DeleteTask(ctx context.Context, taskID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureTaskStep(api.CommandItemDelete, taskID)
+
 	// API実行
 	resp, err := c.apiClient.DeleteTask(ctx, taskID)
 	if err != nil {
 		return nil, err
 	}
+	c.recordUndo(step)
 
-	// ローカルストレージが有効な場合は即座に反映
-	if c.config.Enabled {
-		if err := c.storage.DeleteTask(taskID); err != nil {
-			log.Printf("Failed to delete task from local storage: %v", err)
-		}
-
-		// 削除後に増分同期を実行してAPI側の変更をローカルに反映
-		if err := c.syncManager.IncrementalSync(ctx); err != nil {
-			log.Printf("Failed to sync after task deletion: %v", err)
-		}
-	}
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
 	return resp, nil
 }
 
 // CloseTask はタスクを完了にする（API実行 + ローカル反映）
+// 繰り返しタスクは完了にならず次回の期限に再スケジュールされるため、その結果をローカルに反映する
 func (c *Repository) CloseTask(ctx context.Context, taskID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureTaskStep(api.CommandItemClose, taskID)
+
 	// API実行
 	resp, err := c.apiClient.CloseTask(ctx, taskID)
 	if err != nil {
 		return nil, err
 	}
+	c.recordUndo(step)
 
-	// ローカルストレージが有効な場合
-	if c.config.Enabled {
-		// sync_token を更新
-		if err := c.storage.SetSyncToken(resp.SyncToken); err != nil {
-			log.Printf("Failed to update sync token after task completion: %v", err)
-		}
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
-		// ローカルストレージでタスクを完了状態に更新
-		if err := c.storage.UpdateTaskCompleted(taskID, true); err != nil {
-			log.Printf("Failed to update task completion status in local storage: %v", err)
-		}
+	return resp, nil
+}
+
+// CompleteTaskForever はタスクを完了にする（API実行 + ローカル反映）
+// 繰り返しタスクも再スケジュールせずに完了にする
+func (c *Repository) CompleteTaskForever(ctx context.Context, taskID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureTaskStep(api.CommandItemComplete, taskID)
+
+	// API実行
+	resp, err := c.apiClient.CompleteTaskForever(ctx, taskID)
+	if err != nil {
+		return nil, err
 	}
+	c.recordUndo(step)
+
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
 	return resp, nil
 }
 
 // ReopenTask はタスクを未完了に戻す（API実行 + ローカル反映）
 func (c *Repository) ReopenTask(ctx context.Context, taskID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureTaskStep(api.CommandItemUncomplete, taskID)
+
 	// API実行
 	resp, err := c.apiClient.ReopenTask(ctx, taskID)
 	if err != nil {
 		return nil, err
 	}
+	c.recordUndo(step)
 
-	// ローカルストレージが有効な場合
-	if c.config.Enabled {
-		// sync_token を更新
-		if err := c.storage.SetSyncToken(resp.SyncToken); err != nil {
-			log.Printf("Failed to update sync token after task reopening: %v", err)
-		}
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
-		// ローカルストレージでタスクを未完了状態に更新
-		if err := c.storage.UpdateTaskCompleted(taskID, false); err != nil {
-			log.Printf("Failed to update task completion status in local storage: %v", err)
+	return resp, nil
+}
+
+// ExecuteTaskCommands はタスクに対する複数のコマンドをまとめて実行する（API実行 + ローカル反映）
+// 一部のリクエストが失敗した場合も、成功したコマンドの結果はローカルに反映する
+func (c *Repository) ExecuteTaskCommands(ctx context.Context, commands []api.Command) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	snapshot := c.loadTaskSnapshot()
+	steps := make(map[string]undoStep, len(commands))
+	for _, cmd := range commands {
+		taskID, _ := cmd.Args["id"].(string)
+		steps[cmd.UUID] = newTaskUndoStep(snapshot, cmd.Type, taskID)
+	}
+
+	// API実行（まとめて送信）
+	resp, execErr := c.apiClient.ExecuteCommands(ctx, commands)
+	if resp == nil {
+		return nil, execErr
+	}
+
+	// 成功したコマンドだけを1つの操作として記録
+	var succeeded []undoStep
+	for _, cmd := range commands {
+		if resp.CommandError(cmd.UUID) != nil {
+			continue
+		}
+		step := steps[cmd.UUID]
+		if cmd.Type == api.CommandItemAdd {
+			step.TaskID = resp.TempIDMapping[cmd.TempID]
+			step.Label, _ = cmd.Args["content"].(string)
+			if step.TaskID == "" {
+				continue
+			}
 		}
+		succeeded = append(succeeded, step)
 	}
+	c.recordUndo(succeeded...)
 
-	return resp, nil
+	// 成功したコマンドの結果をローカルに反映
+	c.reconcile(ctx, succeeded...)
+
+	return resp, execErr
 }
 
 // Sync は手動で同期を実行する
@@ -242,6 +378,9 @@ func (c *Repository) Sync(ctx context.Context) error {
 	if !c.config.Enabled {
 		return fmt.Errorf("local storage is disabled")
 	}
+	if c.dryRun {
+		return nil // ドライラン時はローカルストレージを変更しない
+	}
 
 	return c.syncManager.IncrementalSync(ctx)
 }
@@ -265,6 +404,9 @@ func (c *Repository) ForceInitialSync(ctx context.Context) error {
 	if !c.config.Enabled {
 		return fmt.Errorf("local storage is disabled")
 	}
+	if c.dryRun {
+		return nil // ドライラン時はローカルストレージを変更しない
+	}
 
 	return c.syncManager.ForceInitialSync(ctx)
 }
@@ -276,136 +418,144 @@ func (c *Repository) CreateProject(ctx context.Context, req *api.CreateProjectRe
 	if err != nil {
 		return nil, err
 	}
-
-	// ローカルストレージが有効な場合は増分同期で最新データを取得
-	if c.config.Enabled {
-		// 作成後に増分同期を実行して新しいプロジェクトを取得
-		if err := c.syncManager.IncrementalSync(ctx); err != nil {
-			log.Printf("Failed to sync after project creation: %v", err)
-		}
+	step := undoStep{Type: api.CommandProjectAdd, ProjectID: createdResourceID(resp), Label: req.Name}
+	if step.ProjectID != "" {
+		c.recordUndo(step)
 	}
 
+	// 増分同期で作成したプロジェクトをローカルに反映
+	c.reconcile(ctx, step)
+
 	return resp, nil
 }
 
 // UpdateProject はプロジェクトを更新する（API実行 + ローカル反映）
 func (c *Repository) UpdateProject(ctx context.Context, projectID string, req *api.UpdateProjectRequest) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureProjectStep(api.CommandProjectUpdate, projectID)
+
 	// API実行
 	resp, err := c.apiClient.UpdateProject(ctx, projectID, req)
 	if err != nil {
 		return nil, err
 	}
+	c.recordUndo(step)
 
-	// ローカルストレージが有効な場合は増分同期で最新データを取得
-	if c.config.Enabled {
-		// 更新後に増分同期を実行して変更をローカルに反映
-		if err := c.syncManager.IncrementalSync(ctx); err != nil {
-			log.Printf("Failed to sync after project update: %v", err)
-		}
-	}
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
 	return resp, nil
 }
 
 // DeleteProject はプロジェクトを削除する（API実行 + ローカル反映）
 func (c *Repository) DeleteProject(ctx context.Context, projectID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureProjectStep(api.CommandProjectDelete, projectID)
+
 	// API実行
 	resp, err := c.apiClient.DeleteProject(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
+	c.recordUndo(step)
 
-	// ローカルストレージが有効な場合は即座に反映
-	if c.config.Enabled {
-		// プロジェクトに属するタスクを先に削除（カスケード削除）
-		if err := c.storage.DeleteTasksByProject(projectID); err != nil {
-			log.Printf("Failed to delete tasks for project %s: %v", projectID, err)
-		}
-
-		// プロジェクト自体を削除
-		if err := c.storage.DeleteProject(projectID); err != nil {
-			log.Printf("Failed to delete project from local storage: %v", err)
-		}
-
-		// 削除後に増分同期を実行してAPI側の変更をローカルに反映
-		if err := c.syncManager.IncrementalSync(ctx); err != nil {
-			log.Printf("Failed to sync after project deletion: %v", err)
-		}
-	}
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
 	return resp, nil
 }
 
 // ArchiveProject はプロジェクトをアーカイブする（API実行 + ローカル反映）
 func (c *Repository) ArchiveProject(ctx context.Context, projectID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureProjectStep(api.CommandProjectArchive, projectID)
+
 	// API実行
 	resp, err := c.apiClient.ArchiveProject(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
+	c.recordUndo(step)
 
-	// ローカルストレージが有効な場合は増分同期で最新データを取得
-	if c.config.Enabled {
-		// アーカイブ後に増分同期を実行して変更をローカルに反映
-		if err := c.syncManager.IncrementalSync(ctx); err != nil {
-			log.Printf("Failed to sync after project archive: %v", err)
-		}
-	}
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
 	return resp, nil
 }
 
 // UnarchiveProject はプロジェクトのアーカイブを解除する（API実行 + ローカル反映）
 func (c *Repository) UnarchiveProject(ctx context.Context, projectID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureProjectStep(api.CommandProjectUnarchive, projectID)
+
 	// API実行
 	resp, err := c.apiClient.UnarchiveProject(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
+	c.recordUndo(step)
 
-	// ローカルストレージが有効な場合は増分同期で最新データを取得
-	if c.config.Enabled {
-		// アンアーカイブ後に増分同期を実行して変更をローカルに反映
-		if err := c.syncManager.IncrementalSync(ctx); err != nil {
-			log.Printf("Failed to sync after project unarchive: %v", err)
-		}
-	}
+	// ローカルストレージに反映
+	c.reconcile(ctx, step)
 
 	return resp, nil
 }
 
-// FindProjectIDByName はプロジェクト名またはIDからプロジェクトIDを検索する
-// 検索順序: 1. ID完全一致 2. 名前完全一致 3. 名前部分一致
-func (c *Repository) FindProjectIDByName(ctx context.Context, nameOrID string) (string, error) {
-	projects, err := c.GetAllProjects(ctx)
+// MoveProject はプロジェクトを別の親プロジェクトの下、またはルートに移動する（API実行 + ローカル反映）
+func (c *Repository) MoveProject(ctx context.Context, projectID, parentID string) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の状態を記録
+	step := c.captureProjectStep(api.CommandProjectMove, projectID)
+	step.ParentID = parentID
+
+	// API実行
+	resp, err := c.apiClient.MoveProject(ctx, projectID, parentID)
 	if err != nil {
-		return "", fmt.Errorf("failed to get projects: %w", err)
+		return nil, err
 	}
+	c.recordUndo(step)
 
-	nameOrIDLower := strings.ToLower(nameOrID)
+	// ツリー表示にすぐ反映されるよう親プロジェクトをローカルに反映
+	c.reconcile(ctx, step)
 
-	// 1. ID完全一致（最優先・最高速）
-	for _, project := range projects {
-		if project.ID == nameOrID {
-			return project.ID, nil
-		}
+	return resp, nil
+}
+
+// ReorderProjects はプロジェクトの並び順を変更する（API実行 + ローカル反映）
+func (c *Repository) ReorderProjects(ctx context.Context, orders []api.ProjectOrder) (*api.SyncResponse, error) {
+	// 取り消し用に変更前の並び順を記録
+	step := undoStep{Type: api.CommandProjectReorder, Orders: orders}
+	names := make([]string, 0, len(orders))
+	for _, order := range orders {
+		captured := c.captureProjectStep(api.CommandProjectReorder, order.ID)
+		step.Projects = append(step.Projects, captured.Projects...)
+		names = append(names, captured.Label)
 	}
+	step.Label = strings.Join(names, ", ")
 
-	// 2. 名前完全一致（大文字小文字を無視）
-	for _, project := range projects {
-		if strings.EqualFold(project.Name, nameOrID) {
-			return project.ID, nil
-		}
+	// API実行
+	resp, err := c.apiClient.ReorderProjects(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+	if len(step.Projects) == len(orders) {
+		c.recordUndo(step)
 	}
 
-	// 3. 名前部分一致（最後の手段）
-	for _, project := range projects {
-		if strings.Contains(strings.ToLower(project.Name), nameOrIDLower) {
-			return project.ID, nil
-		}
+	// ツリー表示にすぐ反映されるよう並び順をローカルに反映
+	c.reconcile(ctx, step)
+
+	return resp, nil
+}
+
+// FindProjectIDByName はプロジェクト名・階層パス・ID・IDプレフィックスからプロジェクトIDを検索する
+// 複数の候補に一致した場合はAmbiguousReferenceErrorを返す（検索順序はfindProjectIDByNameを参照）
+func (c *Repository) FindProjectIDByName(ctx context.Context, nameOrID string) (string, error) {
+	projects, err := c.GetAllProjects(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get projects: %w", err)
 	}
 
-	return "", fmt.Errorf("project not found: %s", nameOrID)
+	return findProjectIDByName(projects, nameOrID)
 }
 
 // ResetLocalStorage はローカルストレージを完全にリセットする
@@ -413,6 +563,9 @@ func (c *Repository) ResetLocalStorage(_ context.Context) error {
 	if !c.config.Enabled {
 		return fmt.Errorf("local storage is disabled")
 	}
+	if c.dryRun {
+		return nil // ドライラン時はローカルストレージを変更しない
+	}
 
 	return c.storage.ResetAllData()
 }
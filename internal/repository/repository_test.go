@@ -1,8 +1,6 @@
 package repository
 
 import (
-	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/kyokomi/gotodoist/internal/api"
@@ -19,6 +17,7 @@ func TestFindProjectIDByName_Logic(t *testing.T) {
 		{ID: "project2", Name: "Another Project"},
 		{ID: "project3", Name: "test project"}, // 小文字
 		{ID: "project4", Name: "Partial Match Test"},
+		{ID: "6Jf8VQXxpwv56VQ7", Name: "Inbox"},
 	}
 
 	tests := []struct {
@@ -33,19 +32,39 @@ func TestFindProjectIDByName_Logic(t *testing.T) {
 			expectedID: "project2",
 		},
 		{
-			name:       "名前完全一致（大文字小文字無視）",
-			nameOrID:   "TEST PROJECT",
+			name:       "名前完全一致",
+			nameOrID:   "Test Project",
 			expectedID: "project1",
 		},
 		{
 			name:       "名前完全一致（小文字）",
 			nameOrID:   "test project",
-			expectedID: "project1", // "Test Project"と大文字小文字を無視して一致
+			expectedID: "project3", // 大文字小文字まで一致する方を優先
+		},
+		{
+			name:        "大文字小文字を無視すると曖昧",
+			nameOrID:    "TEST PROJECT",
+			expectError: true, // "Test Project"と"test project"のどちらか推測しない
+		},
+		{
+			name:       "名前完全一致（大文字小文字無視）",
+			nameOrID:   "another project",
+			expectedID: "project2",
+		},
+		{
+			name:        "名前部分一致では解決しない",
+			nameOrID:    "partial",
+			expectError: true,
 		},
 		{
-			name:       "名前部分一致",
-			nameOrID:   "partial",
-			expectedID: "project4",
+			name:       "IDプレフィックス一致",
+			nameOrID:   "6Jf8",
+			expectedID: "6Jf8VQXxpwv56VQ7",
+		},
+		{
+			name:        "IDプレフィックスが曖昧",
+			nameOrID:    "project",
+			expectError: true,
 		},
 		{
 			name:        "見つからない",
@@ -56,7 +75,7 @@ func TestFindProjectIDByName_Logic(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := findProjectIDByNameLogic(testProjects, tt.nameOrID)
+			result, err := findProjectIDByName(testProjects, tt.nameOrID)
 
 			if tt.expectError {
 				assert.Error(t, err, "期待されたエラーが発生しませんでした")
@@ -64,36 +83,185 @@ func TestFindProjectIDByName_Logic(t *testing.T) {
 			}
 
 			require.NoError(t, err, "予期しないエラーが発生しました")
-			assert.Equal(t, tt.expectedID, result, "findProjectIDByNameLogic()結果が期待値と異なります")
+			assert.Equal(t, tt.expectedID, result, "findProjectIDByName()結果が期待値と異なります")
 		})
 	}
 }
 
-// findProjectIDByNameLogic はテスト用に抽出したロジック関数
-// Repository.FindProjectIDByNameと同じロジックを実装
-func findProjectIDByNameLogic(projects []api.Project, nameOrID string) (string, error) {
-	nameOrIDLower := strings.ToLower(nameOrID)
+func TestResolveTaskIDByContent(t *testing.T) {
+	testTasks := []api.Item{
+		{ID: "task1", Content: "Renew passport"},
+		{ID: "task2", Content: "Buy milk"},
+		{ID: "task3", Content: "Buy milk"},
+		{ID: "task4", Content: "Book flight to Osaka"},
+		{ID: "task5", Content: "Book hotel in Osaka"},
+	}
 
-	// 1. ID完全一致（最優先・最高速）
-	for _, project := range projects {
-		if project.ID == nameOrID {
-			return project.ID, nil
-		}
+	tests := []struct {
+		name               string
+		query              string
+		expectedID         string
+		expectedCandidates []string
+		expectedExact      bool
+		expectNotFound     bool
+	}{
+		{
+			name:       "内容完全一致（大文字小文字を無視）",
+			query:      "renew passport",
+			expectedID: "task1",
+		},
+		{
+			name:               "内容完全一致が複数",
+			query:              "Buy milk",
+			expectedCandidates: []string{"task2", "task3"},
+			expectedExact:      true,
+		},
+		{
+			name:               "部分一致が1件",
+			query:              "passport",
+			expectedCandidates: []string{"task1"},
+		},
+		{
+			name:               "部分一致が複数（全単語を含む）",
+			query:              "book osaka",
+			expectedCandidates: []string{"task4", "task5"},
+		},
+		{
+			name:           "見つからない",
+			query:          "dentist",
+			expectNotFound: true,
+		},
 	}
 
-	// 2. 名前完全一致（大文字小文字を無視）
-	for _, project := range projects {
-		if strings.EqualFold(project.Name, nameOrID) {
-			return project.ID, nil
-		}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := resolveTaskIDByContent(testTasks, tt.query)
+
+			if tt.expectNotFound {
+				assert.ErrorIs(t, err, ErrTaskNotFound)
+				return
+			}
+			if tt.expectedCandidates != nil {
+				var matchErr *TaskContentMatchError
+				require.ErrorAs(t, err, &matchErr, "TaskContentMatchErrorが返されませんでした")
+				var ids []string
+				for _, candidate := range matchErr.Candidates {
+					ids = append(ids, candidate.ID)
+				}
+				assert.Equal(t, tt.expectedCandidates, ids, "候補が期待値と異なります")
+				assert.Equal(t, tt.expectedExact, matchErr.Exact, "完全一致フラグが期待値と異なります")
+				return
+			}
+
+			require.NoError(t, err, "予期しないエラーが発生しました")
+			assert.Equal(t, tt.expectedID, result, "resolveTaskIDByContent()結果が期待値と異なります")
+		})
 	}
+}
 
-	// 3. 名前部分一致（最後の手段）
-	for _, project := range projects {
-		if strings.Contains(strings.ToLower(project.Name), nameOrIDLower) {
-			return project.ID, nil
-		}
+func TestFindProjectIDByName_Hierarchy(t *testing.T) {
+	// テスト用の階層プロジェクトデータ
+	testProjects := []api.Project{
+		{ID: "inbox", Name: "インボックス", InboxProject: true},
+		{ID: "work", Name: "Work"},
+		{ID: "homework", Name: "Homework"},
+		{ID: "backend", Name: "Backend", ParentID: "work"},
+		{ID: "api", Name: "API", ParentID: "backend"},
+		{ID: "frontend", Name: "Frontend", ParentID: "work"},
+		{ID: "frontend-api", Name: "API", ParentID: "frontend"},
+		{ID: "old", Name: "Old", IsArchived: true},
+		{ID: "old-backend", Name: "Backend", ParentID: "old", IsArchived: true},
 	}
 
-	return "", fmt.Errorf("project not found: %s", nameOrID)
+	tests := []struct {
+		name               string
+		nameOrID           string
+		expectedID         string
+		expectedCandidates []string
+		expectNotFound     bool
+	}{
+		{
+			name:       "名前完全一致は部分一致より優先",
+			nameOrID:   "work",
+			expectedID: "work", // "Homework"に誤って一致しない
+		},
+		{
+			name:       "ルートからの階層パス",
+			nameOrID:   "Work/Backend/API",
+			expectedID: "api",
+		},
+		{
+			name:       "末尾一致の階層パス",
+			nameOrID:   "Frontend/API",
+			expectedID: "frontend-api",
+		},
+		{
+			name:       "アーカイブ済みプロジェクトの階層パス",
+			nameOrID:   "Old/Backend",
+			expectedID: "old-backend",
+		},
+		{
+			name:       "インボックス",
+			nameOrID:   "Inbox",
+			expectedID: "inbox",
+		},
+		{
+			name:               "同名プロジェクトは曖昧",
+			nameOrID:           "API",
+			expectedCandidates: []string{"Work/Backend/API", "Work/Frontend/API"},
+		},
+		{
+			name:           "存在しない階層パス",
+			nameOrID:       "Work/Mobile",
+			expectNotFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := findProjectIDByName(testProjects, tt.nameOrID)
+
+			if tt.expectNotFound {
+				assert.Error(t, err, "期待されたエラーが発生しませんでした")
+				return
+			}
+			if tt.expectedCandidates != nil {
+				var ambiguousErr *AmbiguousReferenceError
+				require.ErrorAs(t, err, &ambiguousErr, "AmbiguousReferenceErrorが返されませんでした")
+				var labels []string
+				for _, candidate := range ambiguousErr.Candidates {
+					labels = append(labels, candidate.Label)
+				}
+				assert.Equal(t, tt.expectedCandidates, labels, "候補が期待値と異なります")
+				return
+			}
+
+			require.NoError(t, err, "予期しないエラーが発生しました")
+			assert.Equal(t, tt.expectedID, result, "findProjectIDByName()結果が期待値と異なります")
+		})
+	}
+}
+
+func TestFindProjectIDByName_PartialMatchIsNotResolved(t *testing.T) {
+	testProjects := []api.Project{
+		{ID: "homework", Name: "Homework"},
+		{ID: "network", Name: "Network", ParentID: "homework"},
+	}
+
+	// Act: "Work"という名前のプロジェクトは無い
+	_, err := findProjectIDByName(testProjects, "Work")
+
+	// Assert: "Homework"に解決せず、部分一致を候補として返す
+	var notFoundErr *ProjectNotFoundError
+	require.ErrorAs(t, err, &notFoundErr, "ProjectNotFoundErrorが返されませんでした")
+	assert.Equal(t, []Candidate{
+		{ID: "homework", Label: "Homework"},
+		{ID: "network", Label: "Homework/Network"},
+	}, notFoundErr.Candidates)
+	assert.Contains(t, err.Error(), "project not found: Work (did you mean one of these?)")
+
+	_, err = findProjectIDByName(testProjects, "Garden")
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Empty(t, notFoundErr.Candidates)
+	assert.EqualError(t, err, "project not found: Garden")
 }
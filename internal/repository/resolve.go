@@ -0,0 +1,406 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// ErrTaskNotFound は参照に一致するタスクが見つからない場合のエラー
+var ErrTaskNotFound = errors.New("task not found")
+
+// Candidate は曖昧な参照に一致した候補を表す
+type Candidate struct {
+	ID    string
+	Label string
+}
+
+// AmbiguousReferenceError は参照が複数の候補に一致した場合のエラー
+type AmbiguousReferenceError struct {
+	Kind       string
+	Reference  string
+	Candidates []Candidate
+}
+
+// Error はerrorインターフェースを実装する
+func (e *AmbiguousReferenceError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ambiguous %s reference %q matches %d candidates:", e.Kind, e.Reference, len(e.Candidates))
+	for _, candidate := range e.Candidates {
+		fmt.Fprintf(&b, "\n  %s  %s", candidate.ID, candidate.Label)
+	}
+	return b.String()
+}
+
+// ProjectNotFoundError は参照に一致するプロジェクトが見つからない場合のエラー
+// 名前の部分一致は解決に使わず、候補として保持する
+type ProjectNotFoundError struct {
+	Reference  string
+	Candidates []Candidate
+}
+
+// Error はerrorインターフェースを実装する
+func (e *ProjectNotFoundError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "project not found: %s", e.Reference)
+	if len(e.Candidates) > 0 {
+		b.WriteString(" (did you mean one of these?)")
+		for _, candidate := range e.Candidates {
+			fmt.Fprintf(&b, "\n  %s  %s", candidate.ID, candidate.Label)
+		}
+	}
+	return b.String()
+}
+
+// TaskContentMatchError はタスク内容による検索が一意に確定しなかった場合のエラー
+// 呼び出し側で確認または選択を行うための候補を保持する
+type TaskContentMatchError struct {
+	Query      string
+	Candidates []Candidate
+	Exact      bool // 候補が内容の完全一致かどうか
+}
+
+// Error はerrorインターフェースを実装する
+func (e *TaskContentMatchError) Error() string {
+	if len(e.Candidates) == 1 {
+		return fmt.Sprintf("task %q only partially matches %q (%s)", e.Query, e.Candidates[0].Label, e.Candidates[0].ID)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "task %q matches %d tasks:", e.Query, len(e.Candidates))
+	for _, candidate := range e.Candidates {
+		fmt.Fprintf(&b, "\n  %s  %s", candidate.ID, candidate.Label)
+	}
+	return b.String()
+}
+
+// minNumericIDPrefixLength は数字のみの参照をIDプレフィックスとして扱う最小の長さ
+const minNumericIDPrefixLength = 4
+
+// isDigits は文字列が数字のみで構成されているかを返す
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveTaskID はタスク参照からタスクIDを解決する
+// 検索順序: 1. ID完全一致 2. 直近のtask listの一覧番号 3. IDプレフィックス一致（一意な場合のみ、数字のみの場合は4文字以上）
+// 4. 内容の完全一致 5. 内容の部分一致（4, 5は一意でも確認が必要な場合TaskContentMatchErrorを返す）
+func (c *Repository) ResolveTaskID(ctx context.Context, ref string) (string, error) {
+	if ref == "" {
+		return "", fmt.Errorf("task ID is required")
+	}
+
+	tasks, err := c.GetTasks(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	// 1. ID完全一致（最優先・最高速）
+	for i := range tasks {
+		if tasks[i].ID == ref {
+			return tasks[i].ID, nil
+		}
+	}
+
+	// 2. 一覧番号（ローカルストレージ有効時のみ）
+	numeric := isDigits(ref)
+	if position, err := strconv.Atoi(ref); err == nil && numeric && position > 0 && c.config.Enabled {
+		taskID, err := c.storage.GetTaskIDByListIndex(position)
+		if err != nil {
+			return "", fmt.Errorf("failed to get task list index: %w", err)
+		}
+		if taskID != "" {
+			for i := range tasks {
+				if tasks[i].ID == taskID {
+					return taskID, nil
+				}
+			}
+			return "", fmt.Errorf("%w: listing index %d refers to task %s which no longer exists", ErrTaskNotFound, position, taskID)
+		}
+		// 一覧に無い番号を数字で始まるIDとして解釈しない
+		if len(ref) < minNumericIDPrefixLength {
+			return "", fmt.Errorf("%w: no task #%d in the last listing", ErrTaskNotFound, position)
+		}
+	}
+
+	// 3. IDプレフィックス一致（数字のみの参照は一覧番号と紛らわしいため、十分な長さがある場合のみ）
+	if !numeric || len(ref) >= minNumericIDPrefixLength {
+		taskID, err := resolveTaskIDPrefix(tasks, ref)
+		if !errors.Is(err, ErrTaskNotFound) {
+			return taskID, err
+		}
+	}
+
+	// 4, 5. タスク内容で検索
+	return resolveTaskIDByContent(tasks, ref)
+}
+
+// SaveTaskListIndex はtask listで表示したタスクの並び順をローカルに保存する
+// ローカルストレージが無効、またはドライランの場合は何もしない
+func (c *Repository) SaveTaskListIndex(_ context.Context, taskIDs []string) error {
+	if !c.shouldWriteLocal() {
+		return nil
+	}
+
+	return c.storage.SaveTaskListIndex(taskIDs)
+}
+
+// resolveTaskIDPrefix はタスク一覧からIDプレフィックスに一意に一致するタスクIDを解決する
+func resolveTaskIDPrefix(tasks []api.Item, ref string) (string, error) {
+	var matches []Candidate
+	for i := range tasks {
+		if strings.HasPrefix(tasks[i].ID, ref) {
+			matches = append(matches, Candidate{ID: tasks[i].ID, Label: tasks[i].Content})
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w: %s", ErrTaskNotFound, ref)
+	case 1:
+		return matches[0].ID, nil
+	default:
+		return "", &AmbiguousReferenceError{Kind: "task", Reference: ref, Candidates: matches}
+	}
+}
+
+// resolveTaskIDByContent はタスク内容からタスクIDを解決する
+// 完全一致（大文字小文字を無視）が1件ならそのIDを返す
+// それ以外の一致はTaskContentMatchErrorとして候補を返す
+func resolveTaskIDByContent(tasks []api.Item, query string) (string, error) {
+	// 1. 内容の完全一致
+	var exactMatches []Candidate
+	for i := range tasks {
+		if strings.EqualFold(strings.TrimSpace(tasks[i].Content), strings.TrimSpace(query)) {
+			exactMatches = append(exactMatches, taskCandidate(&tasks[i]))
+		}
+	}
+	if len(exactMatches) == 1 {
+		return exactMatches[0].ID, nil
+	}
+	if len(exactMatches) > 1 {
+		return "", &TaskContentMatchError{Query: query, Candidates: exactMatches, Exact: true}
+	}
+
+	// 2. 部分一致（クエリの全単語を含むタスク）
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return "", fmt.Errorf("%w: %s", ErrTaskNotFound, query)
+	}
+	var fuzzyMatches []Candidate
+	for i := range tasks {
+		content := strings.ToLower(tasks[i].Content)
+		matched := true
+		for _, word := range words {
+			if !strings.Contains(content, word) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			fuzzyMatches = append(fuzzyMatches, taskCandidate(&tasks[i]))
+		}
+	}
+	if len(fuzzyMatches) == 0 {
+		return "", fmt.Errorf("%w: %s", ErrTaskNotFound, query)
+	}
+
+	return "", &TaskContentMatchError{Query: query, Candidates: fuzzyMatches}
+}
+
+// taskCandidate はタスクから候補を作成する（完了済みタスクはその旨を付記）
+func taskCandidate(task *api.Item) Candidate {
+	label := task.Content
+	if task.DateCompleted != nil {
+		label += " (completed)"
+	}
+	return Candidate{ID: task.ID, Label: label}
+}
+
+// maxProjectDepth はプロジェクト階層を辿る際の上限（循環参照対策）
+const maxProjectDepth = 32
+
+// projectPathSeparator はプロジェクト階層パスの区切り文字
+const projectPathSeparator = "/"
+
+// ProjectPath はプロジェクトの親を辿って "親/子" 形式のパスを構築する
+func ProjectPath(projects []api.Project, projectID string) string {
+	projectsByID := make(map[string]*api.Project, len(projects))
+	for i := range projects {
+		projectsByID[projects[i].ID] = &projects[i]
+	}
+
+	return strings.Join(projectPathNames(projectsByID, projectID), projectPathSeparator)
+}
+
+// projectPathNames はルートから指定プロジェクトまでの名前の並びを返す
+func projectPathNames(projectsByID map[string]*api.Project, projectID string) []string {
+	var names []string
+	currentID := projectID
+	for depth := 0; currentID != "" && depth < maxProjectDepth; depth++ {
+		project, exists := projectsByID[currentID]
+		if !exists {
+			break
+		}
+		names = append([]string{project.Name}, names...)
+		currentID = project.ParentID
+	}
+	return names
+}
+
+// findProjectIDByName はプロジェクト一覧から名前・階層パス・IDに一致するプロジェクトIDを検索する
+// アーカイブ済みプロジェクトやインボックスも同じ規則で解決する
+// 検索順序: 1. ID完全一致 2. 名前完全一致（大文字小文字の区別あり→なし） 3. 階層パス（"Work/Backend/API"） 4. "inbox"（インボックス）
+// 5. IDプレフィックス一致
+// 2以降で複数の候補に一致した場合は推測せずAmbiguousReferenceErrorを返す
+// いずれにも一致しない場合は、名前の部分一致を候補としたProjectNotFoundErrorを返す
+func findProjectIDByName(projects []api.Project, nameOrID string) (string, error) {
+	if nameOrID == "" {
+		return "", fmt.Errorf("project name or ID is required")
+	}
+
+	projectsByID := make(map[string]*api.Project, len(projects))
+	for i := range projects {
+		projectsByID[projects[i].ID] = &projects[i]
+	}
+
+	// 候補が一意ならそのIDを返し、複数なら曖昧エラーとする
+	resolve := func(matches []*api.Project) (string, bool, error) {
+		switch len(matches) {
+		case 0:
+			return "", false, nil
+		case 1:
+			return matches[0].ID, true, nil
+		default:
+			candidates := make([]Candidate, 0, len(matches))
+			for _, project := range matches {
+				candidates = append(candidates, Candidate{
+					ID:    project.ID,
+					Label: strings.Join(projectPathNames(projectsByID, project.ID), projectPathSeparator),
+				})
+			}
+			return "", true, &AmbiguousReferenceError{Kind: "project", Reference: nameOrID, Candidates: candidates}
+		}
+	}
+
+	// 1. ID完全一致（最優先・最高速）
+	if project, exists := projectsByID[nameOrID]; exists {
+		return project.ID, nil
+	}
+
+	// 2. 名前完全一致（大文字小文字まで一致するものを優先し、無ければ大文字小文字を無視）
+	var nameMatches, foldMatches []*api.Project
+	for i := range projects {
+		if projects[i].Name == nameOrID {
+			nameMatches = append(nameMatches, &projects[i])
+		} else if strings.EqualFold(projects[i].Name, nameOrID) {
+			foldMatches = append(foldMatches, &projects[i])
+		}
+	}
+	if id, found, err := resolve(nameMatches); found {
+		return id, err
+	}
+	if id, found, err := resolve(foldMatches); found {
+		return id, err
+	}
+
+	// 3. 階層パス（ルートからの完全パスを優先し、無ければ末尾一致のパス）
+	if strings.Contains(nameOrID, projectPathSeparator) {
+		rootMatches, suffixMatches := matchProjectPath(projects, projectsByID, nameOrID)
+		if id, found, err := resolve(rootMatches); found {
+			return id, err
+		}
+		if id, found, err := resolve(suffixMatches); found {
+			return id, err
+		}
+	}
+
+	// 4. インボックス（表示名がローカライズされていても "inbox" で指定可能）
+	if strings.EqualFold(nameOrID, "inbox") {
+		var inboxMatches []*api.Project
+		for i := range projects {
+			if projects[i].InboxProject {
+				inboxMatches = append(inboxMatches, &projects[i])
+			}
+		}
+		if id, found, err := resolve(inboxMatches); found {
+			return id, err
+		}
+	}
+
+	// 5. IDプレフィックス一致
+	var prefixMatches []*api.Project
+	for i := range projects {
+		if strings.HasPrefix(projects[i].ID, nameOrID) {
+			prefixMatches = append(prefixMatches, &projects[i])
+		}
+	}
+	if id, found, err := resolve(prefixMatches); found {
+		return id, err
+	}
+
+	// 名前の部分一致では解決せず、候補として提示する（"Work"が"Homework"に一致しないように）
+	nameOrIDLower := strings.ToLower(nameOrID)
+	notFound := &ProjectNotFoundError{Reference: nameOrID}
+	for i := range projects {
+		if strings.Contains(strings.ToLower(projects[i].Name), nameOrIDLower) {
+			notFound.Candidates = append(notFound.Candidates, Candidate{
+				ID:    projects[i].ID,
+				Label: strings.Join(projectPathNames(projectsByID, projects[i].ID), projectPathSeparator),
+			})
+		}
+	}
+	return "", notFound
+}
+
+// matchProjectPath は階層パスに一致するプロジェクトを、ルートからの完全一致と末尾一致に分けて返す
+func matchProjectPath(projects []api.Project, projectsByID map[string]*api.Project, path string) (rootMatches, suffixMatches []*api.Project) {
+	var segments []string
+	for _, segment := range strings.Split(path, projectPathSeparator) {
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		return nil, nil
+	}
+
+	for i := range projects {
+		names := projectPathNames(projectsByID, projects[i].ID)
+		if len(names) < len(segments) {
+			continue
+		}
+
+		tail := names[len(names)-len(segments):]
+		matched := true
+		for j := range segments {
+			if !strings.EqualFold(tail[j], segments[j]) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		if len(names) == len(segments) {
+			rootMatches = append(rootMatches, &projects[i])
+		} else {
+			suffixMatches = append(suffixMatches, &projects[i])
+		}
+	}
+
+	return rootMatches, suffixMatches
+}
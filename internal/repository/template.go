@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/csvtemplate"
+)
+
+// TemplateImportOptions はテンプレートCSVの取り込み先の指定
+type TemplateImportOptions struct {
+	ProjectID   string // 取り込み先の既存プロジェクト（空の場合はProjectNameで新しく作成する）
+	ProjectName string
+}
+
+// TemplateImportResult はテンプレートCSVの取り込み結果
+type TemplateImportResult struct {
+	ProjectID string // 取り込み先のプロジェクトのID
+	Sections  int
+	Tasks     int
+	Notes     int
+	Skipped   int // 取り込めなかった行（内容が空のタスク、対象のタスクがないコメントなど）
+	Response  *api.SyncResponse
+}
+
+// templateImport はテンプレートの行から組み立てたコマンド
+type templateImport struct {
+	commands    []api.Command
+	rootTasks   []api.Command // 最上位のタスク（取り消し用）
+	projectTemp string        // 新しく作成するプロジェクトのtemp_id
+	result      TemplateImportResult
+}
+
+// ImportTemplate はテンプレートCSVの行からセクション・タスク・サブタスク・コメントを作成する
+// INDENTでサブタスクの階層を表し、全てのコマンドをtemp_idで関連付けてまとめて送信する
+func (c *Repository) ImportTemplate(ctx context.Context, rows []csvtemplate.Row, opts TemplateImportOptions) (*TemplateImportResult, error) {
+	imp, err := buildTemplateImport(rows, opts)
+	if err != nil {
+		return nil, err
+	}
+	if imp.result.Sections == 0 && imp.result.Tasks == 0 {
+		return nil, fmt.Errorf("no sections or tasks to import")
+	}
+
+	// API実行（まとめて送信）
+	resp, execErr := c.executeCreateCommands(ctx, imp.commands)
+	if resp == nil {
+		return nil, execErr
+	}
+	result := imp.result
+	result.Response = resp
+
+	// 新しく作成したプロジェクトはプロジェクトごと、既存のプロジェクトでは作成したタスクを取り消せる
+	var steps []undoStep
+	if imp.projectTemp != "" {
+		result.ProjectID = resp.TempIDMapping[imp.projectTemp]
+		if result.ProjectID != "" {
+			steps = append(steps, undoStep{Type: api.CommandProjectAdd, ProjectID: result.ProjectID, Label: opts.ProjectName})
+		}
+	} else {
+		for _, cmd := range imp.rootTasks {
+			if id := resp.TempIDMapping[cmd.TempID]; id != "" {
+				steps = append(steps, undoStep{Type: api.CommandItemAdd, TaskID: id, Label: cmd.Args["content"].(string)})
+			}
+		}
+	}
+	c.recordUndo(steps...)
+
+	// 増分同期で取り込んだデータをローカルに反映
+	c.reconcile(ctx, steps...)
+
+	return &result, execErr
+}
+
+// buildTemplateImport はテンプレートの行を作成コマンドに変換する
+func buildTemplateImport(rows []csvtemplate.Row, opts TemplateImportOptions) (*templateImport, error) {
+	imp := &templateImport{result: TemplateImportResult{ProjectID: opts.ProjectID}}
+
+	projectID := opts.ProjectID
+	if projectID == "" {
+		cmd, err := api.NewCreateProjectCommand(&api.CreateProjectRequest{Name: opts.ProjectName})
+		if err != nil {
+			return nil, err
+		}
+		imp.commands = append(imp.commands, cmd)
+		imp.projectTemp = cmd.TempID
+		projectID = cmd.TempID
+	}
+
+	sectionID := ""
+	var parents []string // INDENTごとの直近のタスクのtemp_id
+	lastTaskID := ""
+	for i := range rows {
+		row := &rows[i]
+		switch row.Type {
+		case csvtemplate.TypeSection:
+			cmd, err := api.NewCreateSectionCommand(&api.CreateSectionRequest{Name: row.Content, ProjectID: projectID})
+			if err != nil {
+				// 以降のタスクが直前のセクションやタスクに紐付かないようにする
+				imp.result.Skipped++
+				sectionID = ""
+				parents, lastTaskID = nil, ""
+				continue
+			}
+			imp.commands = append(imp.commands, cmd)
+			imp.result.Sections++
+			sectionID = cmd.TempID
+			parents, lastTaskID = nil, ""
+
+		case csvtemplate.TypeTask:
+			// 階層が飛んでいる場合は直近のタスクの子として扱う
+			depth := min(max(row.Indent, 1)-1, len(parents))
+			req := templateTaskRequest(row)
+			req.ProjectID = projectID
+			req.SectionID = sectionID
+			if depth > 0 {
+				req.ParentID = parents[depth-1]
+			}
+			cmd, err := api.NewCreateTaskCommand(req)
+			if err != nil {
+				// スキップしたタスクのサブタスクやコメントが直前のタスクに紐付かないようにする
+				imp.result.Skipped++
+				parents, lastTaskID = parents[:depth], ""
+				continue
+			}
+			imp.commands = append(imp.commands, cmd)
+			imp.result.Tasks++
+			if depth == 0 {
+				imp.rootTasks = append(imp.rootTasks, cmd)
+			}
+			parents = append(parents[:depth], cmd.TempID)
+			lastTaskID = cmd.TempID
+
+		case csvtemplate.TypeNote:
+			if lastTaskID == "" {
+				imp.result.Skipped++
+				continue
+			}
+			cmd, err := api.NewCreateNoteCommand(&api.CreateNoteRequest{ItemID: lastTaskID, Content: row.Content})
+			if err != nil {
+				imp.result.Skipped++
+				continue
+			}
+			imp.commands = append(imp.commands, cmd)
+			imp.result.Notes++
+
+		case csvtemplate.TypeMeta:
+			// 表示形式などの設定は取り込まない
+
+		default:
+			imp.result.Skipped++
+		}
+	}
+
+	return imp, nil
+}
+
+// templateTaskRequest はテンプレートのタスク行をタスク作成リクエストに変換する
+func templateTaskRequest(row *csvtemplate.Row) *api.CreateTaskRequest {
+	content, labels := csvtemplate.SplitLabels(row.Content)
+	req := &api.CreateTaskRequest{
+		Content:     content,
+		Description: row.Description,
+		Labels:      labels,
+		Priority:    csvtemplate.APIPriority(row.Priority),
+		DueString:   row.Date,
+		DueLang:     row.DateLang,
+	}
+	if row.Duration > 0 && (row.DurationUnit == api.DurationUnitMinute || row.DurationUnit == api.DurationUnitDay) {
+		req.Duration = &api.Duration{Amount: row.Duration, Unit: row.DurationUnit}
+	}
+	if _, err := time.Parse("2006-01-02", row.Deadline); err == nil {
+		req.DeadlineDate = row.Deadline
+	}
+	return req
+}
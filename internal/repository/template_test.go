@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/csvtemplate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTemplateImport_SkippedSection(t *testing.T) {
+	rows := []csvtemplate.Row{
+		{Type: csvtemplate.TypeSection, Content: "Doing"},
+		{Type: csvtemplate.TypeTask, Content: "First", Indent: 1},
+		{Type: csvtemplate.TypeSection, Content: ""},
+		{Type: csvtemplate.TypeTask, Content: "Second", Indent: 2},
+		{Type: csvtemplate.TypeNote, Content: "memo"},
+	}
+
+	imp, err := buildTemplateImport(rows, TemplateImportOptions{ProjectID: "project-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, imp.result.Sections)
+	assert.Equal(t, 2, imp.result.Tasks)
+	assert.Equal(t, 1, imp.result.Notes)
+	assert.Equal(t, 1, imp.result.Skipped)
+	require.Len(t, imp.commands, 4)
+
+	// スキップしたセクションの後のタスクは直前のセクションやタスクに紐付かない
+	second := imp.commands[2]
+	assert.Equal(t, api.CommandItemAdd, second.Type)
+	assert.Equal(t, "Second", second.Args["content"])
+	assert.NotContains(t, second.Args, "section_id")
+	assert.NotContains(t, second.Args, "parent_id")
+	assert.Equal(t, second.TempID, imp.commands[3].Args["item_id"])
+}
+
+func TestBuildTemplateImport_SkippedTask(t *testing.T) {
+	rows := []csvtemplate.Row{
+		{Type: csvtemplate.TypeTask, Content: "Parent", Indent: 1},
+		{Type: csvtemplate.TypeTask, Content: "", Indent: 1},
+		{Type: csvtemplate.TypeTask, Content: "Orphan", Indent: 2},
+		{Type: csvtemplate.TypeTask, Content: "Grandchild", Indent: 3},
+	}
+
+	imp, err := buildTemplateImport(rows, TemplateImportOptions{ProjectID: "project-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, imp.result.Tasks)
+	assert.Equal(t, 1, imp.result.Skipped)
+	require.Len(t, imp.commands, 3)
+
+	// スキップしたタスクのサブタスクは直前のタスクの子にならず、最上位のタスクとして作成される
+	parent, orphan, grandchild := imp.commands[0], imp.commands[1], imp.commands[2]
+	assert.NotContains(t, orphan.Args, "parent_id")
+	assert.Equal(t, orphan.TempID, grandchild.Args["parent_id"])
+	assert.NotEqual(t, parent.TempID, grandchild.Args["parent_id"])
+	assert.Len(t, imp.rootTasks, 2)
+}
@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/plaintext"
+)
+
+// TaskImportOptions はテキスト形式のタスクの取り込みの指定
+type TaskImportOptions struct {
+	ProjectID     string            // プロジェクトの指定がないタスクの取り込み先（空の場合はインボックス）
+	Imported      map[string]string // 取り込み済みのタスクのキーからタスクIDへの対応（これらのタスクは作成しない）
+	SkipCompleted bool              // 完了済みのタスクを取り込まない
+}
+
+// PlannedTask は取り込みで作成するタスク
+type PlannedTask struct {
+	Task    plaintext.Task // Subtasksは含まない
+	Project string         // 取り込み先のプロジェクトの階層パス
+	Depth   int            // サブタスクの深さ（最上位のタスクは0）
+}
+
+// TaskImportPlan はテキスト形式のタスクの取り込みで作成するプロジェクト・タスク
+type TaskImportPlan struct {
+	Projects []string      // 作成するプロジェクトの階層パス（親が先）
+	Tasks    []PlannedTask // 作成するタスク（親が先）
+	Skipped  int           // 取り込み済み・完了済みのため作成しないタスク
+
+	commands []api.Command
+	taskKeys map[string]string // タスクのtemp_idからキーへの対応
+	steps    []undoStepRef     // 取り消し用に記録するオブジェクト
+}
+
+// undoStepRef は作成後のIDが決まってから取り消し用の記録にするオブジェクト
+type undoStepRef struct {
+	step   undoStep
+	tempID string
+}
+
+// TaskImportResult はテキスト形式のタスクの取り込み結果
+type TaskImportResult struct {
+	Projects  int
+	Tasks     int
+	Completed int
+	IDs       map[string]string // 作成したタスクのキーからタスクIDへの対応
+	Response  *api.SyncResponse
+}
+
+// PlanTaskImport はテキスト形式から読み込んだタスクの取り込み計画を作成する
+// プロジェクトは階層ごとに名前（大文字小文字を区別しない）で既存のプロジェクトを探し、無ければ作成する
+func (c *Repository) PlanTaskImport(ctx context.Context, tasks []plaintext.Task, opts TaskImportOptions) (*TaskImportPlan, error) {
+	projects, err := c.GetAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	current, err := c.GetTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	b := &taskImportBuilder{
+		plan:       &TaskImportPlan{taskKeys: make(map[string]string)},
+		opts:       opts,
+		projects:   projects,
+		projectRef: make(map[string]string),
+		newProject: make(map[string]bool),
+		openTasks:  make(map[string]bool, len(current)),
+	}
+	for i := range current {
+		if current[i].DateCompleted == nil {
+			b.openTasks[current[i].ID] = true
+		}
+	}
+
+	for i := range tasks {
+		if err := b.addTask(&tasks[i], "", 0); err != nil {
+			return nil, err
+		}
+	}
+	return b.plan, nil
+}
+
+// ImportTasks は取り込み計画に従ってプロジェクト・タスクをまとめて作成する
+func (c *Repository) ImportTasks(ctx context.Context, plan *TaskImportPlan) (*TaskImportResult, error) {
+	if len(plan.Tasks) == 0 {
+		return nil, fmt.Errorf("no tasks to import")
+	}
+
+	// API実行（まとめて送信）
+	resp, execErr := c.executeCreateCommands(ctx, plan.commands)
+	if resp == nil {
+		return nil, execErr
+	}
+
+	result := &TaskImportResult{
+		Projects: len(plan.Projects),
+		Tasks:    len(plan.Tasks),
+		IDs:      make(map[string]string),
+		Response: resp,
+	}
+	for i := range plan.Tasks {
+		if plan.Tasks[i].Task.Completed {
+			result.Completed++
+		}
+	}
+	for tempID, key := range plan.taskKeys {
+		if id := resp.TempIDMapping[tempID]; id != "" {
+			result.IDs[key] = id
+		}
+	}
+
+	// 作成したプロジェクトはプロジェクトごと、既存のプロジェクトに作成したタスクはタスクごと取り消せる
+	var steps []undoStep
+	for _, ref := range plan.steps {
+		id := resp.TempIDMapping[ref.tempID]
+		if id == "" {
+			continue
+		}
+		step := ref.step
+		if step.Type == api.CommandProjectAdd {
+			step.ProjectID = id
+		} else {
+			step.TaskID = id
+		}
+		steps = append(steps, step)
+	}
+	c.recordUndo(steps...)
+
+	// 増分同期で取り込んだデータをローカルに反映
+	c.reconcile(ctx, steps...)
+
+	return result, execErr
+}
+
+// taskImportBuilder は取り込み計画を組み立てる
+type taskImportBuilder struct {
+	plan       *TaskImportPlan
+	opts       TaskImportOptions
+	projects   []api.Project
+	projectRef map[string]string // プロジェクトの階層パス（小文字）からIDまたはtemp_idへの対応
+	newProject map[string]bool   // 作成するプロジェクトのtemp_id
+	openTasks  map[string]bool   // 現在の未完了のタスクのID
+}
+
+// addTask はタスクとサブタスクを作成するコマンドを追加する
+func (b *taskImportBuilder) addTask(task *plaintext.Task, parentRef string, depth int) error {
+	// 取り込み済み・完了済みのタスクは作成せず、サブタスクのみ取り込む
+	if id := b.opts.Imported[task.Key]; id != "" || (b.opts.SkipCompleted && task.Completed) {
+		b.plan.Skipped++
+		if !b.openTasks[id] {
+			id = ""
+		}
+		for i := range task.Subtasks {
+			if err := b.addTask(&task.Subtasks[i], id, depth); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	projectRef, projectPath, err := b.resolveProject(task.Project)
+	if err != nil {
+		return err
+	}
+	req := &api.CreateTaskRequest{
+		Content:     task.Content,
+		Description: task.Description,
+		ProjectID:   projectRef,
+		ParentID:    parentRef,
+		Labels:      task.Labels,
+		Priority:    task.Priority,
+	}
+	if len(task.Due) == len("2006-01-02") {
+		req.DueDate = task.Due
+	} else {
+		req.DueDatetime = task.Due
+	}
+	cmd, err := api.NewCreateTaskCommand(req)
+	if err != nil {
+		return fmt.Errorf("task %q: %w", task.Content, err)
+	}
+	b.plan.commands = append(b.plan.commands, cmd)
+	if task.Completed {
+		b.plan.commands = append(b.plan.commands, api.NewCompleteTaskCommand(cmd.TempID))
+	}
+	b.plan.taskKeys[cmd.TempID] = task.Key
+
+	planned := *task
+	planned.Subtasks = nil
+	b.plan.Tasks = append(b.plan.Tasks, PlannedTask{Task: planned, Project: projectPath, Depth: depth})
+
+	// 作成するプロジェクト・タスクの中に作成したタスクは、親と一緒に取り消される
+	if !b.newProject[projectRef] && (parentRef == "" || b.openTasks[parentRef]) {
+		b.plan.steps = append(b.plan.steps, undoStepRef{
+			step:   undoStep{Type: api.CommandItemAdd, Label: task.Content},
+			tempID: cmd.TempID,
+		})
+	}
+
+	for i := range task.Subtasks {
+		if err := b.addTask(&task.Subtasks[i], cmd.TempID, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveProject はプロジェクトの階層を既存のプロジェクトのIDまたは作成するプロジェクトのtemp_idに解決する
+func (b *taskImportBuilder) resolveProject(path []string) (ref, display string, err error) {
+	if len(path) == 0 {
+		return b.defaultProject()
+	}
+
+	parentRef := ""
+	var names []string
+	for _, name := range path {
+		names = append(names, name)
+		key := strings.ToLower(strings.Join(names, projectPathSeparator))
+		if ref, exists := b.projectRef[key]; exists {
+			parentRef = ref
+			continue
+		}
+
+		ref := b.findProject(parentRef, name)
+		if ref == "" {
+			cmd, err := api.NewCreateProjectCommand(&api.CreateProjectRequest{Name: name, ParentID: parentRef})
+			if err != nil {
+				return "", "", fmt.Errorf("project %q: %w", name, err)
+			}
+			b.plan.commands = append(b.plan.commands, cmd)
+			b.plan.Projects = append(b.plan.Projects, strings.Join(names, projectPathSeparator))
+			if !b.newProject[parentRef] {
+				b.plan.steps = append(b.plan.steps, undoStepRef{
+					step:   undoStep{Type: api.CommandProjectAdd, Label: name},
+					tempID: cmd.TempID,
+				})
+			}
+			b.newProject[cmd.TempID] = true
+			ref = cmd.TempID
+		}
+		b.projectRef[key] = ref
+		parentRef = ref
+	}
+	return parentRef, strings.Join(path, projectPathSeparator), nil
+}
+
+// findProject は親プロジェクトの直下から名前が一致する既存のプロジェクトを探す
+func (b *taskImportBuilder) findProject(parentID, name string) string {
+	if b.newProject[parentID] {
+		return ""
+	}
+	for i := range b.projects {
+		project := &b.projects[i]
+		if project.ParentID == parentID && !project.IsArchived && strings.EqualFold(project.Name, name) {
+			return project.ID
+		}
+	}
+	// インボックスは表示名がローカライズされていても "inbox" で指定できる
+	if parentID == "" && strings.EqualFold(name, "inbox") {
+		for i := range b.projects {
+			if b.projects[i].InboxProject {
+				return b.projects[i].ID
+			}
+		}
+	}
+	return ""
+}
+
+// defaultProject はプロジェクトの指定がないタスクの取り込み先を返す
+func (b *taskImportBuilder) defaultProject() (ref, display string, err error) {
+	projectID := b.opts.ProjectID
+	if projectID == "" {
+		for i := range b.projects {
+			if b.projects[i].InboxProject {
+				projectID = b.projects[i].ID
+			}
+		}
+	}
+	if projectID == "" {
+		// プロジェクトを省略するとインボックスに作成される
+		return "", "Inbox", nil
+	}
+	return projectID, ProjectPath(b.projects, projectID), nil
+}
@@ -0,0 +1,403 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/storage"
+)
+
+// ゴミ箱内のアイテムの種類
+const (
+	TrashKindTask    = "task"
+	TrashKindProject = "project"
+)
+
+// projectTrashWindow はプロジェクトと同時に削除されたとみなすタスクの削除日時の幅
+// プロジェクト削除時はタスクもほぼ同時にゴミ箱へ入るため、それ以前に削除されたタスクと区別する
+const projectTrashWindow = time.Minute
+
+// TrashItem はゴミ箱内のタスクまたはプロジェクトを表す
+type TrashItem struct {
+	Kind      string // TrashKindTask または TrashKindProject
+	ID        string
+	Name      string
+	ProjectID string // タスクの場合の所属プロジェクト
+	TaskCount int    // 一緒に復元されるタスク数（タスクの場合はサブタスク数）
+	DeletedAt time.Time
+}
+
+// trashContents はゴミ箱の中身をまとめたもの
+type trashContents struct {
+	tasks    []storage.TrashedTask
+	projects map[string]storage.TrashedProject
+}
+
+// loadTrash はゴミ箱の中身を読み込む
+func (c *Repository) loadTrash() (*trashContents, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("trash requires local storage to be enabled")
+	}
+
+	tasks, err := c.storage.GetTrashedTasks()
+	if err != nil {
+		return nil, err
+	}
+	projects, err := c.storage.GetTrashedProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	contents := &trashContents{
+		tasks:    tasks,
+		projects: make(map[string]storage.TrashedProject, len(projects)),
+	}
+	for _, project := range projects {
+		contents.projects[project.Project.ID] = project
+	}
+	return contents, nil
+}
+
+// deletedWithProject はタスクが所属プロジェクトと一緒に削除されたかどうかを返す
+func (t *trashContents) deletedWithProject(task storage.TrashedTask) bool {
+	project, ok := t.projects[task.Task.ProjectID]
+	return ok && !task.DeletedAt.Before(project.DeletedAt.Add(-projectTrashWindow))
+}
+
+// taskItems はゴミ箱内のタスクをapi.Itemの一覧として返す
+func (t *trashContents) taskItems() []api.Item {
+	items := make([]api.Item, 0, len(t.tasks))
+	for _, task := range t.tasks {
+		items = append(items, task.Task)
+	}
+	return items
+}
+
+// projectItems はゴミ箱内のプロジェクトをapi.Projectの一覧として返す
+func (t *trashContents) projectItems() []api.Project {
+	items := make([]api.Project, 0, len(t.projects))
+	for _, project := range t.projects {
+		items = append(items, project.Project)
+	}
+	return items
+}
+
+// projectTasks は指定したプロジェクトと一緒に復元するタスクを親から順に返す
+func (t *trashContents) projectTasks(projectIDs map[string]bool) []api.Item {
+	var tasks []api.Item
+	for _, task := range t.tasks {
+		if projectIDs[task.Task.ProjectID] && t.deletedWithProject(task) {
+			tasks = append(tasks, task.Task)
+		}
+	}
+	return sortTasksParentFirst(tasks)
+}
+
+// items はゴミ箱に表示するアイテムを削除日時の新しい順に返す
+// 子プロジェクトやサブタスク、プロジェクトと一緒に削除したタスクは親と一緒に復元するため個別には表示しない
+func (t *trashContents) items() []TrashItem {
+	taskIDs := make(map[string]bool, len(t.tasks))
+	for _, task := range t.tasks {
+		taskIDs[task.Task.ID] = true
+	}
+
+	var items []TrashItem
+	for _, project := range t.projects {
+		if _, ok := t.projects[project.Project.ParentID]; ok {
+			continue
+		}
+		tree := collectProjectTree(t.projectItems(), project.Project.ID)
+		items = append(items, TrashItem{
+			Kind:      TrashKindProject,
+			ID:        project.Project.ID,
+			Name:      project.Project.Name,
+			TaskCount: len(t.projectTasks(projectIDSet(tree))),
+			DeletedAt: project.DeletedAt,
+		})
+	}
+
+	allTasks := t.taskItems()
+	for _, task := range t.tasks {
+		if taskIDs[task.Task.ParentID] || t.deletedWithProject(task) {
+			continue
+		}
+		items = append(items, TrashItem{
+			Kind:      TrashKindTask,
+			ID:        task.Task.ID,
+			Name:      task.Task.Content,
+			ProjectID: task.Task.ProjectID,
+			TaskCount: len(collectTaskTree(allTasks, task.Task.ID)) - 1,
+			DeletedAt: task.DeletedAt,
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].DeletedAt.Equal(items[j].DeletedAt) {
+			return items[i].ID < items[j].ID
+		}
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+	return items
+}
+
+// projectIDSet はプロジェクト一覧をIDの集合に変換する
+func projectIDSet(projects []api.Project) map[string]bool {
+	ids := make(map[string]bool, len(projects))
+	for i := range projects {
+		ids[projects[i].ID] = true
+	}
+	return ids
+}
+
+// ListTrash はゴミ箱内のタスク・プロジェクトを削除日時の新しい順に返す
+func (c *Repository) ListTrash(_ context.Context) ([]TrashItem, error) {
+	contents, err := c.loadTrash()
+	if err != nil {
+		return nil, err
+	}
+	return contents.items(), nil
+}
+
+// findTrashItem はIDまたはIDプレフィックスからゴミ箱内のアイテムを探す
+func findTrashItem(items []TrashItem, ref string) (*TrashItem, error) {
+	var matches []TrashItem
+	for _, item := range items {
+		if item.ID == ref {
+			return &item, nil
+		}
+		if strings.HasPrefix(item.ID, ref) {
+			matches = append(matches, item)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no item in trash matches %q (use 'gotodoist trash list' to see deleted items)", ref)
+	case 1:
+		return &matches[0], nil
+	default:
+		candidates := make([]Candidate, 0, len(matches))
+		for _, item := range matches {
+			candidates = append(candidates, Candidate{ID: item.ID, Label: fmt.Sprintf("%s %q", item.Kind, item.Name)})
+		}
+		return nil, &AmbiguousReferenceError{Kind: "trash item", Reference: ref, Candidates: candidates}
+	}
+}
+
+// RestoreFromTrash はゴミ箱内のタスク・プロジェクトをTodoist上に再作成し、復元したアイテムと新しいIDを返す
+// プロジェクトはセクション・タスクごと、タスクはサブタスクごと復元する
+func (c *Repository) RestoreFromTrash(ctx context.Context, ref string) (*TrashItem, string, error) {
+	contents, err := c.loadTrash()
+	if err != nil {
+		return nil, "", err
+	}
+
+	item, err := findTrashItem(contents.items(), ref)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 1. 再作成するデータを組み立てる
+	var step undoStep
+	if item.Kind == TrashKindProject {
+		step, err = c.trashedProjectStep(contents, item.ID)
+	} else {
+		step, err = c.trashedTaskStep(contents, item.ID)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	tempIDs := make(map[string]string)
+	var commands []api.Command
+	if item.Kind == TrashKindProject {
+		commands, err = recreateProjectCommands(step, tempIDs)
+	} else {
+		commands, err = recreateTaskCommands(step.Tasks, tempIDs)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 2. API実行
+	resp, err := c.apiClient.ExecuteCommands(ctx, commands)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, cmd := range commands {
+		if err := resp.CommandError(cmd.UUID); err != nil {
+			return nil, "", err
+		}
+	}
+	newID := resp.TempIDMapping[tempIDs[item.ID]]
+
+	// 3. ローカル反映（古いIDの参照を新しいIDに置き換え、ゴミ箱から取り除く）
+	if c.shouldWriteLocal() {
+		c.finishTrashRestore(ctx, item, step, tempIDs, resp, newID)
+	}
+
+	return item, newID, nil
+}
+
+// finishTrashRestore は復元したアイテムをゴミ箱から取り除き、ローカルに反映する
+func (c *Repository) finishTrashRestore(ctx context.Context, item *TrashItem, step undoStep, tempIDs map[string]string, resp *api.SyncResponse, newID string) {
+	idMap := make(map[string]string, len(tempIDs))
+	for oldID, tempID := range tempIDs {
+		if id, ok := resp.TempIDMapping[tempID]; ok {
+			idMap[oldID] = id
+		}
+	}
+	if err := c.remapJournalIDs(idMap); err != nil {
+		log.Printf("Failed to remap undo journal IDs: %v", err)
+	}
+
+	taskIDs := make([]string, 0, len(step.Tasks))
+	for i := range step.Tasks {
+		taskIDs = append(taskIDs, step.Tasks[i].ID)
+	}
+	projectIDs := make([]string, 0, len(step.Projects))
+	for i := range step.Projects {
+		projectIDs = append(projectIDs, step.Projects[i].ID)
+	}
+	if err := c.storage.RemoveFromTrash(taskIDs, projectIDs); err != nil {
+		log.Printf("Failed to remove restored items from trash: %v", err)
+	}
+
+	// 復元も取り消せるように作成操作として記録
+	if newID != "" {
+		if item.Kind == TrashKindProject {
+			c.recordUndo(undoStep{Type: api.CommandProjectAdd, ProjectID: newID, Label: item.Name})
+		} else {
+			c.recordUndo(undoStep{Type: api.CommandItemAdd, TaskID: newID, Label: item.Name})
+		}
+	}
+
+	c.reconcile(ctx)
+}
+
+// trashedProjectStep はゴミ箱内のプロジェクトを子プロジェクト・セクション・タスクごと再作成するデータを組み立てる
+func (c *Repository) trashedProjectStep(contents *trashContents, projectID string) (undoStep, error) {
+	activeProjects, err := c.storage.GetAllProjects()
+	if err != nil {
+		return undoStep{}, err
+	}
+
+	projects := collectProjectTree(contents.projectItems(), projectID)
+	// 親プロジェクトが存在しない場合はルートに復元する
+	if len(projects) > 0 && !projectIDSet(activeProjects)[projects[0].ParentID] {
+		projects[0].ParentID = ""
+	}
+
+	step := undoStep{Projects: projects}
+	for i := range projects {
+		sections, err := c.storage.GetSectionsByProjectIncludingDeleted(projects[i].ID)
+		if err != nil {
+			return undoStep{}, err
+		}
+		step.Sections = append(step.Sections, sections...)
+	}
+
+	sectionIDs := make(map[string]bool, len(step.Sections))
+	for i := range step.Sections {
+		sectionIDs[step.Sections[i].ID] = true
+	}
+
+	step.Tasks = contents.projectTasks(projectIDSet(projects))
+	for i := range step.Tasks {
+		if !sectionIDs[step.Tasks[i].SectionID] {
+			step.Tasks[i].SectionID = ""
+		}
+	}
+	detachMissingParents(step.Tasks, nil)
+	return step, nil
+}
+
+// trashedTaskStep はゴミ箱内のタスクをサブタスクごと再作成するデータを組み立てる
+func (c *Repository) trashedTaskStep(contents *trashContents, taskID string) (undoStep, error) {
+	tasks := collectTaskTree(contents.taskItems(), taskID)
+	if len(tasks) == 0 {
+		return undoStep{}, fmt.Errorf("task %s is not in the trash", taskID)
+	}
+
+	activeProjects, err := c.storage.GetAllProjects()
+	if err != nil {
+		return undoStep{}, err
+	}
+	if !projectIDSet(activeProjects)[tasks[0].ProjectID] {
+		return undoStep{}, fmt.Errorf("the project of task %q no longer exists; restore the project first", tasks[0].Content)
+	}
+
+	activeSections, err := c.storage.GetAllSections()
+	if err != nil {
+		return undoStep{}, err
+	}
+	sectionIDs := make(map[string]bool, len(activeSections))
+	for i := range activeSections {
+		sectionIDs[activeSections[i].ID] = true
+	}
+
+	activeTasks, err := c.storage.GetTasks()
+	if err != nil {
+		return undoStep{}, err
+	}
+
+	// 削除済みのセクションや親タスクには戻せないため、プロジェクト直下に復元する
+	for i := range tasks {
+		if !sectionIDs[tasks[i].SectionID] {
+			tasks[i].SectionID = ""
+		}
+	}
+	detachMissingParents(tasks, activeTasks)
+
+	return undoStep{Tasks: tasks}, nil
+}
+
+// detachMissingParents は復元対象にも既存タスクにも親がいないタスクの親を外す
+func detachMissingParents(tasks, activeTasks []api.Item) {
+	existing := make(map[string]bool, len(tasks)+len(activeTasks))
+	for i := range tasks {
+		existing[tasks[i].ID] = true
+	}
+	for i := range activeTasks {
+		existing[activeTasks[i].ID] = true
+	}
+
+	for i := range tasks {
+		if tasks[i].ParentID != "" && !existing[tasks[i].ParentID] {
+			tasks[i].ParentID = ""
+		}
+	}
+}
+
+// PurgeTrash はゴミ箱内のタスク・プロジェクトを完全に削除し、削除した件数を返す
+func (c *Repository) PurgeTrash(ctx context.Context) (int, error) {
+	items, err := c.ListTrash(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !c.shouldWriteLocal() {
+		return len(items), nil // ドライラン時はローカルストレージを変更しない
+	}
+
+	if _, err := c.storage.PurgeTrash(time.Now()); err != nil {
+		return 0, err
+	}
+	return len(items), nil
+}
+
+// purgeExpiredTrash は保持期間を過ぎたゴミ箱内のタスク・プロジェクトを削除する
+func (c *Repository) purgeExpiredTrash() {
+	if c.config.TrashRetentionDays <= 0 {
+		return
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -c.config.TrashRetentionDays)
+	if _, err := c.storage.PurgeTrash(cutoff); err != nil {
+		log.Printf("Failed to purge expired trash: %v", err)
+	}
+}
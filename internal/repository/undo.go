@@ -0,0 +1,514 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/kyokomi/gotodoist/internal/storage"
+)
+
+// ErrNothingToUndo は取り消せる変更操作がない場合のエラー
+var ErrNothingToUndo = errors.New("nothing to undo")
+
+// UndoEntry は取り消し可能な変更操作を表す
+type UndoEntry struct {
+	ID          int64
+	Description string
+	CreatedAt   time.Time
+}
+
+// newUndoEntry はジャーナルの行からUndoEntryを作成する
+func newUndoEntry(entry storage.JournalEntry) UndoEntry {
+	return UndoEntry{
+		ID:          entry.ID,
+		Description: entry.Description,
+		CreatedAt:   entry.CreatedAt,
+	}
+}
+
+// ListUndoEntries は取り消し可能な変更操作を新しい順に返す（limitが0以下の場合は全件）
+func (c *Repository) ListUndoEntries(limit int) ([]UndoEntry, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("undo requires local storage to be enabled")
+	}
+
+	entries, err := c.storage.GetJournalEntries(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]UndoEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, newUndoEntry(entry))
+	}
+	return result, nil
+}
+
+// Undo は直近n件の変更操作を新しい順に取り消し、取り消した操作を返す
+// 途中で失敗した場合は、それまでに取り消した操作とエラーを返す
+func (c *Repository) Undo(ctx context.Context, n int) ([]UndoEntry, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("undo requires local storage to be enabled")
+	}
+
+	var undone []UndoEntry
+	skip := 0 // ドライラン時は取り消し済みにならないため、読み飛ばす件数
+	for len(undone) < n {
+		// 再作成によるIDの置き換えを反映するため、1件ずつ読み込む
+		entries, err := c.storage.GetJournalEntries(skip + 1)
+		if err != nil {
+			return undone, err
+		}
+		if len(entries) <= skip {
+			break
+		}
+		entry := entries[skip]
+
+		if err := c.undoEntry(ctx, entry); err != nil {
+			return undone, fmt.Errorf("failed to undo %s: %w", entry.Description, err)
+		}
+		undone = append(undone, newUndoEntry(entry))
+		if !c.shouldWriteLocal() {
+			skip++
+		}
+	}
+
+	if len(undone) == 0 {
+		return nil, ErrNothingToUndo
+	}
+
+	// 取り消した結果をローカルに反映
+	c.reconcile(ctx)
+
+	return undone, nil
+}
+
+// undoEntry はジャーナル1件分の逆操作を実行する
+func (c *Repository) undoEntry(ctx context.Context, entry storage.JournalEntry) error {
+	var steps []undoStep
+	if err := json.Unmarshal([]byte(entry.Payload), &steps); err != nil {
+		return fmt.Errorf("failed to decode undo journal: %w", err)
+	}
+
+	commands, tempIDs, err := buildUndoCommands(steps)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.apiClient.ExecuteCommands(ctx, commands)
+	if err != nil {
+		return err
+	}
+	for _, cmd := range commands {
+		if err := resp.CommandError(cmd.UUID); err != nil {
+			return err
+		}
+	}
+
+	if !c.shouldWriteLocal() {
+		return nil // ドライラン時はジャーナルを更新しない
+	}
+
+	// 再作成したタスク・プロジェクトのIDで古いジャーナルを書き換える
+	idMap := make(map[string]string, len(tempIDs))
+	for oldID, tempID := range tempIDs {
+		if newID, ok := resp.TempIDMapping[tempID]; ok {
+			idMap[oldID] = newID
+		}
+	}
+	if err := c.remapJournalIDs(idMap); err != nil {
+		log.Printf("Failed to remap undo journal IDs: %v", err)
+	}
+
+	// 再作成したタスク・プロジェクトはゴミ箱から取り除く
+	var taskIDs, projectIDs []string
+	for _, step := range steps {
+		if step.Type != api.CommandItemDelete && step.Type != api.CommandProjectDelete {
+			continue
+		}
+		for i := range step.Tasks {
+			taskIDs = append(taskIDs, step.Tasks[i].ID)
+		}
+		for i := range step.Projects {
+			projectIDs = append(projectIDs, step.Projects[i].ID)
+		}
+	}
+	if err := c.storage.RemoveFromTrash(taskIDs, projectIDs); err != nil {
+		log.Printf("Failed to remove restored items from trash: %v", err)
+	}
+
+	return c.storage.MarkJournalEntryUndone(entry.ID)
+}
+
+// buildUndoCommands は記録した変更前データから逆操作のコマンドを構築する
+// 再作成したリソースの元のIDからtemp_idへの対応も返す
+func buildUndoCommands(steps []undoStep) ([]api.Command, map[string]string, error) {
+	tempIDs := make(map[string]string)
+	var commands []api.Command
+
+	// 後に実行したコマンドから順に取り消す
+	for i := len(steps) - 1; i >= 0; i-- {
+		cmds, err := inverseCommands(steps[i], tempIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		commands = append(commands, cmds...)
+	}
+	return commands, tempIDs, nil
+}
+
+// inverseCommands は1つのコマンドの逆操作を構築する
+func inverseCommands(step undoStep, tempIDs map[string]string) ([]api.Command, error) {
+	needsPreImage := func() error {
+		if len(step.Tasks) == 0 && len(step.Projects) == 0 && len(step.Reminders) == 0 && len(step.Filters) == 0 {
+			return fmt.Errorf("no previous state was recorded for %q", step.Label)
+		}
+		return nil
+	}
+
+	switch step.Type {
+	case api.CommandItemAdd:
+		return []api.Command{api.NewDeleteTaskCommand(step.TaskID)}, nil
+
+	case api.CommandItemClose, api.CommandItemComplete:
+		commands := []api.Command{api.NewReopenTaskCommand(step.TaskID)}
+		// 繰り返しタスクは完了で期限が進むため、期限も元に戻す
+		if len(step.Tasks) > 0 && step.Tasks[0].Due != nil && step.Tasks[0].Due.IsRecurring {
+			cmd, err := restoreTaskCommand(step.Tasks[0])
+			if err != nil {
+				return nil, err
+			}
+			commands = append(commands, cmd)
+		}
+		return commands, nil
+
+	case api.CommandItemUncomplete:
+		// 繰り返しタスクを再スケジュールしないよう、item_completeで完了状態に戻す
+		return []api.Command{api.NewCompleteTaskCommand(step.TaskID)}, nil
+
+	case api.CommandItemUpdate:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		cmd, err := restoreTaskCommand(step.Tasks[0])
+		if err != nil {
+			return nil, err
+		}
+		return []api.Command{cmd}, nil
+
+	case api.CommandItemMove:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		task := step.Tasks[0]
+		req := &api.MoveTaskRequest{}
+		switch {
+		case task.ParentID != "":
+			req.ParentID = task.ParentID
+		case task.SectionID != "":
+			req.SectionID = task.SectionID
+		default:
+			req.ProjectID = task.ProjectID
+		}
+		cmd, err := api.NewMoveTaskCommand(task.ID, req)
+		if err != nil {
+			return nil, err
+		}
+		return []api.Command{cmd}, nil
+
+	case api.CommandItemDelete:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		return recreateTaskCommands(step.Tasks, tempIDs)
+
+	case api.CommandProjectAdd:
+		return []api.Command{api.NewDeleteProjectCommand(step.ProjectID)}, nil
+
+	case api.CommandProjectUpdate:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		project := step.Projects[0]
+		cmd, err := api.NewUpdateProjectCommand(project.ID, &api.UpdateProjectRequest{
+			Name:       project.Name,
+			Color:      project.Color,
+			IsFavorite: project.IsFavorite,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []api.Command{cmd}, nil
+
+	case api.CommandProjectArchive:
+		return []api.Command{api.NewUnarchiveProjectCommand(step.ProjectID)}, nil
+
+	case api.CommandProjectUnarchive:
+		return []api.Command{api.NewArchiveProjectCommand(step.ProjectID)}, nil
+
+	case api.CommandProjectDelete:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		return recreateProjectCommands(step, tempIDs)
+
+	case api.CommandProjectMove:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		cmd, err := api.NewMoveProjectCommand(step.ProjectID, step.Projects[0].ParentID)
+		if err != nil {
+			return nil, err
+		}
+		return []api.Command{cmd}, nil
+
+	case api.CommandProjectReorder:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		orders := make([]api.ProjectOrder, 0, len(step.Projects))
+		for i := range step.Projects {
+			orders = append(orders, api.ProjectOrder{ID: step.Projects[i].ID, ChildOrder: step.Projects[i].ChildOrder})
+		}
+		cmd, err := api.NewReorderProjectsCommand(orders)
+		if err != nil {
+			return nil, err
+		}
+		return []api.Command{cmd}, nil
+
+	case api.CommandReminderAdd:
+		return []api.Command{api.NewDeleteReminderCommand(step.ReminderID)}, nil
+
+	case api.CommandReminderDelete:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		reminder := step.Reminders[0]
+		req := &api.CreateReminderRequest{
+			ItemID:       mapID(tempIDs, reminder.ItemID),
+			Type:         reminder.Type,
+			MinuteOffset: reminder.MinuteOffset,
+		}
+		if reminder.Due != nil {
+			req.DueDate = reminder.Due.Date
+		}
+		cmd, err := api.NewCreateReminderCommand(req)
+		if err != nil {
+			return nil, err
+		}
+		tempIDs[reminder.ID] = cmd.TempID
+		return []api.Command{cmd}, nil
+
+	case api.CommandFilterAdd:
+		return []api.Command{api.NewDeleteFilterCommand(step.FilterID)}, nil
+
+	case api.CommandFilterUpdate:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		filter := step.Filters[0]
+		cmd, err := api.NewUpdateFilterCommand(filter.ID, &api.UpdateFilterRequest{
+			Name:  filter.Name,
+			Query: filter.Query,
+			Color: filter.Color,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []api.Command{cmd}, nil
+
+	case api.CommandFilterDelete:
+		if err := needsPreImage(); err != nil {
+			return nil, err
+		}
+		filter := step.Filters[0]
+		cmd, err := api.NewCreateFilterCommand(&api.CreateFilterRequest{
+			Name:       filter.Name,
+			Query:      filter.Query,
+			Color:      filter.Color,
+			IsFavorite: filter.IsFavorite,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tempIDs[filter.ID] = cmd.TempID
+		return []api.Command{cmd}, nil
+
+	case api.CommandShareProject:
+		return []api.Command{api.NewDeleteCollaboratorCommand(step.ProjectID, step.Email)}, nil
+
+	case api.CommandDeleteCollaborator:
+		// 外したユーザーは同じメールアドレスで再度招待する
+		return []api.Command{api.NewShareProjectCommand(step.ProjectID, step.Email)}, nil
+
+	default:
+		return nil, fmt.Errorf("cannot undo %s", step.Type)
+	}
+}
+
+// mapID は再作成したリソースのIDをtemp_idに置き換える
+func mapID(tempIDs map[string]string, id string) string {
+	if tempID, ok := tempIDs[id]; ok {
+		return tempID
+	}
+	return id
+}
+
+// recreateTaskCommands は削除したタスクを再作成するコマンドを構築する（tasksは親から順に並んでいること）
+func recreateTaskCommands(tasks []api.Item, tempIDs map[string]string) ([]api.Command, error) {
+	var commands, completes []api.Command
+	for i := range tasks {
+		task := tasks[i]
+		cmd, err := api.NewCreateTaskCommand(&api.CreateTaskRequest{
+			Content:     task.Content,
+			Description: task.Description,
+			ProjectID:   mapID(tempIDs, task.ProjectID),
+			SectionID:   mapID(tempIDs, task.SectionID),
+			ParentID:    mapID(tempIDs, task.ParentID),
+			Order:       task.ChildOrder,
+			Labels:      task.Labels,
+			Priority:    task.Priority,
+			AssigneeID:  task.ResponsibleUID,
+			Duration:    task.Duration,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if task.Due != nil {
+			cmd.Args["due"] = dueArgs(task.Due)
+		}
+		if task.Deadline != nil {
+			cmd.Args["deadline"] = deadlineArgs(task.Deadline)
+		}
+		tempIDs[task.ID] = cmd.TempID
+		commands = append(commands, cmd)
+
+		if task.DateCompleted != nil {
+			completes = append(completes, api.NewCompleteTaskCommand(cmd.TempID))
+		}
+	}
+
+	// 完了済みだったタスクは子から順に完了に戻す
+	for i := len(completes) - 1; i >= 0; i-- {
+		commands = append(commands, completes[i])
+	}
+	return commands, nil
+}
+
+// recreateProjectCommands は削除したプロジェクトをセクション・タスクごと再作成するコマンドを構築する
+func recreateProjectCommands(step undoStep, tempIDs map[string]string) ([]api.Command, error) {
+	var commands []api.Command
+	for i := range step.Projects {
+		project := step.Projects[i]
+		cmd, err := api.NewCreateProjectCommand(&api.CreateProjectRequest{
+			Name:       project.Name,
+			ParentID:   mapID(tempIDs, project.ParentID),
+			Color:      project.Color,
+			IsFavorite: project.IsFavorite,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tempIDs[project.ID] = cmd.TempID
+		commands = append(commands, cmd)
+	}
+
+	for i := range step.Sections {
+		section := step.Sections[i]
+		cmd, err := api.NewCreateSectionCommand(&api.CreateSectionRequest{
+			Name:      section.Name,
+			ProjectID: mapID(tempIDs, section.ProjectID),
+			Order:     section.SectionOrder,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tempIDs[section.ID] = cmd.TempID
+		commands = append(commands, cmd)
+	}
+
+	taskCommands, err := recreateTaskCommands(step.Tasks, tempIDs)
+	if err != nil {
+		return nil, err
+	}
+	return append(commands, taskCommands...), nil
+}
+
+// restoreTaskCommand はタスクを変更前の内容に戻す更新コマンドを構築する
+func restoreTaskCommand(task api.Item) (api.Command, error) {
+	cmd, err := api.NewUpdateTaskCommand(task.ID, &api.UpdateTaskRequest{Content: task.Content})
+	if err != nil {
+		return api.Command{}, err
+	}
+
+	// 空の値も含めて変更前の状態に戻す
+	labels := task.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	cmd.Args["description"] = task.Description
+	cmd.Args["priority"] = task.Priority
+	cmd.Args["labels"] = labels
+	cmd.Args["due"] = dueArgs(task.Due)
+	cmd.Args["duration"] = durationArgs(task.Duration)
+	cmd.Args["deadline"] = deadlineArgs(task.Deadline)
+	if task.ResponsibleUID != "" {
+		cmd.Args["responsible_uid"] = task.ResponsibleUID
+	} else {
+		cmd.Args["responsible_uid"] = nil
+	}
+	return cmd, nil
+}
+
+// dueArgs は期限をコマンドの引数に変換する（期限なしの場合はnilで期限を削除する）
+func dueArgs(due *api.Due) map[string]interface{} {
+	if due == nil {
+		return nil
+	}
+
+	// 繰り返しでない期限は自然言語を再解釈させず、日付をそのまま指定する
+	args := map[string]interface{}{
+		"date": due.Date,
+	}
+	if due.IsRecurring {
+		args["string"] = due.String
+		args["is_recurring"] = true
+		if due.Lang != "" {
+			args["lang"] = due.Lang
+		}
+	}
+	if due.Timezone != "" {
+		args["timezone"] = due.Timezone
+	}
+	return args
+}
+
+// durationArgs は所要時間をコマンドの引数に変換する（所要時間なしの場合はnilで所要時間を削除する）
+func durationArgs(duration *api.Duration) map[string]interface{} {
+	if duration == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"amount": duration.Amount,
+		"unit":   duration.Unit,
+	}
+}
+
+// deadlineArgs は締め切りをコマンドの引数に変換する（締め切りなしの場合はnilで締め切りを削除する）
+func deadlineArgs(deadline *api.Deadline) map[string]interface{} {
+	if deadline == nil {
+		return nil
+	}
+	args := map[string]interface{}{
+		"date": deadline.Date,
+	}
+	if deadline.Lang != "" {
+		args["lang"] = deadline.Lang
+	}
+	return args
+}
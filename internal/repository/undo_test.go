@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildUndoCommands_DeletedProject(t *testing.T) {
+	completedAt := api.TodoistTime{}
+	steps := []undoStep{{
+		Type:      api.CommandProjectDelete,
+		ProjectID: "p1",
+		Label:     "Work",
+		Projects: []api.Project{
+			{ID: "p1", Name: "Work", Color: "red"},
+			{ID: "p2", Name: "Sub", ParentID: "p1"},
+		},
+		Sections: []api.Section{{ID: "s1", Name: "Doing", ProjectID: "p2"}},
+		Tasks: []api.Item{
+			{ID: "t1", Content: "Parent", ProjectID: "p2", SectionID: "s1"},
+			{ID: "t2", Content: "Child", ProjectID: "p2", SectionID: "s1", ParentID: "t1", DateCompleted: &completedAt},
+		},
+	}}
+
+	commands, tempIDs, err := buildUndoCommands(steps)
+
+	require.NoError(t, err)
+	require.Len(t, commands, 6)
+
+	types := make([]string, 0, len(commands))
+	for _, cmd := range commands {
+		types = append(types, cmd.Type)
+	}
+	assert.Equal(t, []string{
+		api.CommandProjectAdd, api.CommandProjectAdd, api.CommandSectionAdd,
+		api.CommandItemAdd, api.CommandItemAdd, api.CommandItemComplete,
+	}, types)
+
+	// 再作成したリソース同士はtemp_idで参照される
+	assert.Equal(t, tempIDs["p1"], commands[1].Args["parent_id"])
+	assert.Equal(t, tempIDs["p2"], commands[2].Args["project_id"])
+	assert.Equal(t, tempIDs["s1"], commands[3].Args["section_id"])
+	assert.Equal(t, tempIDs["t1"], commands[4].Args["parent_id"])
+	assert.Equal(t, tempIDs["t2"], commands[5].Args["id"], "完了済みだったタスクが完了に戻されていません")
+}
+
+func TestBuildUndoCommands_UpdatedTaskRestoresEmptyFields(t *testing.T) {
+	steps := []undoStep{{
+		Type:   api.CommandItemUpdate,
+		TaskID: "t1",
+		Tasks:  []api.Item{{ID: "t1", Content: "Original", Priority: 1}},
+	}}
+
+	commands, _, err := buildUndoCommands(steps)
+
+	require.NoError(t, err)
+	require.Len(t, commands, 1)
+	assert.Equal(t, api.CommandItemUpdate, commands[0].Type)
+	assert.Equal(t, "Original", commands[0].Args["content"])
+	assert.Equal(t, "", commands[0].Args["description"])
+	assert.Equal(t, []string{}, commands[0].Args["labels"], "変更前にラベルが無い場合はラベルを外す必要があります")
+	assert.Nil(t, commands[0].Args["due"], "変更前に期限が無い場合は期限を外す必要があります")
+	assert.Contains(t, commands[0].Args, "duration")
+	assert.Nil(t, commands[0].Args["duration"], "変更前に所要時間が無い場合は所要時間を外す必要があります")
+	assert.Contains(t, commands[0].Args, "deadline")
+	assert.Nil(t, commands[0].Args["deadline"], "変更前に締め切りが無い場合は締め切りを外す必要があります")
+	assert.Contains(t, commands[0].Args, "responsible_uid")
+	assert.Nil(t, commands[0].Args["responsible_uid"], "変更前に担当者が無い場合は担当を外す必要があります")
+}
+
+func TestBuildUndoCommands_UpdatedTaskRestoresAssignee(t *testing.T) {
+	steps := []undoStep{{
+		Type:   api.CommandItemUpdate,
+		TaskID: "t1",
+		Tasks:  []api.Item{{ID: "t1", Content: "Review PR", ResponsibleUID: "user-2"}},
+	}}
+
+	commands, _, err := buildUndoCommands(steps)
+
+	require.NoError(t, err)
+	require.Len(t, commands, 1)
+	assert.Equal(t, "user-2", commands[0].Args["responsible_uid"])
+}
+
+func TestBuildUndoCommands_UpdatedTaskRestoresDurationAndDeadline(t *testing.T) {
+	steps := []undoStep{{
+		Type:   api.CommandItemUpdate,
+		TaskID: "t1",
+		Tasks: []api.Item{{
+			ID:       "t1",
+			Content:  "Write report",
+			Due:      &api.Due{Date: "2026-11-02T10:00:00"},
+			Duration: &api.Duration{Amount: 90, Unit: api.DurationUnitMinute},
+			Deadline: &api.Deadline{Date: "2026-11-06"},
+		}},
+	}}
+
+	commands, _, err := buildUndoCommands(steps)
+
+	require.NoError(t, err)
+	require.Len(t, commands, 1)
+	assert.Equal(t, map[string]interface{}{"amount": 90, "unit": api.DurationUnitMinute}, commands[0].Args["duration"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-06"}, commands[0].Args["deadline"])
+}
+
+func TestBuildUndoCommands_DeletedTaskRestoresDurationAndDeadline(t *testing.T) {
+	steps := []undoStep{{
+		Type:   api.CommandItemDelete,
+		TaskID: "t1",
+		Label:  "Write report",
+		Tasks: []api.Item{{
+			ID:        "t1",
+			Content:   "Write report",
+			ProjectID: "p1",
+			Due:       &api.Due{Date: "2026-11-02"},
+			Duration:  &api.Duration{Amount: 2, Unit: api.DurationUnitDay},
+			Deadline:  &api.Deadline{Date: "2026-11-06"},
+		}},
+	}}
+
+	commands, _, err := buildUndoCommands(steps)
+
+	require.NoError(t, err)
+	require.Len(t, commands, 1)
+	assert.Equal(t, api.CommandItemAdd, commands[0].Type)
+	assert.Equal(t, map[string]interface{}{"amount": 2, "unit": api.DurationUnitDay}, commands[0].Args["duration"])
+	assert.Equal(t, map[string]interface{}{"date": "2026-11-06"}, commands[0].Args["deadline"])
+}
+
+func TestBuildUndoCommands_MissingPreImage(t *testing.T) {
+	steps := []undoStep{{Type: api.CommandItemDelete, TaskID: "t1", Label: "t1"}}
+
+	_, _, err := buildUndoCommands(steps)
+
+	assert.Error(t, err)
+}
+
+func TestBuildUndoCommands_MovedAndReorderedProjects(t *testing.T) {
+	steps := []undoStep{
+		{
+			Type:      api.CommandProjectMove,
+			ProjectID: "p2",
+			ParentID:  "",
+			Projects:  []api.Project{{ID: "p2", Name: "API", ParentID: "p1"}},
+		},
+		{
+			Type:   api.CommandProjectReorder,
+			Orders: []api.ProjectOrder{{ID: "p3", ChildOrder: 1}, {ID: "p4", ChildOrder: 2}},
+			Projects: []api.Project{
+				{ID: "p3", Name: "Home", ChildOrder: 2},
+				{ID: "p4", Name: "Work", ChildOrder: 1},
+			},
+		},
+	}
+
+	commands, _, err := buildUndoCommands(steps)
+
+	require.NoError(t, err)
+	require.Len(t, commands, 2)
+
+	// 後に実行した並び替えから元に戻す
+	assert.Equal(t, api.CommandProjectReorder, commands[0].Type)
+	assert.Equal(t, []map[string]interface{}{
+		{"id": "p3", "child_order": 2},
+		{"id": "p4", "child_order": 1},
+	}, commands[0].Args["projects"])
+
+	assert.Equal(t, api.CommandProjectMove, commands[1].Type)
+	assert.Equal(t, "p1", commands[1].Args["parent_id"], "元の親プロジェクトに戻す必要があります")
+}
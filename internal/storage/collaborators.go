@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// InsertCollaborator は共同作業者をローカルDBに挿入する
+func (s *SQLiteDB) InsertCollaborator(collaborator api.Collaborator) error {
+	query := `
+		INSERT OR REPLACE INTO collaborators (
+			id, email, full_name, timezone, image_id, updated_at
+		) VALUES (
+			?, ?, ?, ?, ?, strftime('%s', 'now')
+		)
+	`
+
+	_, err := s.db.Exec(query,
+		collaborator.ID, nullString(collaborator.Email), nullString(collaborator.FullName),
+		nullString(collaborator.Timezone), nullString(collaborator.ImageID),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert collaborator: %w", err)
+	}
+
+	return nil
+}
+
+// GetCollaborators は全ての共同作業者を取得する
+func (s *SQLiteDB) GetCollaborators() ([]api.Collaborator, error) {
+	rows, err := s.db.Query(`
+		SELECT id, email, full_name, timezone, image_id
+		FROM collaborators
+		ORDER BY full_name, email, id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query collaborators: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var collaborators []api.Collaborator
+	for rows.Next() {
+		var collaborator api.Collaborator
+		var email, fullName, timezone, imageID sql.NullString
+		if err := rows.Scan(&collaborator.ID, &email, &fullName, &timezone, &imageID); err != nil {
+			return nil, fmt.Errorf("failed to scan collaborator: %w", err)
+		}
+		collaborator.Email = email.String
+		collaborator.FullName = fullName.String
+		collaborator.Timezone = timezone.String
+		collaborator.ImageID = imageID.String
+		collaborators = append(collaborators, collaborator)
+	}
+
+	return collaborators, nil
+}
+
+// InsertCollaboratorState は共同作業者の参加状態をローカルDBに挿入する
+func (s *SQLiteDB) InsertCollaboratorState(state api.CollaboratorState) error {
+	query := `
+		INSERT OR REPLACE INTO collaborator_states (
+			project_id, user_id, state, is_deleted, updated_at
+		) VALUES (
+			?, ?, ?, ?, strftime('%s', 'now')
+		)
+	`
+
+	if _, err := s.db.Exec(query, state.ProjectID, state.UserID, state.State, state.IsDeleted); err != nil {
+		return fmt.Errorf("failed to insert collaborator state: %w", err)
+	}
+
+	return nil
+}
+
+// GetCollaboratorStates は削除されていない共同作業者の参加状態を取得する
+// projectIDが空の場合は全プロジェクトの参加状態を取得する
+func (s *SQLiteDB) GetCollaboratorStates(projectID string) ([]api.CollaboratorState, error) {
+	query := "SELECT project_id, user_id, state FROM collaborator_states WHERE is_deleted = FALSE"
+	var args []interface{}
+	if projectID != "" {
+		query += " AND project_id = ?"
+		args = append(args, projectID)
+	}
+	query += " ORDER BY project_id, user_id"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query collaborator states: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var states []api.CollaboratorState
+	for rows.Next() {
+		var state api.CollaboratorState
+		if err := rows.Scan(&state.ProjectID, &state.UserID, &state.State); err != nil {
+			return nil, fmt.Errorf("failed to scan collaborator state: %w", err)
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+// SetCurrentUser はログイン中のユーザーを保存する
+// 名前の解決に使えるよう共同作業者としても保存する
+func (s *SQLiteDB) SetCurrentUser(user api.User) error {
+	if err := s.InsertCollaborator(api.Collaborator{
+		ID:       user.ID,
+		Email:    user.Email,
+		FullName: user.FullName,
+		Timezone: user.Timezone,
+	}); err != nil {
+		return err
+	}
+
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO sync_state (key, value, updated_at)
+		VALUES ('current_user_id', ?, strftime('%s', 'now'))
+	`, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to set current user: %w", err)
+	}
+	return nil
+}
+
+// GetCurrentUserID はログイン中のユーザーのIDを取得する
+// 未同期の場合は空文字を返す
+func (s *SQLiteDB) GetCurrentUserID() (string, error) {
+	var userID string
+	err := s.db.QueryRow("SELECT value FROM sync_state WHERE key = 'current_user_id'").Scan(&userID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return userID, nil
+}
@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// GetCompletedTasks は指定日時以降に完了したタスクを完了日時の新しい順に取得する
+// 完了済みタスクの履歴と、同期・操作で完了になったタスクを合わせて返す（同じ完了は1件にまとめる）
+// projectIDが空の場合は全プロジェクトを対象とする
+func (s *SQLiteDB) GetCompletedTasks(since time.Time, projectID string) ([]api.Item, error) {
+	history, err := s.getCompletedHistory(since, projectID)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := s.getCompletedTaskRows(since, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(history))
+	for i := range history {
+		seen[completionKey(&history[i])] = true
+	}
+	tasks := history
+	for i := range completed {
+		if !seen[completionKey(&completed[i])] {
+			tasks = append(tasks, completed[i])
+		}
+	}
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if !tasks[i].DateCompleted.Equal(tasks[j].DateCompleted.Time) {
+			return tasks[i].DateCompleted.After(tasks[j].DateCompleted.Time)
+		}
+		return tasks[i].ID < tasks[j].ID
+	})
+	return tasks, nil
+}
+
+// completionKey は完了を識別するキー（タスクIDと完了日時）を返す
+func completionKey(task *api.Item) string {
+	return task.ID + "@" + strconv.FormatInt(task.DateCompleted.Unix(), 10)
+}
+
+// getCompletedHistory は完了済みタスクの履歴から指定日時以降の完了を取得する
+func (s *SQLiteDB) getCompletedHistory(since time.Time, projectID string) ([]api.Item, error) {
+	query := "SELECT data FROM completed_tasks WHERE completed_at >= ?"
+	args := []interface{}{since.Unix()}
+	if projectID != "" {
+		query += " AND project_id = ?"
+		args = append(args, projectID)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query completed task history: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var tasks []api.Item
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("failed to scan completed task: %w", err)
+		}
+		var task api.Item
+		if err := json.Unmarshal([]byte(data), &task); err != nil {
+			return nil, fmt.Errorf("failed to decode completed task: %w", err)
+		}
+		if task.DateCompleted == nil {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+// getCompletedTaskRows はタスクのテーブルから指定日時以降に完了したタスクを取得する
+func (s *SQLiteDB) getCompletedTaskRows(since time.Time, projectID string) ([]api.Item, error) {
+	query := `
+		SELECT
+			t.id, t.user_id, t.project_id, t.section_id, t.parent_id,
+			t.content, t.description, t.priority, t.child_order, t.day_order,
+			t.is_collapsed, t.is_completed, t.is_deleted,
+			t.assigned_by_uid, t.responsible_uid, t.sync_id,
+			t.due_date, t.due_string, t.due_lang, t.due_is_recurring, t.due_timezone,
+			t.duration_amount, t.duration_unit, t.deadline_date,
+			t.added_at, t.completed_at
+		FROM tasks t
+		WHERE t.is_deleted = FALSE AND t.completed_at IS NOT NULL AND t.completed_at >= ?
+	`
+	args := []interface{}{since.Unix()}
+	if projectID != "" {
+		query += " AND t.project_id = ?"
+		args = append(args, projectID)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query completed tasks: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var tasks []api.Item
+	for rows.Next() {
+		task, err := s.scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		if task.DateCompleted == nil {
+			continue
+		}
+
+		// ラベルを取得
+		labels, err := s.getTaskLabels(task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get task labels: %w", err)
+		}
+		task.Labels = labels
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+// InsertCompletedTask は完了済みタスクを履歴に保存する
+// 同じタスクの同じ完了日時の記録は上書きする
+func (s *SQLiteDB) InsertCompletedTask(task api.Item) error {
+	if task.DateCompleted == nil {
+		return fmt.Errorf("task %s is not completed", task.ID)
+	}
+	data, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to encode completed task: %w", err)
+	}
+	_, err = s.db.Exec(`
+		INSERT OR REPLACE INTO completed_tasks (task_id, completed_at, project_id, data)
+		VALUES (?, ?, ?, ?)
+	`, task.ID, task.DateCompleted.Unix(), task.ProjectID, string(data))
+	return err
+}
+
+// IsOpenTask はタスクが未完了のタスクとしてローカルに存在するかを返す
+func (s *SQLiteDB) IsOpenTask(taskID string) (bool, error) {
+	var count int
+	err := s.db.QueryRow(
+		"SELECT COUNT(*) FROM tasks WHERE id = ? AND is_deleted = FALSE AND is_completed = FALSE",
+		taskID,
+	).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to query task: %w", err)
+	}
+	return count > 0, nil
+}
+
+// GetCompletedHistoryRange は完了済みタスクを取得済みの期間を取得する
+func (s *SQLiteDB) GetCompletedHistoryRange() (since, until time.Time, err error) {
+	var sinceValue, untilValue string
+	err = s.db.QueryRow("SELECT value FROM sync_state WHERE key = 'completed_history_since'").Scan(&sinceValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	err = s.db.QueryRow("SELECT value FROM sync_state WHERE key = 'completed_history_until'").Scan(&untilValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	sinceUnix, err := strconv.ParseInt(sinceValue, 10, 64)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid completed history range: %w", err)
+	}
+	untilUnix, err := strconv.ParseInt(untilValue, 10, 64)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid completed history range: %w", err)
+	}
+	return time.Unix(sinceUnix, 0), time.Unix(untilUnix, 0), nil
+}
+
+// SetCompletedHistoryRange は完了済みタスクを取得済みの期間を設定する
+func (s *SQLiteDB) SetCompletedHistoryRange(since, until time.Time) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO sync_state (key, value, updated_at)
+		VALUES ('completed_history_since', ?, strftime('%s', 'now')),
+		       ('completed_history_until', ?, strftime('%s', 'now'))
+	`, since.Unix(), until.Unix())
+	return err
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// InsertFilter は保存済みフィルタをローカルDBに挿入する
+func (s *SQLiteDB) InsertFilter(filter api.Filter) error {
+	query := `
+		INSERT OR REPLACE INTO filters (
+			id, name, query, color, item_order, is_favorite, is_deleted, updated_at
+		) VALUES (
+			?, ?, ?, ?, ?, ?, ?, strftime('%s', 'now')
+		)
+	`
+
+	_, err := s.db.Exec(query,
+		filter.ID, filter.Name, filter.Query, nullString(filter.Color),
+		filter.ItemOrder, filter.IsFavorite, filter.IsDeleted,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert filter: %w", err)
+	}
+
+	return nil
+}
+
+// GetFilters はアクティブな保存済みフィルタを表示順に取得する
+func (s *SQLiteDB) GetFilters() ([]api.Filter, error) {
+	query := `
+		SELECT id, name, query, color, item_order, is_favorite, is_deleted
+		FROM filters
+		WHERE is_deleted = FALSE
+		ORDER BY item_order, name
+	`
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query filters: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var filters []api.Filter
+	for rows.Next() {
+		filter, err := s.scanFilter(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan filter: %w", err)
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// GetFilterByName は名前（大文字小文字を区別しない）またはIDで保存済みフィルタを取得する
+// 見つからない場合はnilを返す
+func (s *SQLiteDB) GetFilterByName(nameOrID string) (*api.Filter, error) {
+	filters, err := s.GetFilters()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range filters {
+		if filters[i].ID == nameOrID {
+			return &filters[i], nil
+		}
+	}
+	for i := range filters {
+		if strings.EqualFold(filters[i].Name, nameOrID) {
+			return &filters[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// DeleteFilter は保存済みフィルタを削除する（論理削除）
+func (s *SQLiteDB) DeleteFilter(filterID string) error {
+	query := "UPDATE filters SET is_deleted = TRUE, updated_at = strftime('%s', 'now') WHERE id = ?"
+	_, err := s.db.Exec(query, filterID)
+	if err != nil {
+		return fmt.Errorf("failed to delete filter: %w", err)
+	}
+	return nil
+}
+
+// scanFilter は行からFilterオブジェクトをスキャンする
+func (s *SQLiteDB) scanFilter(row interface {
+	Scan(dest ...interface{}) error
+}) (api.Filter, error) {
+	var filter api.Filter
+	var color sql.NullString
+
+	err := row.Scan(
+		&filter.ID, &filter.Name, &filter.Query, &color,
+		&filter.ItemOrder, &filter.IsFavorite, &filter.IsDeleted,
+	)
+	if err != nil {
+		return filter, err
+	}
+
+	// NULL値の処理
+	filter.Color = color.String
+
+	return filter, nil
+}
@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+)
+
+// JournalEntry は取り消し（undo）用に記録した変更操作を表す
+type JournalEntry struct {
+	ID          int64
+	Operation   string
+	Description string
+	Payload     string // 逆操作に必要な変更前データ（JSON）
+	CreatedAt   time.Time
+}
+
+// InsertJournalEntry は変更操作をジャーナルに記録する
+func (s *SQLiteDB) InsertJournalEntry(operation, description, payload string) (int64, error) {
+	result, err := s.db.Exec(
+		"INSERT INTO undo_journal (operation, description, payload) VALUES (?, ?, ?)",
+		operation, description, payload,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert journal entry: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get journal entry ID: %w", err)
+	}
+	return id, nil
+}
+
+// GetJournalEntries は未取り消しのジャーナルを新しい順に取得する（limitが0以下の場合は全件）
+func (s *SQLiteDB) GetJournalEntries(limit int) ([]JournalEntry, error) {
+	query := `
+		SELECT id, operation, description, payload, created_at
+		FROM undo_journal
+		WHERE is_undone = FALSE
+		ORDER BY id DESC
+	`
+	args := []interface{}{}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query journal entries: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var entries []JournalEntry
+	for rows.Next() {
+		var entry JournalEntry
+		var createdAt int64
+		if err := rows.Scan(&entry.ID, &entry.Operation, &entry.Description, &entry.Payload, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		entry.CreatedAt = time.Unix(createdAt, 0)
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// MarkJournalEntryUndone はジャーナルを取り消し済みにする
+func (s *SQLiteDB) MarkJournalEntryUndone(id int64) error {
+	if _, err := s.db.Exec("UPDATE undo_journal SET is_undone = TRUE WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to mark journal entry as undone: %w", err)
+	}
+	return nil
+}
+
+// RewriteJournalPayloads は未取り消しのジャーナルの変更前データを1つのトランザクションで書き換える
+// rewriteが返した内容が元と異なるジャーナルのみ更新する
+func (s *SQLiteDB) RewriteJournalPayloads(rewrite func(payload string) (string, error)) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				fmt.Printf("Warning: failed to rollback transaction: %v\n", rollbackErr)
+			}
+		}
+	}()
+
+	rows, err := tx.Query("SELECT id, payload FROM undo_journal WHERE is_undone = FALSE")
+	if err != nil {
+		return fmt.Errorf("failed to query journal entries: %w", err)
+	}
+	var entries []JournalEntry
+	for rows.Next() {
+		var entry JournalEntry
+		if err = rows.Scan(&entry.ID, &entry.Payload); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err = rows.Close(); err != nil {
+		return fmt.Errorf("failed to read journal entries: %w", err)
+	}
+
+	for _, entry := range entries {
+		var payload string
+		payload, err = rewrite(entry.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to rewrite journal entry %d: %w", entry.ID, err)
+		}
+		if payload == entry.Payload {
+			continue
+		}
+		if _, err = tx.Exec("UPDATE undo_journal SET payload = ? WHERE id = ?", payload, entry.ID); err != nil {
+			return fmt.Errorf("failed to update journal entry %d: %w", entry.ID, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
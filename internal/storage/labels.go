@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// InsertLabel はパーソナルラベルをローカルDBに挿入する
+func (s *SQLiteDB) InsertLabel(label api.Label) error {
+	query := `
+		INSERT OR REPLACE INTO labels (
+			id, name, color, item_order, is_favorite, is_deleted, updated_at
+		) VALUES (
+			?, ?, ?, ?, ?, ?, strftime('%s', 'now')
+		)
+	`
+
+	_, err := s.db.Exec(query,
+		label.ID, label.Name, nullString(label.Color),
+		label.ItemOrder, label.IsFavorite, label.IsDeleted,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert label: %w", err)
+	}
+
+	return nil
+}
+
+// GetLabels はアクティブなパーソナルラベルを表示順に取得する
+func (s *SQLiteDB) GetLabels() ([]api.Label, error) {
+	query := `
+		SELECT id, name, color, item_order, is_favorite, is_deleted
+		FROM labels
+		WHERE is_deleted = FALSE
+		ORDER BY item_order, name
+	`
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query labels: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var labels []api.Label
+	for rows.Next() {
+		var label api.Label
+		var color sql.NullString
+		if err := rows.Scan(&label.ID, &label.Name, &color, &label.ItemOrder, &label.IsFavorite, &label.IsDeleted); err != nil {
+			return nil, fmt.Errorf("failed to scan label: %w", err)
+		}
+		label.Color = color.String
+		labels = append(labels, label)
+	}
+
+	return labels, nil
+}
+
+// DeleteLabel はパーソナルラベルを削除する
+// 同じ名前のラベルを作り直せるよう、論理削除ではなく行ごと削除する
+func (s *SQLiteDB) DeleteLabel(labelID string) error {
+	_, err := s.db.Exec("DELETE FROM labels WHERE id = ?", labelID)
+	if err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"fmt"
+)
+
+// SaveTaskListIndex は直近のタスク一覧の表示順を保存する（既存の一覧は置き換える）
+func (s *SQLiteDB) SaveTaskListIndex(taskIDs []string) (err error) {
+	tx, err := s.BeginTx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				fmt.Printf("Warning: failed to rollback transaction: %v\n", rollbackErr)
+			}
+		}
+	}()
+
+	if _, err = tx.Exec("DELETE FROM task_list_index"); err != nil {
+		return fmt.Errorf("failed to clear task list index: %w", err)
+	}
+
+	for i, taskID := range taskIDs {
+		if _, err = tx.Exec("INSERT INTO task_list_index (position, task_id) VALUES (?, ?)", i+1, taskID); err != nil {
+			return fmt.Errorf("failed to insert task list index: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetTaskIDByListIndex は直近のタスク一覧の番号（1始まり）からタスクIDを取得する
+// 該当する番号がない場合は空文字を返す
+func (s *SQLiteDB) GetTaskIDByListIndex(position int) (string, error) {
+	rows, err := s.db.Query("SELECT task_id FROM task_list_index WHERE position = ?", position)
+	if err != nil {
+		return "", fmt.Errorf("failed to query task list index: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var taskID string
+	if rows.Next() {
+		if err := rows.Scan(&taskID); err != nil {
+			return "", fmt.Errorf("failed to scan task list index: %w", err)
+		}
+	}
+
+	return taskID, nil
+}
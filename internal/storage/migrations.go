@@ -1,13 +1,14 @@
 package storage
 
 import (
+	"database/sql"
 	"fmt"
 	"strconv"
 )
 
 const (
 	// CurrentSchemaVersion は現在のスキーマバージョン
-	CurrentSchemaVersion = 1
+	CurrentSchemaVersion = 2
 )
 
 // Migration はデータベースマイグレーションを表す
@@ -15,6 +16,8 @@ type Migration struct {
 	Version int
 	Name    string
 	SQL     string
+	// Apply はSQLだけでは表現できない処理（存在確認付きのカラム追加など）を行う
+	Apply func(tx *sql.Tx) error
 }
 
 // migrations は実行可能なマイグレーション一覧
@@ -27,12 +30,61 @@ var migrations = []Migration{
 -- この時点では何もしない
 `,
 	},
+	{
+		// 新規作成のデータベースはschema.sqlでカラムが作成済みのため、存在しない場合のみ追加する
+		Version: 2,
+		Name:    "add_task_duration_and_deadline",
+		Apply: func(tx *sql.Tx) error {
+			return addColumnsIfMissing(tx, "tasks", []columnDefinition{
+				{name: "duration_amount", definition: "INTEGER"},
+				{name: "duration_unit", definition: "TEXT"},
+				{name: "deadline_date", definition: "TEXT"},
+			})
+		},
+	},
 	// 将来のマイグレーションはここに追加
-	// {
-	//     Version: 2,
-	//     Name:    "add_task_tags",
-	//     SQL: `ALTER TABLE tasks ADD COLUMN tags TEXT;`,
-	// },
+}
+
+// columnDefinition は追加するカラムの定義
+type columnDefinition struct {
+	name       string
+	definition string
+}
+
+// addColumnsIfMissing はテーブルに存在しないカラムのみを追加する
+func addColumnsIfMissing(tx *sql.Tx, table string, columns []columnDefinition) error {
+	rows, err := tx.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("failed to get columns of %s: %w", table, err)
+	}
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var (
+			cid          int
+			name, ctype  string
+			notNull, pk  int
+			defaultValue sql.NullString
+		)
+		if err := rows.Scan(&cid, &name, &ctype, &notNull, &defaultValue, &pk); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("failed to scan column of %s: %w", table, err)
+		}
+		existing[name] = true
+	}
+	if err := rows.Close(); err != nil {
+		return fmt.Errorf("failed to close rows: %w", err)
+	}
+
+	for _, column := range columns {
+		if existing[column.name] {
+			continue
+		}
+		query := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column.name, column.definition)
+		if _, err := tx.Exec(query); err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %w", table, column.name, err)
+		}
+	}
+	return nil
 }
 
 // RunMigrations はデータベースマイグレーションを実行する
@@ -94,6 +146,11 @@ func (s *SQLiteDB) runMigration(migration Migration) error {
 			return fmt.Errorf("failed to execute migration SQL: %w", err)
 		}
 	}
+	if migration.Apply != nil {
+		if err = migration.Apply(tx); err != nil {
+			return err
+		}
+	}
 
 	// スキーマバージョンを更新
 	_, err = tx.Exec(`
@@ -109,7 +166,6 @@ func (s *SQLiteDB) runMigration(migration Migration) error {
 		return fmt.Errorf("failed to commit migration transaction: %w", err)
 	}
 
-	fmt.Printf("Migration %d (%s) completed successfully\n", migration.Version, migration.Name)
 	return nil
 }
 
@@ -126,6 +182,9 @@ func (s *SQLiteDB) ResetDatabase() error {
 		"sections",
 		"projects",
 		"labels",
+		"notes",
+		"completed_tasks",
+		"task_list_index",
 		"sync_state",
 	}
 
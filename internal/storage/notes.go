@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// InsertNote はノートをローカルDBに挿入する
+func (s *SQLiteDB) InsertNote(note api.Note) error {
+	query := `
+		INSERT OR REPLACE INTO notes (
+			id, item_id, project_id, posted_uid, content, file_attachment,
+			is_deleted, posted_at, updated_at
+		) VALUES (
+			?, ?, ?, ?, ?, ?, ?, ?, strftime('%s', 'now')
+		)
+	`
+
+	var fileAttachment sql.NullString
+	if len(note.FileAttachment) > 0 {
+		data, err := json.Marshal(note.FileAttachment)
+		if err != nil {
+			return fmt.Errorf("failed to marshal file attachment: %w", err)
+		}
+		fileAttachment = sql.NullString{String: string(data), Valid: true}
+	}
+
+	var postedAt sql.NullInt64
+	if !note.Posted.IsZero() {
+		postedAt = sql.NullInt64{Int64: note.Posted.Unix(), Valid: true}
+	}
+
+	_, err := s.db.Exec(query,
+		note.ID, nullString(note.ItemID), nullString(note.ProjectID),
+		nullString(note.PostedUID), note.Content, fileAttachment,
+		note.IsDeleted, postedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert note: %w", err)
+	}
+
+	return nil
+}
+
+// GetNotesByTask はタスク指定でアクティブなノートを取得する（投稿日時順）
+func (s *SQLiteDB) GetNotesByTask(taskID string) ([]api.Note, error) {
+	query := `
+		SELECT 
+			id, item_id, project_id, posted_uid, content, file_attachment,
+			is_deleted, posted_at
+		FROM notes
+		WHERE item_id = ? AND is_deleted = FALSE
+		ORDER BY posted_at, id
+	`
+
+	rows, err := s.db.Query(query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notes by task: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var notes []api.Note
+	for rows.Next() {
+		note, err := s.scanNote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+		notes = append(notes, note)
+	}
+
+	return notes, nil
+}
+
+// GetAllNotes は削除されていない全てのノートを投稿順に取得する
+func (s *SQLiteDB) GetAllNotes() ([]api.Note, error) {
+	query := `
+		SELECT 
+			id, item_id, project_id, posted_uid, content, file_attachment,
+			is_deleted, posted_at
+		FROM notes
+		WHERE is_deleted = FALSE
+		ORDER BY posted_at, id
+	`
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notes: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var notes []api.Note
+	for rows.Next() {
+		note, err := s.scanNote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+		notes = append(notes, note)
+	}
+
+	return notes, nil
+}
+
+// DeleteNote はノートを削除する（論理削除）
+func (s *SQLiteDB) DeleteNote(noteID string) error {
+	query := "UPDATE notes SET is_deleted = TRUE, updated_at = strftime('%s', 'now') WHERE id = ?"
+	_, err := s.db.Exec(query, noteID)
+	if err != nil {
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+	return nil
+}
+
+// scanNote は行からNoteオブジェクトをスキャンする
+func (s *SQLiteDB) scanNote(row interface {
+	Scan(dest ...interface{}) error
+}) (api.Note, error) {
+	var note api.Note
+	var itemID, projectID, postedUID, fileAttachment sql.NullString
+	var postedAt sql.NullInt64
+
+	err := row.Scan(
+		&note.ID, &itemID, &projectID, &postedUID, &note.Content, &fileAttachment,
+		&note.IsDeleted, &postedAt,
+	)
+	if err != nil {
+		return note, err
+	}
+
+	// NULL値の処理
+	note.ItemID = itemID.String
+	note.ProjectID = projectID.String
+	note.PostedUID = postedUID.String
+
+	if fileAttachment.Valid {
+		if err := json.Unmarshal([]byte(fileAttachment.String), &note.FileAttachment); err != nil {
+			return note, fmt.Errorf("failed to unmarshal file attachment: %w", err)
+		}
+	}
+
+	// 日時の処理
+	if postedAt.Valid {
+		note.Posted = api.TodoistTime{Time: time.Unix(postedAt.Int64, 0)}
+	}
+
+	return note, nil
+}
@@ -164,8 +164,9 @@ func (s *SQLiteDB) FindProjectsByName(name string) ([]api.Project, error) {
 }
 
 // DeleteProject はプロジェクトを削除する（論理削除）
+// 削除済みのプロジェクトはゴミ箱に入った日時（updated_at）を変えない
 func (s *SQLiteDB) DeleteProject(projectID string) error {
-	query := "UPDATE projects SET is_deleted = TRUE, updated_at = strftime('%s', 'now') WHERE id = ?"
+	query := "UPDATE projects SET is_deleted = TRUE, updated_at = strftime('%s', 'now') WHERE id = ? AND is_deleted = FALSE"
 	_, err := s.db.Exec(query, projectID)
 	if err != nil {
 		return fmt.Errorf("failed to delete project: %w", err)
@@ -173,6 +174,24 @@ func (s *SQLiteDB) DeleteProject(projectID string) error {
 	return nil
 }
 
+// UpdateProjectParent はプロジェクトの親プロジェクトを更新する（parentIDが空の場合はルート）
+func (s *SQLiteDB) UpdateProjectParent(projectID, parentID string) error {
+	query := "UPDATE projects SET parent_id = ?, updated_at = strftime('%s', 'now') WHERE id = ?"
+	if _, err := s.db.Exec(query, nullString(parentID), projectID); err != nil {
+		return fmt.Errorf("failed to update project parent: %w", err)
+	}
+	return nil
+}
+
+// UpdateProjectChildOrder はプロジェクトの並び順を更新する
+func (s *SQLiteDB) UpdateProjectChildOrder(projectID string, childOrder int) error {
+	query := "UPDATE projects SET child_order = ?, updated_at = strftime('%s', 'now') WHERE id = ?"
+	if _, err := s.db.Exec(query, childOrder, projectID); err != nil {
+		return fmt.Errorf("failed to update project order: %w", err)
+	}
+	return nil
+}
+
 // scanProject は行からProjectオブジェクトをスキャンする
 func (s *SQLiteDB) scanProject(row interface {
 	Scan(dest ...interface{}) error
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// InsertReminder はリマインダーをローカルDBに挿入する
+func (s *SQLiteDB) InsertReminder(reminder api.Reminder) error {
+	query := `
+		INSERT OR REPLACE INTO reminders (
+			id, item_id, notify_uid, type, minute_offset,
+			due_date, due_string, due_timezone, is_deleted, updated_at
+		) VALUES (
+			?, ?, ?, ?, ?, ?, ?, ?, ?, strftime('%s', 'now')
+		)
+	`
+
+	var dueDate, dueString, dueTimezone sql.NullString
+	if reminder.Due != nil {
+		dueDate = nullString(reminder.Due.Date)
+		dueString = nullString(reminder.Due.String)
+		dueTimezone = nullString(reminder.Due.Timezone)
+	}
+
+	_, err := s.db.Exec(query,
+		reminder.ID, reminder.ItemID, nullString(reminder.NotifyUID), reminder.Type, reminder.MinuteOffset,
+		dueDate, dueString, dueTimezone, reminder.IsDeleted,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert reminder: %w", err)
+	}
+
+	return nil
+}
+
+// GetReminders はアクティブなリマインダーを取得する
+// taskIDが空の場合は全タスクのリマインダーを取得する
+func (s *SQLiteDB) GetReminders(taskID string) ([]api.Reminder, error) {
+	query := `
+		SELECT
+			id, item_id, notify_uid, type, minute_offset,
+			due_date, due_string, due_timezone, is_deleted
+		FROM reminders
+		WHERE is_deleted = FALSE
+	`
+	var args []interface{}
+	if taskID != "" {
+		query += " AND item_id = ?"
+		args = append(args, taskID)
+	}
+	query += " ORDER BY item_id, type, minute_offset DESC, due_date, id"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reminders: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var reminders []api.Reminder
+	for rows.Next() {
+		reminder, err := s.scanReminder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reminder: %w", err)
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	return reminders, nil
+}
+
+// DeleteReminder はリマインダーを削除する（論理削除）
+func (s *SQLiteDB) DeleteReminder(reminderID string) error {
+	query := "UPDATE reminders SET is_deleted = TRUE, updated_at = strftime('%s', 'now') WHERE id = ?"
+	_, err := s.db.Exec(query, reminderID)
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	return nil
+}
+
+// scanReminder は行からReminderオブジェクトをスキャンする
+func (s *SQLiteDB) scanReminder(row interface {
+	Scan(dest ...interface{}) error
+}) (api.Reminder, error) {
+	var reminder api.Reminder
+	var notifyUID, dueDate, dueString, dueTimezone sql.NullString
+
+	err := row.Scan(
+		&reminder.ID, &reminder.ItemID, &notifyUID, &reminder.Type, &reminder.MinuteOffset,
+		&dueDate, &dueString, &dueTimezone, &reminder.IsDeleted,
+	)
+	if err != nil {
+		return reminder, err
+	}
+
+	// NULL値の処理
+	reminder.NotifyUID = notifyUID.String
+	if dueDate.Valid {
+		reminder.Due = &api.Due{
+			Date:     dueDate.String,
+			String:   dueString.String,
+			Timezone: dueTimezone.String,
+		}
+	}
+
+	return reminder, nil
+}
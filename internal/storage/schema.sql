@@ -62,6 +62,10 @@ CREATE TABLE IF NOT EXISTS tasks (
     due_lang TEXT,
     due_is_recurring BOOLEAN DEFAULT FALSE,
     due_timezone TEXT,
+    -- 所要時間・締め切り
+    duration_amount INTEGER,
+    duration_unit TEXT,
+    deadline_date TEXT,
     -- タイムスタンプ
     added_at INTEGER,
     completed_at INTEGER,
@@ -95,6 +99,96 @@ CREATE TABLE IF NOT EXISTS task_labels (
     -- label_nameはラベル文字列をそのまま保存（外部キー制約なし）
 );
 
+-- ノート（タスク・プロジェクトへのコメント）
+CREATE TABLE IF NOT EXISTS notes (
+    id TEXT PRIMARY KEY,
+    item_id TEXT,
+    project_id TEXT,
+    posted_uid TEXT,
+    content TEXT NOT NULL,
+    file_attachment TEXT,
+    is_deleted BOOLEAN DEFAULT FALSE,
+    posted_at INTEGER,
+    created_at INTEGER DEFAULT (strftime('%s', 'now')),
+    updated_at INTEGER DEFAULT (strftime('%s', 'now'))
+    -- item_idはタスク削除後もコメントを保持するため外部キー制約なし
+);
+
+-- 完了済みタスクの履歴（繰り返しタスクは同じIDで完了ごとに記録する）
+CREATE TABLE IF NOT EXISTS completed_tasks (
+    task_id TEXT NOT NULL,
+    completed_at INTEGER NOT NULL,
+    project_id TEXT NOT NULL,
+    data TEXT NOT NULL, -- 完了時点のタスク（JSON）
+    created_at INTEGER DEFAULT (strftime('%s', 'now')),
+    PRIMARY KEY (task_id, completed_at)
+);
+
+-- 直近のタスク一覧の表示順（一覧番号によるタスク指定用）
+CREATE TABLE IF NOT EXISTS task_list_index (
+    position INTEGER PRIMARY KEY,
+    task_id TEXT NOT NULL,
+    created_at INTEGER DEFAULT (strftime('%s', 'now'))
+);
+
+-- リマインダー
+CREATE TABLE IF NOT EXISTS reminders (
+    id TEXT PRIMARY KEY,
+    item_id TEXT NOT NULL,
+    notify_uid TEXT,
+    type TEXT NOT NULL, -- relative: 期限の何分前か / absolute: 日時指定
+    minute_offset INTEGER DEFAULT 0,
+    due_date TEXT,
+    due_string TEXT,
+    due_timezone TEXT,
+    is_deleted BOOLEAN DEFAULT FALSE,
+    created_at INTEGER DEFAULT (strftime('%s', 'now')),
+    updated_at INTEGER DEFAULT (strftime('%s', 'now'))
+);
+
+-- 保存済みフィルタ
+CREATE TABLE IF NOT EXISTS filters (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    query TEXT NOT NULL,
+    color TEXT,
+    item_order INTEGER DEFAULT 0,
+    is_favorite BOOLEAN DEFAULT FALSE,
+    is_deleted BOOLEAN DEFAULT FALSE,
+    created_at INTEGER DEFAULT (strftime('%s', 'now')),
+    updated_at INTEGER DEFAULT (strftime('%s', 'now'))
+);
+
+-- 共同作業者（ログイン中のユーザーを含む）
+CREATE TABLE IF NOT EXISTS collaborators (
+    id TEXT PRIMARY KEY,
+    email TEXT,
+    full_name TEXT,
+    timezone TEXT,
+    image_id TEXT,
+    updated_at INTEGER DEFAULT (strftime('%s', 'now'))
+);
+
+-- 共有プロジェクトごとの共同作業者の参加状態
+CREATE TABLE IF NOT EXISTS collaborator_states (
+    project_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    state TEXT NOT NULL, -- active: 参加中 / invited: 招待中
+    is_deleted BOOLEAN DEFAULT FALSE,
+    updated_at INTEGER DEFAULT (strftime('%s', 'now')),
+    PRIMARY KEY (project_id, user_id)
+);
+
+-- 変更操作の取り消し（undo）用ジャーナル
+CREATE TABLE IF NOT EXISTS undo_journal (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    operation TEXT NOT NULL,
+    description TEXT NOT NULL,
+    payload TEXT NOT NULL, -- 逆操作に必要な変更前データ（JSON）
+    is_undone BOOLEAN DEFAULT FALSE,
+    created_at INTEGER DEFAULT (strftime('%s', 'now'))
+);
+
 -- 同期状態管理
 CREATE TABLE IF NOT EXISTS sync_state (
     key TEXT PRIMARY KEY,
@@ -113,6 +207,11 @@ CREATE INDEX IF NOT EXISTS idx_sections_project_id ON sections(project_id);
 CREATE INDEX IF NOT EXISTS idx_sections_deleted ON sections(is_deleted);
 CREATE INDEX IF NOT EXISTS idx_projects_deleted ON projects(is_deleted);
 CREATE INDEX IF NOT EXISTS idx_projects_archived ON projects(is_archived);
+CREATE INDEX IF NOT EXISTS idx_notes_item_id ON notes(item_id);
+CREATE INDEX IF NOT EXISTS idx_notes_project_id ON notes(project_id);
+CREATE INDEX IF NOT EXISTS idx_completed_tasks_completed_at ON completed_tasks(completed_at);
+CREATE INDEX IF NOT EXISTS idx_reminders_item_id ON reminders(item_id);
+CREATE INDEX IF NOT EXISTS idx_collaborator_states_user_id ON collaborator_states(user_id);
 
 -- 初期データ（既存データがある場合は上書きしない）
 INSERT OR IGNORE INTO sync_state (key, value) VALUES 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3" // SQLite driver
@@ -45,6 +46,11 @@ func NewSQLiteDB(dbPath string) (*SQLiteDB, error) {
 		return nil, fmt.Errorf("failed to initialize schema: %w", err)
 	}
 
+	// 既存データベースのマイグレーション
+	if err := sqliteDB.RunMigrations(); err != nil {
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+
 	return sqliteDB, nil
 }
 
@@ -130,6 +136,32 @@ func (s *SQLiteDB) SetInitialSyncDone(done bool) error {
 	return err
 }
 
+// GetSyncedResourceTypes は初期同期済みのリソースタイプを取得する
+// 記録が無い場合は空のスライスを返す
+func (s *SQLiteDB) GetSyncedResourceTypes() ([]string, error) {
+	var value string
+	err := s.db.QueryRow("SELECT value FROM sync_state WHERE key = 'synced_resource_types'").Scan(&value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+	return strings.Split(value, ","), nil
+}
+
+// SetSyncedResourceTypes は初期同期済みのリソースタイプを設定する
+func (s *SQLiteDB) SetSyncedResourceTypes(resourceTypes []string) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO sync_state (key, value, updated_at)
+		VALUES ('synced_resource_types', ?, strftime('%s', 'now'))
+	`, strings.Join(resourceTypes, ","))
+	return err
+}
+
 // BeginTx はトランザクションを開始する
 func (s *SQLiteDB) BeginTx() (*sql.Tx, error) {
 	return s.db.Begin()
@@ -161,7 +193,17 @@ func (s *SQLiteDB) ResetAllData() error {
 		"DELETE FROM tasks",
 		"DELETE FROM projects",
 		"DELETE FROM sections",
-		"DELETE FROM sync_state",
+		"DELETE FROM notes",
+		"DELETE FROM completed_tasks",
+		"DELETE FROM reminders",
+		"DELETE FROM filters",
+		"DELETE FROM labels",
+		"DELETE FROM collaborators",
+		"DELETE FROM collaborator_states",
+		"DELETE FROM task_list_index",
+		"DELETE FROM undo_journal",
+		// スキーマバージョンはデータではないため残す
+		"DELETE FROM sync_state WHERE key != 'schema_version'",
 	}
 
 	// 各テーブルをクリア
@@ -20,6 +20,16 @@ func (s *SQLiteDB) InsertTask(task api.Item) error {
 		dueIsRecurring = sql.NullBool{Bool: task.Due.IsRecurring, Valid: true}
 	}
 
+	var durationAmount sql.NullInt64
+	var durationUnit, deadlineDate sql.NullString
+	if task.Duration != nil {
+		durationAmount = sql.NullInt64{Int64: int64(task.Duration.Amount), Valid: true}
+		durationUnit = nullString(task.Duration.Unit)
+	}
+	if task.Deadline != nil {
+		deadlineDate = nullString(task.Deadline.Date)
+	}
+
 	var completedAt sql.NullInt64
 	if task.DateCompleted != nil {
 		completedAt = sql.NullInt64{Int64: task.DateCompleted.Unix(), Valid: true}
@@ -32,6 +42,7 @@ func (s *SQLiteDB) InsertTask(task api.Item) error {
 			priority = ?, child_order = ?, day_order = ?, is_collapsed = ?, is_completed = ?, is_deleted = ?,
 			assigned_by_uid = ?, responsible_uid = ?, sync_id = ?,
 			due_date = ?, due_string = ?, due_lang = ?, due_is_recurring = ?, due_timezone = ?,
+			duration_amount = ?, duration_unit = ?, deadline_date = ?,
 			added_at = ?, completed_at = ?, updated_at = strftime('%s', 'now')
 		WHERE id = ?
 	`
@@ -45,6 +56,7 @@ func (s *SQLiteDB) InsertTask(task api.Item) error {
 		nullString(task.AssignedByUID), nullString(task.ResponsibleUID),
 		nullString(task.SyncID),
 		dueDate, dueString, dueLang, dueIsRecurring, dueTimezone,
+		durationAmount, durationUnit, deadlineDate,
 		task.DateAdded.Unix(), completedAt,
 		task.ID,
 	)
@@ -67,9 +79,10 @@ func (s *SQLiteDB) InsertTask(task api.Item) error {
 				priority, child_order, day_order, is_collapsed, is_completed, is_deleted,
 				assigned_by_uid, responsible_uid, sync_id,
 				due_date, due_string, due_lang, due_is_recurring, due_timezone,
+				duration_amount, duration_unit, deadline_date,
 				added_at, completed_at, updated_at
 			) VALUES (
-				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, strftime('%s', 'now')
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, strftime('%s', 'now')
 			)
 		`
 
@@ -82,6 +95,7 @@ func (s *SQLiteDB) InsertTask(task api.Item) error {
 			nullString(task.AssignedByUID), nullString(task.ResponsibleUID),
 			nullString(task.SyncID),
 			dueDate, dueString, dueLang, dueIsRecurring, dueTimezone,
+			durationAmount, durationUnit, deadlineDate,
 			task.DateAdded.Unix(), completedAt,
 		)
 
@@ -109,6 +123,7 @@ func (s *SQLiteDB) GetTasks() ([]api.Item, error) {
 			t.is_collapsed, t.is_completed, t.is_deleted,
 			t.assigned_by_uid, t.responsible_uid, t.sync_id,
 			t.due_date, t.due_string, t.due_lang, t.due_is_recurring, t.due_timezone,
+			t.duration_amount, t.duration_unit, t.deadline_date,
 			t.added_at, t.completed_at
 		FROM tasks t
 		WHERE t.is_deleted = FALSE
@@ -154,6 +169,7 @@ func (s *SQLiteDB) GetTasksByProject(projectID string) ([]api.Item, error) {
 			t.is_collapsed, t.is_completed, t.is_deleted,
 			t.assigned_by_uid, t.responsible_uid, t.sync_id,
 			t.due_date, t.due_string, t.due_lang, t.due_is_recurring, t.due_timezone,
+			t.duration_amount, t.duration_unit, t.deadline_date,
 			t.added_at, t.completed_at
 		FROM tasks t
 		WHERE t.project_id = ? AND t.is_deleted = FALSE
@@ -191,8 +207,9 @@ func (s *SQLiteDB) GetTasksByProject(projectID string) ([]api.Item, error) {
 }
 
 // DeleteTask はタスクを削除する（論理削除）
+// 削除済みのタスクはゴミ箱に入った日時（updated_at）を変えない
 func (s *SQLiteDB) DeleteTask(taskID string) error {
-	query := "UPDATE tasks SET is_deleted = TRUE, updated_at = strftime('%s', 'now') WHERE id = ?"
+	query := "UPDATE tasks SET is_deleted = TRUE, updated_at = strftime('%s', 'now') WHERE id = ? AND is_deleted = FALSE"
 	_, err := s.db.Exec(query, taskID)
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
@@ -208,6 +225,8 @@ func (s *SQLiteDB) scanTask(row interface {
 	var userID, sectionID, parentID, assignedByUID, responsibleUID, syncID sql.NullString
 	var dueDate, dueString, dueLang, dueTimezone sql.NullString
 	var dueIsRecurring sql.NullBool
+	var durationAmount sql.NullInt64
+	var durationUnit, deadlineDate sql.NullString
 	var addedAt, completedAt sql.NullInt64
 
 	err := row.Scan(
@@ -216,6 +235,7 @@ func (s *SQLiteDB) scanTask(row interface {
 		&task.Collapsed, &task.IsDeleted, &task.IsDeleted,
 		&assignedByUID, &responsibleUID, &syncID,
 		&dueDate, &dueString, &dueLang, &dueIsRecurring, &dueTimezone,
+		&durationAmount, &durationUnit, &deadlineDate,
 		&addedAt, &completedAt,
 	)
 	if err != nil {
@@ -250,6 +270,14 @@ func (s *SQLiteDB) scanTask(row interface {
 		}
 	}
 
+	// 所要時間と締め切りの処理
+	if durationAmount.Valid && durationUnit.Valid {
+		task.Duration = &api.Duration{Amount: int(durationAmount.Int64), Unit: durationUnit.String}
+	}
+	if deadlineDate.Valid {
+		task.Deadline = &api.Deadline{Date: deadlineDate.String}
+	}
+
 	return task, nil
 }
 
@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/kyokomi/gotodoist/internal/api"
+)
+
+// TrashedTask はゴミ箱内（論理削除済み）のタスク
+type TrashedTask struct {
+	Task      api.Item
+	DeletedAt time.Time
+}
+
+// TrashedProject はゴミ箱内（論理削除済み）のプロジェクト
+type TrashedProject struct {
+	Project   api.Project
+	DeletedAt time.Time
+}
+
+// rowsWithExtra は共通のscan関数に追加のカラムを読み込ませるためのラッパー
+type rowsWithExtra struct {
+	rows  *sql.Rows
+	extra []interface{}
+}
+
+// Scan は通常のカラムに続けて追加のカラムを読み込む
+func (r rowsWithExtra) Scan(dest ...interface{}) error {
+	return r.rows.Scan(append(dest, r.extra...)...)
+}
+
+// GetTrashedTasks はゴミ箱内のタスクを削除日時の新しい順に取得する
+func (s *SQLiteDB) GetTrashedTasks() ([]TrashedTask, error) {
+	query := `
+		SELECT 
+			t.id, t.user_id, t.project_id, t.section_id, t.parent_id, 
+			t.content, t.description, t.priority, t.child_order, t.day_order,
+			t.is_collapsed, t.is_completed, t.is_deleted,
+			t.assigned_by_uid, t.responsible_uid, t.sync_id,
+			t.due_date, t.due_string, t.due_lang, t.due_is_recurring, t.due_timezone,
+			t.duration_amount, t.duration_unit, t.deadline_date,
+			t.added_at, t.completed_at, t.updated_at
+		FROM tasks t
+		WHERE t.is_deleted = TRUE
+		ORDER BY t.updated_at DESC, t.child_order, t.id
+	`
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trashed tasks: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var trashed []TrashedTask
+	for rows.Next() {
+		var deletedAt int64
+		task, err := s.scanTask(rowsWithExtra{rows: rows, extra: []interface{}{&deletedAt}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		trashed = append(trashed, TrashedTask{Task: task, DeletedAt: time.Unix(deletedAt, 0)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate trashed tasks: %w", err)
+	}
+
+	// ラベルを取得（行の読み込みが終わってから問い合わせる）
+	for i := range trashed {
+		labels, err := s.getTaskLabels(trashed[i].Task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get task labels: %w", err)
+		}
+		trashed[i].Task.Labels = labels
+	}
+
+	return trashed, nil
+}
+
+// GetTrashedProjects はゴミ箱内のプロジェクトを削除日時の新しい順に取得する
+func (s *SQLiteDB) GetTrashedProjects() ([]TrashedProject, error) {
+	query := `
+		SELECT 
+			id, name, color, parent_id, child_order, collapsed, shared,
+			is_deleted, is_archived, is_favorite, inbox_project, team_inbox, sync_id, updated_at
+		FROM projects
+		WHERE is_deleted = TRUE
+		ORDER BY updated_at DESC, child_order, name
+	`
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trashed projects: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var trashed []TrashedProject
+	for rows.Next() {
+		var deletedAt int64
+		project, err := s.scanProject(rowsWithExtra{rows: rows, extra: []interface{}{&deletedAt}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan project: %w", err)
+		}
+		trashed = append(trashed, TrashedProject{Project: project, DeletedAt: time.Unix(deletedAt, 0)})
+	}
+
+	return trashed, nil
+}
+
+// GetSectionsByProjectIncludingDeleted は削除済みを含むプロジェクトのセクションを取得する
+// 削除したプロジェクトをゴミ箱から復元する際に使用する
+func (s *SQLiteDB) GetSectionsByProjectIncludingDeleted(projectID string) ([]api.Section, error) {
+	query := `
+		SELECT 
+			id, name, project_id, section_order, collapsed, is_deleted,
+			sync_id, date_added, date_archived
+		FROM sections
+		WHERE project_id = ?
+		ORDER BY section_order, name
+	`
+
+	rows, err := s.db.Query(query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sections by project: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			fmt.Printf("Warning: failed to close rows: %v\n", err)
+		}
+	}()
+
+	var sections []api.Section
+	for rows.Next() {
+		section, err := s.scanSection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan section: %w", err)
+		}
+		sections = append(sections, section)
+	}
+
+	return sections, nil
+}
+
+// RemoveFromTrash はゴミ箱内の指定したタスク・プロジェクトを完全に削除する
+// プロジェクトを削除するとセクションとタスクも外部キーにより削除される
+func (s *SQLiteDB) RemoveFromTrash(taskIDs, projectIDs []string) (err error) {
+	tx, err := s.BeginTx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				fmt.Printf("Warning: failed to rollback transaction: %v\n", rollbackErr)
+			}
+		}
+	}()
+
+	for _, taskID := range taskIDs {
+		if _, err = tx.Exec("DELETE FROM tasks WHERE id = ? AND is_deleted = TRUE", taskID); err != nil {
+			return fmt.Errorf("failed to remove task from trash: %w", err)
+		}
+	}
+	for _, projectID := range projectIDs {
+		if _, err = tx.Exec("DELETE FROM projects WHERE id = ? AND is_deleted = TRUE", projectID); err != nil {
+			return fmt.Errorf("failed to remove project from trash: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// PurgeTrash は指定日時以前にゴミ箱に入ったタスク・プロジェクト・セクションを完全に削除し、削除した件数を返す
+func (s *SQLiteDB) PurgeTrash(before time.Time) (purged int64, err error) {
+	tx, err := s.BeginTx()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				fmt.Printf("Warning: failed to rollback transaction: %v\n", rollbackErr)
+			}
+		}
+	}()
+
+	// タスク → セクション → プロジェクトの順に削除し、外部キーで消えたタスクを数え漏らさないようにする
+	// 件数はゴミ箱に表示されるタスクとプロジェクトのみを数える
+	queries := []struct {
+		sql     string
+		counted bool
+	}{
+		{"DELETE FROM tasks WHERE is_deleted = TRUE AND updated_at <= ?", true},
+		{"DELETE FROM sections WHERE is_deleted = TRUE AND updated_at <= ?", false},
+		{"DELETE FROM projects WHERE is_deleted = TRUE AND updated_at <= ?", true},
+	}
+	for _, query := range queries {
+		var result sql.Result
+		if result, err = tx.Exec(query.sql, before.Unix()); err != nil {
+			return 0, fmt.Errorf("failed to purge trash: %w", err)
+		}
+		var affected int64
+		if affected, err = result.RowsAffected(); err != nil {
+			return 0, fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if query.counted {
+			purged += affected
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return purged, nil
+}
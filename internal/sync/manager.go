@@ -4,12 +4,40 @@ package sync
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/kyokomi/gotodoist/internal/api"
 	"github.com/kyokomi/gotodoist/internal/storage"
 )
 
+// syncResourceTypes はローカルストレージに同期するリソースタイプ
+var syncResourceTypes = []string{
+	api.ResourceItems,
+	api.ResourceProjects,
+	api.ResourceSections,
+	api.ResourceNotes,
+	api.ResourceReminders,
+	api.ResourceFilters,
+	api.ResourceLabels,
+	api.ResourceUser,
+	api.ResourceCollaborators,
+}
+
+// baseResourceTypes は同期済みリソースタイプの記録が無い場合に同期済みとみなすリソースタイプ
+// 記録を始める前に作成されたローカルDBはこれらのリソースタイプのみを同期している
+var baseResourceTypes = []string{
+	api.ResourceItems,
+	api.ResourceProjects,
+	api.ResourceSections,
+}
+
+// ResourceTypes はローカルストレージに同期するリソースタイプを返す
+func ResourceTypes() []string {
+	return append([]string(nil), syncResourceTypes...)
+}
+
 // Manager は同期処理を管理する
 type Manager struct {
 	apiClient api.Interface
@@ -35,7 +63,7 @@ func (m *Manager) InitialSync(ctx context.Context) error {
 	// sync_token="*"で全データを取得
 	resp, err := m.apiClient.Sync(ctx, &api.SyncRequest{
 		SyncToken:     "*",
-		ResourceTypes: []string{api.ResourceItems, api.ResourceProjects, api.ResourceSections},
+		ResourceTypes: syncResourceTypes,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to fetch initial data: %w", err)
@@ -84,6 +112,54 @@ func (m *Manager) InitialSync(ctx context.Context) error {
 		}
 	}
 
+	// ノートを保存
+	if m.verbose {
+		fmt.Printf("💬 Saving %d notes...\n", len(resp.Notes))
+	}
+	for _, note := range resp.Notes {
+		if err := m.storage.InsertNote(note); err != nil {
+			return fmt.Errorf("failed to insert note %s: %w", note.ID, err)
+		}
+	}
+
+	// リマインダーを保存
+	if m.verbose {
+		fmt.Printf("⏰ Saving %d reminders...\n", len(resp.Reminders))
+	}
+	for _, reminder := range resp.Reminders {
+		if err := m.storage.InsertReminder(reminder); err != nil {
+			return fmt.Errorf("failed to insert reminder %s: %w", reminder.ID, err)
+		}
+	}
+
+	// フィルタを保存
+	if m.verbose {
+		fmt.Printf("🔍 Saving %d filters...\n", len(resp.Filters))
+	}
+	for _, filter := range resp.Filters {
+		if err := m.storage.InsertFilter(filter); err != nil {
+			return fmt.Errorf("failed to insert filter %s: %w", filter.ID, err)
+		}
+	}
+
+	// パーソナルラベルを保存
+	if m.verbose {
+		fmt.Printf("🏷️  Saving %d labels...\n", len(resp.Labels))
+	}
+	for _, label := range resp.Labels {
+		if err := m.storage.InsertLabel(label); err != nil {
+			return fmt.Errorf("failed to insert label %s: %w", label.ID, err)
+		}
+	}
+
+	// 共同作業者とログイン中のユーザーを保存
+	if m.verbose {
+		fmt.Printf("👥 Saving %d collaborators...\n", len(resp.Collaborators))
+	}
+	if err := m.applyCollaboratorChanges(resp.User, resp.Collaborators, resp.CollaboratorStates); err != nil {
+		return err
+	}
+
 	// sync_tokenと同期状態を更新
 	if err := m.storage.SetSyncToken(resp.SyncToken); err != nil {
 		return fmt.Errorf("failed to set sync token: %w", err)
@@ -97,6 +173,10 @@ func (m *Manager) InitialSync(ctx context.Context) error {
 		return fmt.Errorf("failed to set initial sync done: %w", err)
 	}
 
+	if err := m.storage.SetSyncedResourceTypes(syncResourceTypes); err != nil {
+		return fmt.Errorf("failed to set synced resource types: %w", err)
+	}
+
 	// トランザクションコミット
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
@@ -115,6 +195,10 @@ func (m *Manager) IncrementalSync(ctx context.Context) error {
 		return err
 	}
 
+	if err := m.syncMissingResourceTypes(ctx); err != nil {
+		return err
+	}
+
 	if m.verbose {
 		fmt.Println("🔄 Starting incremental sync...")
 	}
@@ -159,6 +243,67 @@ func (m *Manager) checkInitialSyncStatus(ctx context.Context) error {
 	return nil
 }
 
+// syncMissingResourceTypes は初期同期の後に追加されたリソースタイプの全データを取得する
+// 既存のローカルDBでは新しいリソースタイプが増分同期の差分に現れないため、一度だけ全データを取得する
+// 取得時のsync_tokenは保存しない（他のリソースタイプの差分を取りこぼすため）
+func (m *Manager) syncMissingResourceTypes(ctx context.Context) error {
+	synced, err := m.storage.GetSyncedResourceTypes()
+	if err != nil {
+		return fmt.Errorf("failed to get synced resource types: %w", err)
+	}
+	if len(synced) == 0 {
+		synced = baseResourceTypes
+	}
+
+	var missing []string
+	for _, resourceType := range syncResourceTypes {
+		if !slices.Contains(synced, resourceType) {
+			missing = append(missing, resourceType)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if m.verbose {
+		fmt.Printf("🔄 Fetching newly supported resources: %s\n", strings.Join(missing, ", "))
+	}
+
+	resp, err := m.apiClient.Sync(ctx, &api.SyncRequest{
+		SyncToken:     "*",
+		ResourceTypes: missing,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", strings.Join(missing, ", "), err)
+	}
+
+	tx, err := m.storage.BeginTx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				fmt.Printf("Warning: failed to rollback transaction: %v\n", rollbackErr)
+			}
+		}
+	}()
+
+	if err = m.applyResourceChanges(resp); err != nil {
+		return err
+	}
+
+	if err = m.storage.SetSyncedResourceTypes(syncResourceTypes); err != nil {
+		return fmt.Errorf("failed to set synced resource types: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 // fetchIncrementalData は前回のsync_tokenを使って差分データを取得する
 func (m *Manager) fetchIncrementalData(ctx context.Context) (*api.SyncResponse, error) {
 	lastToken, err := m.storage.GetSyncToken()
@@ -168,7 +313,7 @@ func (m *Manager) fetchIncrementalData(ctx context.Context) (*api.SyncResponse,
 
 	resp, err := m.apiClient.Sync(ctx, &api.SyncRequest{
 		SyncToken:     lastToken,
-		ResourceTypes: []string{api.ResourceItems, api.ResourceProjects, api.ResourceSections},
+		ResourceTypes: syncResourceTypes,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch incremental data: %w", err)
@@ -179,7 +324,8 @@ func (m *Manager) fetchIncrementalData(ctx context.Context) (*api.SyncResponse,
 
 // hasNoChanges は同期レスポンスに変更がないかチェックする
 func (m *Manager) hasNoChanges(resp *api.SyncResponse) bool {
-	return len(resp.Projects) == 0 && len(resp.Sections) == 0 && len(resp.Items) == 0
+	return len(resp.Projects) == 0 && len(resp.Sections) == 0 && len(resp.Items) == 0 && len(resp.Notes) == 0 &&
+		len(resp.Reminders) == 0 && len(resp.Filters) == 0 && len(resp.Labels) == 0 && len(resp.Collaborators) == 0 && len(resp.CollaboratorStates) == 0
 }
 
 // applyIncrementalChanges はトランザクション内で差分変更を適用する
@@ -189,6 +335,11 @@ func (m *Manager) applyIncrementalChanges(resp *api.SyncResponse) error {
 		fmt.Printf("  - Projects: %d\n", len(resp.Projects))
 		fmt.Printf("  - Sections: %d\n", len(resp.Sections))
 		fmt.Printf("  - Tasks: %d\n", len(resp.Items))
+		fmt.Printf("  - Notes: %d\n", len(resp.Notes))
+		fmt.Printf("  - Reminders: %d\n", len(resp.Reminders))
+		fmt.Printf("  - Filters: %d\n", len(resp.Filters))
+		fmt.Printf("  - Labels: %d\n", len(resp.Labels))
+		fmt.Printf("  - Collaborators: %d\n", len(resp.Collaborators))
 		if len(resp.Projects) > 0 {
 			for _, project := range resp.Projects {
 				fmt.Printf("    📁 Project: %s (ID: %s, Deleted: %t)\n", project.Name, project.ID, project.IsDeleted)
@@ -207,6 +358,23 @@ func (m *Manager) applyIncrementalChanges(resp *api.SyncResponse) error {
 		}
 	}()
 
+	if err := m.applyResourceChanges(resp); err != nil {
+		return err
+	}
+
+	if err := m.updateSyncMetadata(resp.SyncToken); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// applyResourceChanges は同期レスポンスに含まれる各リソースの変更を適用する
+func (m *Manager) applyResourceChanges(resp *api.SyncResponse) error {
 	if err := m.applyProjectChanges(resp.Projects); err != nil {
 		return err
 	}
@@ -219,15 +387,23 @@ func (m *Manager) applyIncrementalChanges(resp *api.SyncResponse) error {
 		return err
 	}
 
-	if err := m.updateSyncMetadata(resp.SyncToken); err != nil {
+	if err := m.applyNoteChanges(resp.Notes); err != nil {
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	if err := m.applyReminderChanges(resp.Reminders); err != nil {
+		return err
 	}
 
-	return nil
+	if err := m.applyFilterChanges(resp.Filters); err != nil {
+		return err
+	}
+
+	if err := m.applyLabelChanges(resp.Labels); err != nil {
+		return err
+	}
+
+	return m.applyCollaboratorChanges(resp.User, resp.Collaborators, resp.CollaboratorStates)
 }
 
 // applyProjectChanges はプロジェクトの変更を適用する
@@ -305,6 +481,137 @@ func (m *Manager) applyTaskChanges(tasks []api.Item) error {
 	return nil
 }
 
+// applyNoteChanges はノートの変更を適用する
+func (m *Manager) applyNoteChanges(notes []api.Note) error {
+	if len(notes) == 0 {
+		return nil
+	}
+
+	if m.verbose {
+		fmt.Printf("💬 Processing %d note changes...\n", len(notes))
+	}
+
+	for _, note := range notes {
+		if note.IsDeleted {
+			if err := m.storage.DeleteNote(note.ID); err != nil {
+				return fmt.Errorf("failed to delete note %s: %w", note.ID, err)
+			}
+		} else {
+			if err := m.storage.InsertNote(note); err != nil {
+				return fmt.Errorf("failed to upsert note %s: %w", note.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyReminderChanges はリマインダーの変更を適用する
+func (m *Manager) applyReminderChanges(reminders []api.Reminder) error {
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	if m.verbose {
+		fmt.Printf("⏰ Processing %d reminder changes...\n", len(reminders))
+	}
+
+	for _, reminder := range reminders {
+		if reminder.IsDeleted {
+			if err := m.storage.DeleteReminder(reminder.ID); err != nil {
+				return fmt.Errorf("failed to delete reminder %s: %w", reminder.ID, err)
+			}
+		} else {
+			if err := m.storage.InsertReminder(reminder); err != nil {
+				return fmt.Errorf("failed to upsert reminder %s: %w", reminder.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyFilterChanges はフィルタの変更を適用する
+func (m *Manager) applyFilterChanges(filters []api.Filter) error {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	if m.verbose {
+		fmt.Printf("🔍 Processing %d filter changes...\n", len(filters))
+	}
+
+	for _, filter := range filters {
+		if filter.IsDeleted {
+			if err := m.storage.DeleteFilter(filter.ID); err != nil {
+				return fmt.Errorf("failed to delete filter %s: %w", filter.ID, err)
+			}
+		} else {
+			if err := m.storage.InsertFilter(filter); err != nil {
+				return fmt.Errorf("failed to upsert filter %s: %w", filter.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyLabelChanges はパーソナルラベルの変更を適用する
+func (m *Manager) applyLabelChanges(labels []api.Label) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	if m.verbose {
+		fmt.Printf("🏷️  Processing %d label changes...\n", len(labels))
+	}
+
+	for _, label := range labels {
+		if label.IsDeleted {
+			if err := m.storage.DeleteLabel(label.ID); err != nil {
+				return fmt.Errorf("failed to delete label %s: %w", label.ID, err)
+			}
+		} else {
+			if err := m.storage.InsertLabel(label); err != nil {
+				return fmt.Errorf("failed to upsert label %s: %w", label.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyCollaboratorChanges は共同作業者・参加状態・ログイン中のユーザーの変更を適用する
+func (m *Manager) applyCollaboratorChanges(user *api.User, collaborators []api.Collaborator, states []api.CollaboratorState) error {
+	if user != nil && user.ID != "" {
+		if err := m.storage.SetCurrentUser(*user); err != nil {
+			return fmt.Errorf("failed to save current user %s: %w", user.ID, err)
+		}
+	}
+
+	if len(collaborators) == 0 && len(states) == 0 {
+		return nil
+	}
+
+	if m.verbose {
+		fmt.Printf("👥 Processing %d collaborator changes...\n", len(collaborators)+len(states))
+	}
+
+	for _, collaborator := range collaborators {
+		if err := m.storage.InsertCollaborator(collaborator); err != nil {
+			return fmt.Errorf("failed to upsert collaborator %s: %w", collaborator.ID, err)
+		}
+	}
+
+	for _, state := range states {
+		if err := m.storage.InsertCollaboratorState(state); err != nil {
+			return fmt.Errorf("failed to upsert collaborator state %s/%s: %w", state.ProjectID, state.UserID, err)
+		}
+	}
+
+	return nil
+}
+
 // updateSyncMetadata はsync_tokenと同期時刻を更新する
 func (m *Manager) updateSyncMetadata(syncToken string) error {
 	if err := m.storage.SetSyncToken(syncToken); err != nil {